@@ -0,0 +1,201 @@
+package api
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/machearn/galaxy_controller/money"
+	"github.com/machearn/galaxy_controller/pb"
+	"github.com/machearn/galaxy_controller/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const exportPageSize = 100
+
+type ExportUserRequest struct {
+	Format string `form:"format" binding:"omitempty,oneof=json zip"`
+}
+
+type UserExport struct {
+	ExportedAt time.Time `json:"exported_at"`
+	User       User      `json:"user"`
+	Sessions   []Session `json:"sessions"`
+	Entries    []Entry   `json:"entries"`
+}
+
+// ExportUser returns everything held about the authenticated user, either as
+// a single JSON document or as a ZIP archive with one JSON file per section.
+func (server *Server) ExportUser(ctx *gin.Context) {
+	var req ExportUserRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+
+	userResult, err := server.grpc.GetUser(ctx, &pb.GetUserRequest{ID: authPayload.UserID})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.NotFound {
+				ctx.JSON(http.StatusNotFound, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	sessionResult, err := server.grpc.ListSessions(ctx, &pb.ListSessionsRequest{UserId: authPayload.UserID})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	export := UserExport{
+		ExportedAt: time.Now().UTC(),
+		User:       newUserResponse(userResult.GetUser()),
+		Sessions:   make([]Session, 0, len(sessionResult.GetSessions())),
+		Entries:    []Entry{},
+	}
+	for _, session := range sessionResult.GetSessions() {
+		export.Sessions = append(export.Sessions, newSessionResponse(session))
+	}
+
+	for offset := int32(0); ; offset += exportPageSize {
+		grpcReq := pb.ListEntriesByUserRequest{
+			UserId: authPayload.UserID,
+			Offset: offset,
+			Limit:  exportPageSize,
+		}
+
+		result, err := server.grpc.ListEntriesByUser(ctx, &grpcReq)
+		if err != nil {
+			if apiErr, ok := status.FromError(err); ok && apiErr.Code() == codes.NotFound {
+				break
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		for _, row := range result.GetEntries() {
+			export.Entries = append(export.Entries, Entry{
+				ID:        row.ID,
+				UserID:    row.UserId,
+				ItemID:    row.ItemId,
+				Quantity:  row.Quantity,
+				Total:     money.FromProto(row.GetTotal()),
+				CreatedAt: row.CreatedAt.AsTime(),
+			})
+		}
+		if len(result.GetEntries()) < exportPageSize {
+			break
+		}
+	}
+
+	if req.Format != "zip" {
+		ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=user-%d.json", authPayload.UserID))
+		ctx.JSON(http.StatusOK, export)
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=user-%d.zip", authPayload.UserID))
+	ctx.Status(http.StatusOK)
+	ctx.Header("Content-Type", "application/zip")
+
+	archive := zip.NewWriter(ctx.Writer)
+	files := []struct {
+		name    string
+		content any
+	}{
+		{"user.json", export.User},
+		{"sessions.json", export.Sessions},
+		{"entries.json", export.Entries},
+	}
+	for _, file := range files {
+		w, err := archive.Create(file.name)
+		if err != nil {
+			ctx.Error(err)
+			return
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(file.content); err != nil {
+			ctx.Error(err)
+			return
+		}
+	}
+	if err := archive.Close(); err != nil {
+		ctx.Error(err)
+	}
+}
+
+type DeleteAccountRequest struct {
+	Password string `json:"password" binding:"required"`
+}
+
+// DeleteAccount removes the authenticated user after re-confirming their
+// password. Their entries are kept for bookkeeping but anonymized.
+func (server *Server) DeleteAccount(ctx *gin.Context) {
+	var req DeleteAccountRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+
+	userResult, err := server.grpc.GetUser(ctx, &pb.GetUserRequest{ID: authPayload.UserID})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.NotFound {
+				ctx.JSON(http.StatusNotFound, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if err := util.CheckPassword(req.Password, userResult.GetPassword()); err != nil {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(errors.New("password is incorrect")))
+		return
+	}
+
+	_, err = server.grpc.RevokeSessions(ctx, &pb.RevokeSessionsRequest{UserId: authPayload.UserID})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	grpcReq := pb.DeleteUserRequest{
+		ID:               authPayload.UserID,
+		AnonymizeEntries: true,
+	}
+
+	_, err = server.grpc.DeleteUser(ctx, &grpcReq)
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.NotFound {
+				ctx.JSON(http.StatusNotFound, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
@@ -0,0 +1,237 @@
+package api
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/machearn/galaxy_controller/pb"
+	mockpb "github.com/machearn/galaxy_controller/pb/mock"
+	"github.com/machearn/galaxy_controller/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func mockExportCalls(grpc *mockpb.MockGalaxyClient, created time.Time) {
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Eq(&pb.GetUserRequest{ID: 1})).Return(&pb.GetUserResponse{
+		User: &pb.User{
+			ID:        1,
+			Username:  "test",
+			Fullname:  "test",
+			Email:     "test",
+			Plan:      1,
+			CreatedAt: timestamppb.New(created),
+			ExpiredAt: timestamppb.New(created),
+		},
+		Password: "secret",
+	}, nil)
+	grpc.EXPECT().ListSessions(gomock.Any(), gomock.Eq(&pb.ListSessionsRequest{UserId: 1})).Return(&pb.ListSessionsResponse{
+		Sessions: []*pb.Session{
+			{
+				ID:           uuid.New().String(),
+				UserId:       1,
+				RefreshToken: "refresh-token",
+				ClientIp:     "127.0.0.1",
+				UserAgent:    "test",
+				CreatedAt:    timestamppb.New(created),
+				ExpiredAt:    timestamppb.New(created),
+			},
+		},
+	}, nil)
+	grpc.EXPECT().ListEntriesByUser(gomock.Any(), gomock.Eq(&pb.ListEntriesByUserRequest{
+		UserId: 1,
+		Offset: 0,
+		Limit:  exportPageSize,
+	})).Return(&pb.ListEntriesResponse{
+		Entries: []*pb.Entry{
+			{ID: 1, UserId: 1, ItemId: 1, Quantity: 1, Total: usd(1), CreatedAt: timestamppb.New(created)},
+			{ID: 2, UserId: 1, ItemId: 2, Quantity: 2, Total: usd(2), CreatedAt: timestamppb.New(created)},
+		},
+	}, nil)
+}
+
+func TestExportUserAPI(t *testing.T) {
+	created := time.Now().UTC().Truncate(time.Second)
+
+	grpcAuthReq := pb.AuthRequest{
+		Token: util.GetRandomString(32),
+	}
+	grpcAuthRes := pb.AuthResponse{
+		ID:        uuid.New().String(),
+		UserId:    1,
+		CreatedAt: timestamppb.New(created),
+		ExpiredAt: timestamppb.New(created),
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().Authorize(gomock.Any(), gomock.Eq(&grpcAuthReq)).Return(&grpcAuthRes, nil)
+	mockExportCalls(grpc, created)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodGet, "/user/export", nil)
+	require.NoError(t, err)
+
+	addAuthHeader(request, grpcAuthReq.Token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.NotContains(t, recorder.Body.String(), "refresh-token")
+	require.NotContains(t, recorder.Body.String(), "secret")
+
+	var res UserExport
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), res.User.ID)
+	require.Len(t, res.Sessions, 1)
+	require.Equal(t, "127.0.0.1", res.Sessions[0].ClientIP)
+	require.Len(t, res.Entries, 2)
+}
+
+func TestExportUserAPIZip(t *testing.T) {
+	created := time.Now().UTC().Truncate(time.Second)
+
+	grpcAuthReq := pb.AuthRequest{
+		Token: util.GetRandomString(32),
+	}
+	grpcAuthRes := pb.AuthResponse{
+		ID:        uuid.New().String(),
+		UserId:    1,
+		CreatedAt: timestamppb.New(created),
+		ExpiredAt: timestamppb.New(created),
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().Authorize(gomock.Any(), gomock.Eq(&grpcAuthReq)).Return(&grpcAuthRes, nil)
+	mockExportCalls(grpc, created)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodGet, "/user/export?format=zip", nil)
+	require.NoError(t, err)
+
+	addAuthHeader(request, grpcAuthReq.Token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "application/zip", recorder.Header().Get("Content-Type"))
+
+	archive, err := zip.NewReader(bytes.NewReader(recorder.Body.Bytes()), int64(recorder.Body.Len()))
+	require.NoError(t, err)
+	require.Len(t, archive.File, 3)
+
+	f, err := archive.Open("entries.json")
+	require.NoError(t, err)
+	data, err := io.ReadAll(f)
+	require.NoError(t, err)
+
+	var entries []Entry
+	err = json.Unmarshal(data, &entries)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+}
+
+func TestDeleteAccountAPI(t *testing.T) {
+	created := time.Now().UTC().Truncate(time.Second)
+
+	data, err := json.Marshal(gin.H{
+		"password": "test",
+	})
+	require.NoError(t, err)
+
+	hashedPassword, err := util.HashPassword("test")
+	require.NoError(t, err)
+
+	grpcAuthReq := pb.AuthRequest{
+		Token: util.GetRandomString(32),
+	}
+	grpcAuthRes := pb.AuthResponse{
+		ID:        uuid.New().String(),
+		UserId:    1,
+		CreatedAt: timestamppb.New(created),
+		ExpiredAt: timestamppb.New(created),
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().Authorize(gomock.Any(), gomock.Eq(&grpcAuthReq)).Return(&grpcAuthRes, nil)
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Eq(&pb.GetUserRequest{ID: 1})).Return(&pb.GetUserResponse{
+		User:     &pb.User{ID: 1},
+		Password: hashedPassword,
+	}, nil)
+	grpc.EXPECT().RevokeSessions(gomock.Any(), gomock.Eq(&pb.RevokeSessionsRequest{UserId: 1})).Return(&pb.RevokeSessionsResponse{Count: 1}, nil)
+	grpc.EXPECT().DeleteUser(gomock.Any(), gomock.Eq(&pb.DeleteUserRequest{ID: 1, AnonymizeEntries: true})).Return(&pb.Empty{}, nil)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/user/delete", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, grpcAuthReq.Token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestDeleteAccountAPIWrongPassword(t *testing.T) {
+	created := time.Now().UTC().Truncate(time.Second)
+
+	data, err := json.Marshal(gin.H{
+		"password": "wrong",
+	})
+	require.NoError(t, err)
+
+	hashedPassword, err := util.HashPassword("test")
+	require.NoError(t, err)
+
+	grpcAuthReq := pb.AuthRequest{
+		Token: util.GetRandomString(32),
+	}
+	grpcAuthRes := pb.AuthResponse{
+		ID:        uuid.New().String(),
+		UserId:    1,
+		CreatedAt: timestamppb.New(created),
+		ExpiredAt: timestamppb.New(created),
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().Authorize(gomock.Any(), gomock.Eq(&grpcAuthReq)).Return(&grpcAuthRes, nil)
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Eq(&pb.GetUserRequest{ID: 1})).Return(&pb.GetUserResponse{
+		User:     &pb.User{ID: 1},
+		Password: hashedPassword,
+	}, nil)
+	grpc.EXPECT().DeleteUser(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/user/delete", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, grpcAuthReq.Token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+}
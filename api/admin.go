@@ -0,0 +1,150 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/machearn/galaxy_controller/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type UpdateUserStatusRequest struct {
+	ID     int32      `json:"id" binding:"required,min=1"`
+	State  string     `json:"state" binding:"required,oneof=active suspended banned"`
+	Reason string     `json:"reason"`
+	Until  *time.Time `json:"until"`
+}
+
+func (server *Server) UpdateUserStatus(ctx *gin.Context) {
+	var req UpdateUserStatusRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	state := userStates[req.State]
+	if state != pb.UserState_USER_STATE_ACTIVE && len(req.Reason) == 0 {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("reason is required")))
+		return
+	}
+
+	userStatus := pb.UserStatus{
+		State:  state,
+		Reason: req.Reason,
+	}
+	if req.Until != nil {
+		if state != pb.UserState_USER_STATE_SUSPENDED {
+			ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("until is only allowed for suspensions")))
+			return
+		}
+		userStatus.Until = timestamppb.New(*req.Until)
+	}
+
+	grpcReq := pb.UpdateUserStatusRequest{
+		ID:     req.ID,
+		Status: &userStatus,
+	}
+
+	result, err := server.grpc.UpdateUserStatus(ctx, &grpcReq)
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.NotFound {
+				ctx.JSON(http.StatusNotFound, errorResponse(apiErr.Err()))
+				return
+			}
+			if apiErr.Code() == codes.InvalidArgument {
+				ctx.JSON(http.StatusBadRequest, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if state != pb.UserState_USER_STATE_ACTIVE {
+		_, err = server.grpc.RevokeSessions(ctx, &pb.RevokeSessionsRequest{UserId: req.ID})
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+		server.recordSecurityEvent(ctx, req.ID, pb.SecurityEventType_SECURITY_EVENT_TYPE_SESSION_REVOKE, "account "+req.State+" by an administrator")
+	}
+
+	ctx.JSON(http.StatusOK, newUserResponse(result.GetUser()))
+}
+
+type ListUsersRequest struct {
+	Plan          *int32     `json:"plan"`
+	AutoRenew     *bool      `json:"auto_renew"`
+	ExpiredAfter  *time.Time `json:"expired_after"`
+	ExpiredBefore *time.Time `json:"expired_before"`
+	CreatedAfter  *time.Time `json:"created_after"`
+	CreatedBefore *time.Time `json:"created_before"`
+	Search        string     `json:"search"`
+	Offset        int32      `json:"offset" binding:"min=0"`
+	Limit         int32      `json:"limit" binding:"required,min=1,max=100"`
+}
+
+type ListUsersResponse struct {
+	Users []User `json:"users"`
+	Total int32  `json:"total"`
+}
+
+func (server *Server) ListUsers(ctx *gin.Context) {
+	var req ListUsersRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	grpcReq := pb.ListUsersRequest{
+		Plan:      req.Plan,
+		AutoRenew: req.AutoRenew,
+		Search:    strings.TrimSpace(req.Search),
+		Offset:    req.Offset,
+		Limit:     req.Limit,
+	}
+	if req.ExpiredAfter != nil {
+		grpcReq.ExpiredAfter = timestamppb.New(*req.ExpiredAfter)
+	}
+	if req.ExpiredBefore != nil {
+		grpcReq.ExpiredBefore = timestamppb.New(*req.ExpiredBefore)
+	}
+	if req.CreatedAfter != nil {
+		grpcReq.CreatedAfter = timestamppb.New(*req.CreatedAfter)
+	}
+	if req.CreatedBefore != nil {
+		grpcReq.CreatedBefore = timestamppb.New(*req.CreatedBefore)
+	}
+
+	result, err := server.grpc.ListUsers(ctx, &grpcReq)
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.InvalidArgument {
+				ctx.JSON(http.StatusBadRequest, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	users := make([]User, len(result.GetUsers()))
+	for i, user := range result.GetUsers() {
+		users[i] = newUserResponse(user)
+	}
+
+	ctx.JSON(http.StatusOK, ListUsersResponse{
+		Users: users,
+		Total: result.GetTotal(),
+	})
+}
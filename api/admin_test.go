@@ -0,0 +1,283 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/machearn/galaxy_controller/pb"
+	mockpb "github.com/machearn/galaxy_controller/pb/mock"
+	"github.com/machearn/galaxy_controller/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestUpdateUserStatusAPI(t *testing.T) {
+	url := "/admin/user/status"
+	created := time.Now().UTC().Truncate(time.Second)
+	until := created.Add(time.Hour * 24 * 7)
+
+	data, err := json.Marshal(gin.H{
+		"id":     2,
+		"state":  "suspended",
+		"reason": "spam",
+		"until":  until,
+	})
+	require.NoError(t, err)
+
+	grpcAuthReq := pb.AuthRequest{
+		Token: util.GetRandomString(32),
+	}
+	grpcAuthRes := pb.AuthResponse{
+		ID:        uuid.New().String(),
+		UserId:    1,
+		CreatedAt: timestamppb.New(created),
+		ExpiredAt: timestamppb.New(created),
+		Role:      pb.Role_ROLE_ADMIN,
+	}
+
+	userStatus := pb.UserStatus{
+		State:  pb.UserState_USER_STATE_SUSPENDED,
+		Reason: "spam",
+		Until:  timestamppb.New(until),
+	}
+	grpcReq := pb.UpdateUserStatusRequest{
+		ID:     2,
+		Status: &userStatus,
+	}
+	grpcRes := pb.UpdateUserResponse{
+		User: &pb.User{
+			ID:        2,
+			Username:  "test",
+			Fullname:  "test",
+			Email:     "test",
+			Plan:      1,
+			CreatedAt: timestamppb.New(created),
+			ExpiredAt: timestamppb.New(created),
+			Status:    &userStatus,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().Authorize(gomock.Any(), gomock.Eq(&grpcAuthReq)).Return(&grpcAuthRes, nil)
+	grpc.EXPECT().UpdateUserStatus(gomock.Any(), gomock.Eq(&grpcReq)).Return(&grpcRes, nil)
+	grpc.EXPECT().RevokeSessions(gomock.Any(), gomock.Eq(&pb.RevokeSessionsRequest{UserId: 2})).Return(&pb.RevokeSessionsResponse{Count: 1}, nil)
+	grpc.EXPECT().CreateSecurityEvent(gomock.Any(), EqSecurityEvent(2, pb.SecurityEventType_SECURITY_EVENT_TYPE_SESSION_REVOKE)).Return(&pb.Empty{}, nil)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, grpcAuthReq.Token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var res User
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), res.ID)
+	require.Equal(t, "suspended", res.Status.State)
+	require.Equal(t, "spam", res.Status.Reason)
+	require.NotNil(t, res.Status.Until)
+	require.Equal(t, until, *res.Status.Until)
+}
+
+func TestUpdateUserStatusAPINotAdmin(t *testing.T) {
+	url := "/admin/user/status"
+	created := time.Now().UTC().Truncate(time.Second)
+
+	data, err := json.Marshal(gin.H{
+		"id":     2,
+		"state":  "banned",
+		"reason": "spam",
+	})
+	require.NoError(t, err)
+
+	grpcAuthReq := pb.AuthRequest{
+		Token: util.GetRandomString(32),
+	}
+	grpcAuthRes := pb.AuthResponse{
+		ID:        uuid.New().String(),
+		UserId:    1,
+		CreatedAt: timestamppb.New(created),
+		ExpiredAt: timestamppb.New(created),
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().Authorize(gomock.Any(), gomock.Eq(&grpcAuthReq)).Return(&grpcAuthRes, nil)
+	grpc.EXPECT().UpdateUserStatus(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, grpcAuthReq.Token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusForbidden, recorder.Code)
+}
+
+func TestAuthMiddlewareBannedUser(t *testing.T) {
+	created := time.Now().UTC().Truncate(time.Second)
+
+	grpcAuthReq := pb.AuthRequest{
+		Token: util.GetRandomString(32),
+	}
+	grpcAuthRes := pb.AuthResponse{
+		ID:        uuid.New().String(),
+		UserId:    1,
+		CreatedAt: timestamppb.New(created),
+		ExpiredAt: timestamppb.New(created),
+		UserStatus: &pb.UserStatus{
+			State:  pb.UserState_USER_STATE_BANNED,
+			Reason: "fraud",
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().Authorize(gomock.Any(), gomock.Eq(&grpcAuthReq)).Return(&grpcAuthRes, nil)
+	grpc.EXPECT().RevokeSessions(gomock.Any(), gomock.Eq(&pb.RevokeSessionsRequest{UserId: 1})).Return(&pb.RevokeSessionsResponse{Count: 1}, nil)
+	grpc.EXPECT().CreateSecurityEvent(gomock.Any(), EqSecurityEvent(1, pb.SecurityEventType_SECURITY_EVENT_TYPE_SESSION_REVOKE)).Return(&pb.Empty{}, nil)
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodGet, "/user/get/1", nil)
+	require.NoError(t, err)
+
+	addAuthHeader(request, grpcAuthReq.Token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusForbidden, recorder.Code)
+	require.Contains(t, recorder.Body.String(), "account is banned: fraud")
+}
+
+func TestAuthMiddlewareBannedUserRetry(t *testing.T) {
+	created := time.Now().UTC().Truncate(time.Second)
+	token := util.GetRandomString(32)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().Authorize(gomock.Any(), gomock.Eq(&pb.AuthRequest{Token: token})).Return(&pb.AuthResponse{
+		ID:         uuid.New().String(),
+		UserId:     1,
+		CreatedAt:  timestamppb.New(created),
+		ExpiredAt:  timestamppb.New(created),
+		UserStatus: &pb.UserStatus{State: pb.UserState_USER_STATE_BANNED},
+	}, nil)
+	// the sessions were revoked by an earlier request
+	grpc.EXPECT().RevokeSessions(gomock.Any(), gomock.Any()).Return(&pb.RevokeSessionsResponse{}, nil)
+	grpc.EXPECT().CreateSecurityEvent(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodGet, "/user/get/1", nil)
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusForbidden, recorder.Code)
+}
+
+func TestListUsersAPI(t *testing.T) {
+	url := "/admin/users"
+	created := time.Now().UTC().Truncate(time.Second)
+	expiredBefore := created.Add(time.Hour * 24 * 7)
+
+	data, err := json.Marshal(gin.H{
+		"plan":           2,
+		"auto_renew":     true,
+		"expired_before": expiredBefore,
+		"search":         " alice ",
+		"offset":         0,
+		"limit":          10,
+	})
+	require.NoError(t, err)
+
+	grpcAuthReq := pb.AuthRequest{
+		Token: util.GetRandomString(32),
+	}
+	grpcAuthRes := pb.AuthResponse{
+		ID:        uuid.New().String(),
+		UserId:    1,
+		CreatedAt: timestamppb.New(created),
+		ExpiredAt: timestamppb.New(created),
+		Role:      pb.Role_ROLE_ADMIN,
+	}
+
+	plan := int32(2)
+	autoRenew := true
+	grpcReq := pb.ListUsersRequest{
+		Plan:          &plan,
+		AutoRenew:     &autoRenew,
+		ExpiredBefore: timestamppb.New(expiredBefore),
+		Search:        "alice",
+		Offset:        0,
+		Limit:         10,
+	}
+	grpcRes := pb.ListUsersResponse{
+		Users: []*pb.User{
+			{
+				ID:        2,
+				Username:  "alice",
+				Fullname:  "Alice",
+				Email:     "alice@example.com",
+				Plan:      2,
+				CreatedAt: timestamppb.New(created),
+				ExpiredAt: timestamppb.New(created),
+				AutoRenew: true,
+			},
+		},
+		Total: 11,
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().Authorize(gomock.Any(), gomock.Eq(&grpcAuthReq)).Return(&grpcAuthRes, nil)
+	grpc.EXPECT().ListUsers(gomock.Any(), gomock.Eq(&grpcReq)).Return(&grpcRes, nil)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, grpcAuthReq.Token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var res ListUsersResponse
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+	require.Equal(t, int32(11), res.Total)
+	require.Len(t, res.Users, 1)
+	require.Equal(t, "alice", res.Users[0].Username)
+}
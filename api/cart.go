@@ -0,0 +1,186 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/machearn/galaxy_controller/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CartLine struct {
+	ItemID   int32     `json:"item_id"`
+	Quantity int32     `json:"quantity"`
+	AddedAt  time.Time `json:"added_at"`
+}
+
+type Cart struct {
+	Lines     []CartLine `json:"lines"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+func newCartResponse(cart *pb.Cart) Cart {
+	res := Cart{
+		Lines: make([]CartLine, len(cart.GetLines())),
+	}
+	for i, line := range cart.GetLines() {
+		res.Lines[i] = CartLine{
+			ItemID:   line.GetItemId(),
+			Quantity: line.GetQuantity(),
+			AddedAt:  line.GetAddedAt().AsTime(),
+		}
+	}
+	if cart.GetUpdatedAt() != nil {
+		updatedAt := cart.GetUpdatedAt().AsTime()
+		res.UpdatedAt = &updatedAt
+	}
+	return res
+}
+
+// GetCart returns the authenticated user's cart.
+func (server *Server) GetCart(ctx *gin.Context) {
+	authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+
+	result, err := server.grpc.GetCart(ctx, &pb.GetCartRequest{UserId: authPayload.UserID})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newCartResponse(result.GetCart()))
+}
+
+// errGiftCardInCart rejects gift card items in the cart, since a checkout
+// issues no gift cards. They are bought on their own with CreateEntry.
+var errGiftCardInCart = errors.New("gift cards cannot be bought through the cart")
+
+// setCartLine puts quantity of the item in the authenticated user's cart after
+// checking that the item exists in the tenant, is not a gift card and has
+// enough stock. It writes the response either way.
+func (server *Server) setCartLine(ctx *gin.Context, itemID int32, quantity int32) {
+	authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+
+	itemResult, err := server.grpc.GetItem(tenantContext(ctx), &pb.GetItemRequest{Id: itemID})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.NotFound {
+				ctx.JSON(http.StatusNotFound, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	item := itemResult.GetItem()
+	if !inTenant(ctx, item.GetTenantId()) {
+		ctx.JSON(http.StatusNotFound, errorResponse(errors.New("item not found")))
+		return
+	}
+	if item.GetGiftCard() {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errGiftCardInCart))
+		return
+	}
+	if quantity > item.GetQuantity() {
+		ctx.JSON(http.StatusConflict, errorResponse(fmt.Errorf("only %d of %s left in stock", item.GetQuantity(), item.GetName())))
+		return
+	}
+
+	result, err := server.grpc.SetCartLine(ctx, &pb.SetCartLineRequest{
+		UserId:   authPayload.UserID,
+		ItemId:   itemID,
+		Quantity: quantity,
+	})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.InvalidArgument {
+				ctx.JSON(http.StatusBadRequest, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newCartResponse(result.GetCart()))
+}
+
+type AddCartLineRequest struct {
+	ItemID   int32 `json:"item_id" binding:"required,min=1"`
+	Quantity int32 `json:"quantity" binding:"required,min=1"`
+}
+
+// AddCartLine adds quantity of an item to the cart, on top of what the cart
+// already holds of it.
+func (server *Server) AddCartLine(ctx *gin.Context) {
+	var req AddCartLineRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+	result, err := server.grpc.GetCart(ctx, &pb.GetCartRequest{UserId: authPayload.UserID})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	quantity := req.Quantity
+	for _, line := range result.GetCart().GetLines() {
+		if line.GetItemId() == req.ItemID {
+			quantity += line.GetQuantity()
+			break
+		}
+	}
+
+	server.setCartLine(ctx, req.ItemID, quantity)
+}
+
+type UpdateCartLineRequest struct {
+	ItemID   int32 `json:"item_id" binding:"required,min=1"`
+	Quantity int32 `json:"quantity" binding:"required,min=1"`
+}
+
+// UpdateCartLine replaces the quantity of an item in the cart.
+func (server *Server) UpdateCartLine(ctx *gin.Context) {
+	var req UpdateCartLineRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	server.setCartLine(ctx, req.ItemID, req.Quantity)
+}
+
+type RemoveCartLineRequest struct {
+	ItemID int32 `json:"item_id" binding:"required,min=1"`
+}
+
+func (server *Server) RemoveCartLine(ctx *gin.Context) {
+	var req RemoveCartLineRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+	result, err := server.grpc.SetCartLine(ctx, &pb.SetCartLineRequest{
+		UserId: authPayload.UserID,
+		ItemId: req.ItemID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newCartResponse(result.GetCart()))
+}
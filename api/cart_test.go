@@ -0,0 +1,156 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/machearn/galaxy_controller/pb"
+	mockpb "github.com/machearn/galaxy_controller/pb/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCartAPI(t *testing.T) {
+	testCases := []struct {
+		name          string
+		url           string
+		body          gin.H
+		buildStubs    func(grpc *mockpb.MockGalaxyClient)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "AddToExistingLine",
+			url:  "/cart/add",
+			body: gin.H{"item_id": 3, "quantity": 2},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetCart(gomock.Any(), gomock.Eq(&pb.GetCartRequest{UserId: 1})).Return(&pb.CartResponse{
+					Cart: &pb.Cart{UserId: 1, Lines: []*pb.CartLine{{ItemId: 3, Quantity: 1}}},
+				}, nil)
+				grpc.EXPECT().GetItem(gomock.Any(), gomock.Eq(&pb.GetItemRequest{Id: 3})).Return(&pb.GetItemResponse{
+					Item: &pb.Item{ID: 3, Name: "lamp", Quantity: 5, Price: usd(100)},
+				}, nil)
+				grpc.EXPECT().SetCartLine(gomock.Any(), gomock.Eq(&pb.SetCartLineRequest{UserId: 1, ItemId: 3, Quantity: 3})).Return(&pb.CartResponse{
+					Cart: &pb.Cart{UserId: 1, Lines: []*pb.CartLine{{ItemId: 3, Quantity: 3, AddedAt: timestamppb.Now()}}, UpdatedAt: timestamppb.Now()},
+				}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var cart Cart
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &cart))
+				require.Len(t, cart.Lines, 1)
+				require.Equal(t, int32(3), cart.Lines[0].Quantity)
+			},
+		},
+		{
+			name: "AddOutOfStock",
+			url:  "/cart/add",
+			body: gin.H{"item_id": 3, "quantity": 6},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetCart(gomock.Any(), gomock.Any()).Return(&pb.CartResponse{Cart: &pb.Cart{UserId: 1}}, nil)
+				grpc.EXPECT().GetItem(gomock.Any(), gomock.Any()).Return(&pb.GetItemResponse{
+					Item: &pb.Item{ID: 3, Name: "lamp", Quantity: 5, Price: usd(100)},
+				}, nil)
+				grpc.EXPECT().SetCartLine(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "AddUnknownItem",
+			url:  "/cart/add",
+			body: gin.H{"item_id": 3, "quantity": 1},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetCart(gomock.Any(), gomock.Any()).Return(&pb.CartResponse{Cart: &pb.Cart{UserId: 1}}, nil)
+				grpc.EXPECT().GetItem(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.NotFound, "item not found"))
+				grpc.EXPECT().SetCartLine(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "AddGiftCard",
+			url:  "/cart/add",
+			body: gin.H{"item_id": 3, "quantity": 1},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetCart(gomock.Any(), gomock.Any()).Return(&pb.CartResponse{Cart: &pb.Cart{UserId: 1}}, nil)
+				grpc.EXPECT().GetItem(gomock.Any(), gomock.Any()).Return(&pb.GetItemResponse{
+					Item: &pb.Item{ID: 3, Name: "gift card", Quantity: 5, Price: usd(5000), GiftCard: true},
+				}, nil)
+				grpc.EXPECT().SetCartLine(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Update",
+			url:  "/cart/update",
+			body: gin.H{"item_id": 3, "quantity": 2},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetItem(gomock.Any(), gomock.Any()).Return(&pb.GetItemResponse{
+					Item: &pb.Item{ID: 3, Quantity: 5},
+				}, nil)
+				grpc.EXPECT().SetCartLine(gomock.Any(), gomock.Eq(&pb.SetCartLineRequest{UserId: 1, ItemId: 3, Quantity: 2})).Return(&pb.CartResponse{
+					Cart: &pb.Cart{UserId: 1, Lines: []*pb.CartLine{{ItemId: 3, Quantity: 2}}},
+				}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Remove",
+			url:  "/cart/remove",
+			body: gin.H{"item_id": 3},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().SetCartLine(gomock.Any(), gomock.Eq(&pb.SetCartLineRequest{UserId: 1, ItemId: 3})).Return(&pb.CartResponse{
+					Cart: &pb.Cart{UserId: 1},
+				}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var cart Cart
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &cart))
+				require.Empty(t, cart.Lines)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			grpc := mockpb.NewMockGalaxyClient(ctrl)
+			token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+			tc.buildStubs(grpc)
+
+			server := NewTestServer(t, grpc)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, tc.url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthHeader(request, token)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,399 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/machearn/galaxy_controller/money"
+	"github.com/machearn/galaxy_controller/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var couponTypeNames = map[pb.CouponType]string{
+	pb.CouponType_COUPON_TYPE_PERCENT: "percent",
+	pb.CouponType_COUPON_TYPE_FIXED:   "fixed",
+}
+
+var couponTypes = map[string]pb.CouponType{
+	"percent": pb.CouponType_COUPON_TYPE_PERCENT,
+	"fixed":   pb.CouponType_COUPON_TYPE_FIXED,
+}
+
+// errCouponExhausted is returned by the purchase sagas when a coupon has
+// been redeemed as often as it may be.
+var errCouponExhausted = errors.New("coupon has reached its redemption limit")
+
+type Coupon struct {
+	ID   int32  `json:"id"`
+	Code string `json:"code"`
+	Type string `json:"type"`
+	// Value is the percentage off of percent coupons, AmountOff the amount
+	// off of fixed ones.
+	Value     int32        `json:"value,omitempty"`
+	AmountOff *money.Money `json:"amount_off,omitempty"`
+	ItemIDs   []int32      `json:"item_ids"`
+	PlanIDs   []int32      `json:"plan_ids"`
+	StartsAt  *time.Time   `json:"starts_at"`
+	ExpiresAt *time.Time   `json:"expires_at"`
+	Active    bool         `json:"active"`
+	CreatedAt time.Time    `json:"created_at"`
+
+	MaxRedemptions        int32 `json:"max_redemptions"`
+	MaxRedemptionsPerUser int32 `json:"max_redemptions_per_user"`
+	Redemptions           int32 `json:"redemptions"`
+}
+
+func newCouponResponse(coupon *pb.Coupon) Coupon {
+	res := Coupon{
+		ID:        coupon.GetID(),
+		Code:      coupon.GetCode(),
+		Type:      couponTypeNames[coupon.GetType()],
+		Value:     coupon.GetValue(),
+		ItemIDs:   coupon.GetItemIds(),
+		PlanIDs:   coupon.GetPlanIds(),
+		Active:    coupon.GetActive(),
+		CreatedAt: coupon.GetCreatedAt().AsTime(),
+
+		MaxRedemptions:        coupon.GetMaxRedemptions(),
+		MaxRedemptionsPerUser: coupon.GetMaxRedemptionsPerUser(),
+		Redemptions:           coupon.GetRedemptions(),
+	}
+	if res.ItemIDs == nil {
+		res.ItemIDs = []int32{}
+	}
+	if res.PlanIDs == nil {
+		res.PlanIDs = []int32{}
+	}
+	if coupon.GetType() == pb.CouponType_COUPON_TYPE_FIXED {
+		amountOff := money.FromProto(coupon.GetAmountOff())
+		res.AmountOff = &amountOff
+	}
+	if coupon.GetStartsAt() != nil {
+		startsAt := coupon.GetStartsAt().AsTime()
+		res.StartsAt = &startsAt
+	}
+	if coupon.GetExpiresAt() != nil {
+		expiresAt := coupon.GetExpiresAt().AsTime()
+		res.ExpiresAt = &expiresAt
+	}
+	return res
+}
+
+type CouponRedemption struct {
+	ID        int32       `json:"id"`
+	CouponID  int32       `json:"coupon_id"`
+	UserID    int32       `json:"member_id"`
+	EntryID   int32       `json:"entry_id"`
+	Discount  money.Money `json:"discount"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+func newCouponRedemptionResponse(redemption *pb.CouponRedemption) CouponRedemption {
+	return CouponRedemption{
+		ID:        redemption.GetID(),
+		CouponID:  redemption.GetCouponId(),
+		UserID:    redemption.GetUserId(),
+		EntryID:   redemption.GetEntryId(),
+		Discount:  money.FromProto(redemption.GetDiscount()),
+		CreatedAt: redemption.GetCreatedAt().AsTime(),
+	}
+}
+
+// normalizeCouponCode makes codes case-insensitive and tolerant of stray
+// whitespace.
+func normalizeCouponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func containsID(ids []int32, id int32) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
+
+// couponDiscount returns the amount coupon takes off a purchase of item by a
+// subscriber of planID costing subtotal, or why the coupon does not apply.
+// Fixed discounts are converted to the currency of subtotal. Redemption
+// limits are enforced when the coupon is redeemed.
+func couponDiscount(coupon *pb.Coupon, itemID int32, planID int32, subtotal money.Money, exchange *money.Exchange, now time.Time) (money.Money, error) {
+	if !coupon.GetActive() {
+		return money.Money{}, errors.New("coupon is not active")
+	}
+	if coupon.GetStartsAt() != nil && now.Before(coupon.GetStartsAt().AsTime()) {
+		return money.Money{}, errors.New("coupon is not valid yet")
+	}
+	if coupon.GetExpiresAt() != nil && !now.Before(coupon.GetExpiresAt().AsTime()) {
+		return money.Money{}, errors.New("coupon has expired")
+	}
+	if len(coupon.GetItemIds()) > 0 && !containsID(coupon.GetItemIds(), itemID) {
+		return money.Money{}, errors.New("coupon does not apply to this item")
+	}
+	if len(coupon.GetPlanIds()) > 0 && !containsID(coupon.GetPlanIds(), planID) {
+		return money.Money{}, errors.New("coupon does not apply to your plan")
+	}
+
+	discount := money.New(percentOf(subtotal.Amount, coupon.GetValue()), subtotal.Currency)
+	if coupon.GetType() == pb.CouponType_COUPON_TYPE_FIXED {
+		var err error
+		discount, err = exchange.Convert(money.FromProto(coupon.GetAmountOff()), subtotal.Currency)
+		if err != nil {
+			return money.Money{}, err
+		}
+	}
+	if discount.Amount > subtotal.Amount {
+		discount.Amount = subtotal.Amount
+	}
+	return discount, nil
+}
+
+type CreateCouponRequest struct {
+	Code string `json:"code" binding:"required,max=32"`
+	Type string `json:"type" binding:"required,oneof=percent fixed"`
+	// Value is required for percent coupons, AmountOff for fixed ones.
+	Value     int32        `json:"value" binding:"min=0"`
+	AmountOff *money.Money `json:"amount_off"`
+	ItemIDs   []int32      `json:"item_ids" binding:"dive,min=1"`
+	PlanIDs   []int32      `json:"plan_ids" binding:"dive,min=0"`
+	StartsAt  *time.Time   `json:"starts_at"`
+	ExpiresAt *time.Time   `json:"expires_at"`
+
+	MaxRedemptions        int32 `json:"max_redemptions" binding:"min=0"`
+	MaxRedemptionsPerUser int32 `json:"max_redemptions_per_user" binding:"min=0"`
+}
+
+func (server *Server) CreateCoupon(ctx *gin.Context) {
+	var req CreateCouponRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	code := normalizeCouponCode(req.Code)
+	if len(code) == 0 {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("code is required")))
+		return
+	}
+	couponType := couponTypes[req.Type]
+	switch couponType {
+	case pb.CouponType_COUPON_TYPE_PERCENT:
+		if req.Value < 1 || req.Value > 100 {
+			ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("percent coupons take between 1% and 100% off")))
+			return
+		}
+	case pb.CouponType_COUPON_TYPE_FIXED:
+		if req.AmountOff == nil || req.AmountOff.Amount <= 0 {
+			ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("fixed coupons need a positive amount_off")))
+			return
+		}
+		if err := server.checkCurrency(req.AmountOff.Currency); err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+	}
+	if req.StartsAt != nil && req.ExpiresAt != nil && !req.ExpiresAt.After(*req.StartsAt) {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("expires_at must be after starts_at")))
+		return
+	}
+
+	coupon := pb.Coupon{
+		Code:                  code,
+		Type:                  couponType,
+		Value:                 req.Value,
+		ItemIds:               req.ItemIDs,
+		PlanIds:               req.PlanIDs,
+		MaxRedemptions:        req.MaxRedemptions,
+		MaxRedemptionsPerUser: req.MaxRedemptionsPerUser,
+		Active:                true,
+	}
+	if couponType == pb.CouponType_COUPON_TYPE_FIXED {
+		coupon.Value = 0
+		coupon.AmountOff = money.New(req.AmountOff.Amount, req.AmountOff.Currency).Proto()
+	}
+	if req.StartsAt != nil {
+		coupon.StartsAt = timestamppb.New(*req.StartsAt)
+	}
+	if req.ExpiresAt != nil {
+		coupon.ExpiresAt = timestamppb.New(*req.ExpiresAt)
+	}
+
+	result, err := server.grpc.CreateCoupon(ctx, &pb.CreateCouponRequest{Coupon: &coupon})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.AlreadyExists {
+				ctx.JSON(http.StatusConflict, errorResponse(fmt.Errorf("coupon %s already exists", code)))
+				return
+			}
+			if apiErr.Code() == codes.InvalidArgument {
+				ctx.JSON(http.StatusBadRequest, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newCouponResponse(result.GetCoupon()))
+}
+
+type ListCouponsRequest struct {
+	Active *bool `json:"active"`
+	Offset int32 `json:"offset" binding:"min=0"`
+	Limit  int32 `json:"limit" binding:"required,min=1,max=100"`
+}
+
+type ListCouponsResponse struct {
+	Coupons []Coupon `json:"coupons"`
+}
+
+func (server *Server) ListCoupons(ctx *gin.Context) {
+	var req ListCouponsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	result, err := server.grpc.ListCoupons(ctx, &pb.ListCouponsRequest{
+		Active: req.Active,
+		Offset: req.Offset,
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	coupons := make([]Coupon, len(result.GetCoupons()))
+	for i, coupon := range result.GetCoupons() {
+		coupons[i] = newCouponResponse(coupon)
+	}
+
+	ctx.JSON(http.StatusOK, ListCouponsResponse{
+		Coupons: coupons,
+	})
+}
+
+type UpdateCouponRequest struct {
+	ID        int32      `json:"id" binding:"required,min=1"`
+	Active    *bool      `json:"active"`
+	ExpiresAt *time.Time `json:"expires_at"`
+
+	MaxRedemptions        *int32 `json:"max_redemptions" binding:"omitempty,min=0"`
+	MaxRedemptionsPerUser *int32 `json:"max_redemptions_per_user" binding:"omitempty,min=0"`
+}
+
+// UpdateCoupon lets admins end a promotion early or change its limits. The
+// discount itself cannot change once a coupon may have been redeemed.
+func (server *Server) UpdateCoupon(ctx *gin.Context) {
+	var req UpdateCouponRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	grpcReq := pb.UpdateCouponRequest{
+		Id:                    req.ID,
+		Active:                req.Active,
+		MaxRedemptions:        req.MaxRedemptions,
+		MaxRedemptionsPerUser: req.MaxRedemptionsPerUser,
+	}
+	if req.ExpiresAt != nil {
+		grpcReq.ExpiresAt = timestamppb.New(*req.ExpiresAt)
+	}
+
+	result, err := server.grpc.UpdateCoupon(ctx, &grpcReq)
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.NotFound {
+				ctx.JSON(http.StatusNotFound, errorResponse(apiErr.Err()))
+				return
+			}
+			if apiErr.Code() == codes.InvalidArgument {
+				ctx.JSON(http.StatusBadRequest, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newCouponResponse(result.GetCoupon()))
+}
+
+type ListCouponRedemptionsRequest struct {
+	CouponID int32 `json:"coupon_id" binding:"min=0"`
+	UserID   int32 `json:"member_id" binding:"min=0"`
+	Offset   int32 `json:"offset" binding:"min=0"`
+	Limit    int32 `json:"limit" binding:"required,min=1,max=100"`
+}
+
+type ListCouponRedemptionsResponse struct {
+	Redemptions []CouponRedemption `json:"redemptions"`
+	Total       int32              `json:"total"`
+	// TotalDiscounts has the sum of the discounts in each currency.
+	TotalDiscounts []money.Money `json:"total_discounts"`
+}
+
+// ListCouponRedemptions reports how coupons have been used, optionally
+// narrowed down to one coupon or one user.
+func (server *Server) ListCouponRedemptions(ctx *gin.Context) {
+	var req ListCouponRedemptionsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	result, err := server.grpc.ListCouponRedemptions(ctx, &pb.ListCouponRedemptionsRequest{
+		CouponId: req.CouponID,
+		UserId:   req.UserID,
+		Offset:   req.Offset,
+		Limit:    req.Limit,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	redemptions := make([]CouponRedemption, len(result.GetRedemptions()))
+	for i, redemption := range result.GetRedemptions() {
+		redemptions[i] = newCouponRedemptionResponse(redemption)
+	}
+
+	ctx.JSON(http.StatusOK, ListCouponRedemptionsResponse{
+		Redemptions:    redemptions,
+		Total:          result.GetTotal(),
+		TotalDiscounts: newMoneyList(result.GetTotalDiscounts()),
+	})
+}
+
+// releaseCouponRedemption deletes the redemption of the coupon used by a
+// cancelled entry, so that it counts against the coupon's limits no more.
+func (server *Server) releaseCouponRedemption(ctx *gin.Context, entry *pb.Entry) error {
+	result, err := server.grpc.ListCouponRedemptions(ctx, &pb.ListCouponRedemptionsRequest{
+		CouponId:       entry.GetCouponId(),
+		IdempotencyKey: fmt.Sprintf("coupon:entry:%d", entry.GetID()),
+		Limit:          1,
+	})
+	if err != nil {
+		return err
+	}
+	for _, redemption := range result.GetRedemptions() {
+		_, err := server.grpc.DeleteCouponRedemption(ctx, &pb.DeleteCouponRedemptionRequest{Id: redemption.GetID()})
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,321 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/machearn/galaxy_controller/money"
+	"github.com/machearn/galaxy_controller/pb"
+	mockpb "github.com/machearn/galaxy_controller/pb/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCouponDiscount(t *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		name     string
+		coupon   *pb.Coupon
+		discount int64
+		err      string
+	}{
+		{
+			name:     "Percent",
+			coupon:   &pb.Coupon{Type: pb.CouponType_COUPON_TYPE_PERCENT, Value: 15, Active: true},
+			discount: 150,
+		},
+		{
+			name:     "Fixed",
+			coupon:   &pb.Coupon{Type: pb.CouponType_COUPON_TYPE_FIXED, AmountOff: usd(300), Active: true},
+			discount: 300,
+		},
+		{
+			name:     "FixedAboveTotal",
+			coupon:   &pb.Coupon{Type: pb.CouponType_COUPON_TYPE_FIXED, AmountOff: usd(5000), Active: true},
+			discount: 1000,
+		},
+		{
+			name:     "Restricted",
+			coupon:   &pb.Coupon{Type: pb.CouponType_COUPON_TYPE_FIXED, AmountOff: usd(100), ItemIds: []int32{2, 3}, PlanIds: []int32{1}, Active: true},
+			discount: 100,
+		},
+		{
+			name:   "Inactive",
+			coupon: &pb.Coupon{Value: 10},
+			err:    "coupon is not active",
+		},
+		{
+			name:   "NotStarted",
+			coupon: &pb.Coupon{Value: 10, StartsAt: timestamppb.New(now.Add(time.Hour)), Active: true},
+			err:    "coupon is not valid yet",
+		},
+		{
+			name:   "Expired",
+			coupon: &pb.Coupon{Value: 10, ExpiresAt: timestamppb.New(now), Active: true},
+			err:    "coupon has expired",
+		},
+		{
+			name:   "OtherItem",
+			coupon: &pb.Coupon{Value: 10, ItemIds: []int32{2}, Active: true},
+			err:    "coupon does not apply to this item",
+		},
+		{
+			name:   "OtherPlan",
+			coupon: &pb.Coupon{Value: 10, PlanIds: []int32{2}, Active: true},
+			err:    "coupon does not apply to your plan",
+		},
+	}
+
+	exchange := newTestExchange(t)
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			discount, err := couponDiscount(tc.coupon, 3, 1, money.New(1000, "USD"), exchange, now)
+			if len(tc.err) > 0 {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, money.New(tc.discount, "USD"), discount)
+		})
+	}
+
+	// fixed discounts are converted to the currency of the purchase
+	coupon := &pb.Coupon{Type: pb.CouponType_COUPON_TYPE_FIXED, AmountOff: usd(300), Active: true}
+	discount, err := couponDiscount(coupon, 3, 1, money.New(1000, "EUR"), exchange, now)
+	require.NoError(t, err)
+	require.Equal(t, money.New(150, "EUR"), discount)
+}
+
+func TestCreateCouponAPI(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour * 24).UTC().Truncate(time.Second)
+
+	testCases := []struct {
+		name          string
+		role          pb.Role
+		body          gin.H
+		buildStubs    func(grpc *mockpb.MockGalaxyClient)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			role: pb.Role_ROLE_ADMIN,
+			body: gin.H{"code": " spring10 ", "type": "percent", "value": 10, "plan_ids": []int32{2}, "expires_at": expiresAt, "max_redemptions_per_user": 1},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				coupon := &pb.Coupon{
+					Code:                  "SPRING10",
+					Type:                  pb.CouponType_COUPON_TYPE_PERCENT,
+					Value:                 10,
+					PlanIds:               []int32{2},
+					ExpiresAt:             timestamppb.New(expiresAt),
+					MaxRedemptionsPerUser: 1,
+					Active:                true,
+				}
+				grpc.EXPECT().CreateCoupon(gomock.Any(), gomock.Eq(&pb.CreateCouponRequest{Coupon: coupon})).
+					DoAndReturn(func(_ any, req *pb.CreateCouponRequest, _ ...any) (*pb.CouponResponse, error) {
+						created := *req.GetCoupon()
+						created.ID = 4
+						return &pb.CouponResponse{Coupon: &created}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var coupon Coupon
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &coupon))
+				require.Equal(t, int32(4), coupon.ID)
+				require.Equal(t, "SPRING10", coupon.Code)
+				require.Equal(t, "percent", coupon.Type)
+				require.Equal(t, []int32{}, coupon.ItemIDs)
+				require.True(t, expiresAt.Equal(*coupon.ExpiresAt))
+			},
+		},
+		{
+			name: "PercentAbove100",
+			role: pb.Role_ROLE_ADMIN,
+			body: gin.H{"code": "ALL", "type": "percent", "value": 150},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().CreateCoupon(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "FixedWithoutAmount",
+			role: pb.Role_ROLE_ADMIN,
+			body: gin.H{"code": "FIVE", "type": "fixed", "value": 500},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().CreateCoupon(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "UnsupportedCurrency",
+			role: pb.Role_ROLE_ADMIN,
+			body: gin.H{"code": "FIVE", "type": "fixed", "amount_off": gin.H{"amount": 500, "currency": "XXX"}},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().CreateCoupon(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Duplicate",
+			role: pb.Role_ROLE_ADMIN,
+			body: gin.H{"code": "FIVE", "type": "fixed", "amount_off": gin.H{"amount": 500, "currency": "usd"}},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().CreateCoupon(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.AlreadyExists, "duplicate code"))
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "NotAdmin",
+			role: pb.Role_ROLE_MEMBER,
+			body: gin.H{"code": "FIVE", "type": "fixed", "amount_off": gin.H{"amount": 500, "currency": "usd"}},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().CreateCoupon(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			grpc := mockpb.NewMockGalaxyClient(ctrl)
+			token := expectAuthorize(grpc, 1, tc.role)
+			tc.buildStubs(grpc)
+
+			server := NewTestServer(t, grpc)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/admin/coupon/create", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthHeader(request, token)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestCreateEntryAPIWithCoupon(t *testing.T) {
+	coupon := &pb.Coupon{ID: 7, Code: "TENOFF", Type: pb.CouponType_COUPON_TYPE_PERCENT, Value: 10, Active: true}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(grpc *mockpb.MockGalaxyClient)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{Count: 1}}, nil)
+				grpc.EXPECT().CreateEntry(gomock.Any(), EqKeyedRequest(&pb.CreateEntryRequest{
+					UserId:         1,
+					ItemId:         3,
+					Quantity:       2,
+					Total:          usd(900),
+					Discount:       usd(100),
+					CouponId:       7,
+					Tax:            usd(0),
+					GiftCardAmount: usd(0),
+					PointsDiscount: usd(0),
+				})).Return(&pb.CreateEntryResponse{Entry: &pb.Entry{ID: 4, Total: usd(900), Discount: usd(100), CouponId: 7}}, nil)
+				grpc.EXPECT().RedeemCoupon(gomock.Any(), gomock.Eq(&pb.RedeemCouponRequest{
+					CouponId:       7,
+					UserId:         1,
+					EntryId:        4,
+					Discount:       usd(100),
+					IdempotencyKey: "coupon:entry:4",
+				})).Return(&pb.RedeemCouponResponse{Redemption: &pb.CouponRedemption{ID: 9}}, nil)
+				grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Any()).Return(&pb.UpdateEntryResponse{
+					Entry: &pb.Entry{ID: 4, Total: usd(900), Discount: usd(100), CouponId: 7},
+				}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var entry Entry
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &entry))
+				require.Equal(t, money.New(900, "USD"), entry.Total)
+				require.Equal(t, money.New(100, "USD"), entry.Discount)
+				require.Equal(t, int32(7), entry.CouponID)
+			},
+		},
+		{
+			name: "Exhausted",
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				gomock.InOrder(
+					grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{Count: 1}}, nil),
+					grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{}}, nil),
+				)
+				grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Return(&pb.CreateEntryResponse{Entry: &pb.Entry{ID: 4}}, nil)
+				grpc.EXPECT().RedeemCoupon(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.ResourceExhausted, "limit reached"))
+				grpc.EXPECT().DeleteCouponRedemption(gomock.Any(), gomock.Any()).Times(0)
+				grpc.EXPECT().DeleteEntry(gomock.Any(), gomock.Eq(&pb.DeleteEntryRequest{Id: 4})).Return(&pb.Empty{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			grpc := mockpb.NewMockGalaxyClient(ctrl)
+			expectSagas(grpc)
+			token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+			grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(&pb.GetUserResponse{User: &pb.User{ID: 1}}, nil)
+			grpc.EXPECT().GetItem(gomock.Any(), gomock.Any()).Return(&pb.GetItemResponse{Item: &pb.Item{ID: 3, Price: usd(500)}}, nil)
+			grpc.EXPECT().GetPlan(gomock.Any(), gomock.Any()).Return(&pb.GetPlanResponse{Plan: &pb.Plan{}}, nil)
+			grpc.EXPECT().GetCouponByCode(gomock.Any(), gomock.Eq(&pb.GetCouponByCodeRequest{Code: "TENOFF"})).Return(&pb.CouponResponse{Coupon: coupon}, nil)
+			tc.buildStubs(grpc)
+
+			server := NewTestServer(t, grpc)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(gin.H{"member_id": 1, "item_id": 3, "quantity": 2, "total": 1000, "coupon_code": "tenoff"})
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/entry/create", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthHeader(request, token)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/machearn/galaxy_controller/money"
+	"github.com/machearn/galaxy_controller/pb"
+)
+
+// storeCurrency is the currency of users without a preference.
+func (server *Server) storeCurrency() string {
+	return money.NormalizeCurrency(server.config.PaymentsCurrency)
+}
+
+// userCurrency is the currency user is shown and charged prices in.
+func (server *Server) userCurrency(user *pb.User) string {
+	if len(user.GetCurrency()) == 0 {
+		return server.storeCurrency()
+	}
+	return money.NormalizeCurrency(user.GetCurrency())
+}
+
+// checkCurrency fails for currencies the exchange rates do not cover.
+func (server *Server) checkCurrency(currency string) error {
+	if len(currency) == 0 {
+		return errors.New("currency is required")
+	}
+	if !server.exchange.Supports(currency) {
+		return fmt.Errorf("%w %s", money.ErrUnsupportedCurrency, currency)
+	}
+	return nil
+}
+
+// checkPrices validates the base price and the prices in other currencies
+// of an item.
+func (server *Server) checkPrices(price *money.Money, prices []money.Money) error {
+	if price != nil {
+		if price.Amount < 0 {
+			return errors.New("price must not be negative")
+		}
+		if err := server.checkCurrency(price.Currency); err != nil {
+			return err
+		}
+	}
+
+	seen := make(map[string]bool, len(prices))
+	for _, other := range prices {
+		currency := money.NormalizeCurrency(other.Currency)
+		if other.Amount < 0 {
+			return fmt.Errorf("price in %s must not be negative", currency)
+		}
+		if err := server.checkCurrency(currency); err != nil {
+			return err
+		}
+		if seen[currency] {
+			return fmt.Errorf("more than one price in %s", currency)
+		}
+		seen[currency] = true
+	}
+	return nil
+}
+
+// itemPrice returns the unit price of item in currency: the price set for
+// that currency if there is one, otherwise the base price converted at the
+// configured exchange rate.
+func (server *Server) itemPrice(item *pb.Item, currency string) (money.Money, error) {
+	if price, ok := money.Find(item.GetPrices(), currency); ok {
+		return price, nil
+	}
+
+	base := money.FromProto(item.GetPrice())
+	if len(base.Currency) == 0 {
+		base.Currency = server.storeCurrency()
+	}
+	return server.exchange.Convert(base, currency)
+}
+
+func moneyList(prices []money.Money) []*pb.Money {
+	res := make([]*pb.Money, len(prices))
+	for i, price := range prices {
+		res[i] = money.New(price.Amount, price.Currency).Proto()
+	}
+	return res
+}
+
+func newMoneyList(prices []*pb.Money) []money.Money {
+	res := make([]money.Money, len(prices))
+	for i, price := range prices {
+		res[i] = money.FromProto(price)
+	}
+	return res
+}
@@ -0,0 +1,266 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/machearn/galaxy_controller/money"
+	"github.com/machearn/galaxy_controller/pb"
+	mockpb "github.com/machearn/galaxy_controller/pb/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemPrice(t *testing.T) {
+	server := NewTestServer(t, nil)
+	server.exchange = newTestExchange(t)
+
+	item := &pb.Item{ID: 3, Price: usd(1000), Prices: []*pb.Money{{Amount: 450, Currency: "EUR"}}}
+
+	// a price set for the currency wins over the converted base price
+	price, err := server.itemPrice(item, "eur")
+	require.NoError(t, err)
+	require.Equal(t, money.New(450, "EUR"), price)
+
+	price, err = server.itemPrice(item, "JPY")
+	require.NoError(t, err)
+	require.Equal(t, money.New(1500, "JPY"), price)
+
+	price, err = server.itemPrice(item, "USD")
+	require.NoError(t, err)
+	require.Equal(t, dollars(1000), price)
+
+	// items priced before currencies existed are in the store currency
+	price, err = server.itemPrice(&pb.Item{ID: 4, Price: &pb.Money{Amount: 200}}, "EUR")
+	require.NoError(t, err)
+	require.Equal(t, money.New(100, "EUR"), price)
+
+	_, err = server.itemPrice(item, "GBP")
+	require.True(t, errors.Is(err, money.ErrUnsupportedCurrency))
+}
+
+func TestCheckPrices(t *testing.T) {
+	server := NewTestServer(t, nil)
+	server.exchange = newTestExchange(t)
+
+	base := dollars(1000)
+	require.NoError(t, server.checkPrices(&base, []money.Money{money.New(450, "eur"), money.New(1500, "JPY")}))
+	require.NoError(t, server.checkPrices(nil, nil))
+
+	negative := dollars(-1)
+	require.EqualError(t, server.checkPrices(&negative, nil), "price must not be negative")
+	require.EqualError(t, server.checkPrices(&money.Money{Amount: 1000}, nil), "currency is required")
+	require.EqualError(t, server.checkPrices(&base, []money.Money{money.New(450, "EUR"), money.New(400, "eur")}), "more than one price in EUR")
+	require.EqualError(t, server.checkPrices(&base, []money.Money{money.New(-450, "EUR")}), "price in EUR must not be negative")
+	require.EqualError(t, server.checkPrices(&base, []money.Money{money.New(450, "GBP")}), "unsupported currency GBP")
+}
+
+func TestUserCurrency(t *testing.T) {
+	server := NewTestServer(t, nil)
+
+	require.Equal(t, "USD", server.userCurrency(&pb.User{ID: 1}))
+	require.Equal(t, "EUR", server.userCurrency(&pb.User{ID: 1, Currency: "eur"}))
+}
+
+func TestUpdateUserAPICurrency(t *testing.T) {
+	testCases := []struct {
+		name          string
+		currency      string
+		buildStubs    func(grpc *mockpb.MockGalaxyClient)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			currency: " eur",
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				currency := "EUR"
+				grpc.EXPECT().UpdateUser(gomock.Any(), gomock.Eq(&pb.UpdateUserRequest{ID: 1, Currency: &currency})).
+					Return(&pb.UpdateUserResponse{User: &pb.User{ID: 1, Currency: currency}}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var user User
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &user))
+				require.Equal(t, "EUR", user.Currency)
+			},
+		},
+		{
+			name:     "Unsupported",
+			currency: "GBP",
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "Empty",
+			currency: "",
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			grpc := mockpb.NewMockGalaxyClient(ctrl)
+			token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+			tc.buildStubs(grpc)
+
+			server := NewTestServer(t, grpc)
+			server.exchange = newTestExchange(t)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(gin.H{"id": 1, "currency": tc.currency})
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/user/update", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthHeader(request, token)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestCreateItemAPIPrices(t *testing.T) {
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(grpc *mockpb.MockGalaxyClient)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"name":   "lamp",
+				"price":  gin.H{"amount": 1000, "currency": "usd"},
+				"prices": []gin.H{{"amount": 450, "currency": "eur"}},
+			},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().CreateItem(gomock.Any(), gomock.Eq(&pb.CreateItemRequest{
+					Name:   "lamp",
+					Price:  usd(1000),
+					Prices: []*pb.Money{{Amount: 450, Currency: "EUR"}},
+				})).Return(&pb.CreateItemResponse{Item: &pb.Item{
+					ID:     3,
+					Name:   "lamp",
+					Price:  usd(1000),
+					Prices: []*pb.Money{{Amount: 450, Currency: "EUR"}},
+				}}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var item Item
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &item))
+				require.Equal(t, dollars(1000), item.Price)
+				require.Equal(t, []money.Money{money.New(450, "EUR")}, item.Prices)
+			},
+		},
+		{
+			name: "UnsupportedCurrency",
+			body: gin.H{
+				"name":   "lamp",
+				"price":  gin.H{"amount": 1000, "currency": "usd"},
+				"prices": []gin.H{{"amount": 400, "currency": "gbp"}},
+			},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().CreateItem(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "NoCurrency",
+			body: gin.H{"name": "lamp", "price": gin.H{"amount": 1000}},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().CreateItem(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			grpc := mockpb.NewMockGalaxyClient(ctrl)
+			token := expectAuthorize(grpc, 1, pb.Role_ROLE_ADMIN)
+			tc.buildStubs(grpc)
+
+			server := NewTestServer(t, grpc)
+			server.exchange = newTestExchange(t)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/item/create", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthHeader(request, token)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestCreateQuoteAPIInCurrency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(&pb.GetUserResponse{User: &pb.User{ID: 1, Plan: 2, Currency: "JPY"}}, nil)
+	grpc.EXPECT().GetItem(gomock.Any(), gomock.Any()).Return(&pb.GetItemResponse{
+		Item: &pb.Item{ID: 3, Name: "lamp", Price: usd(1000)},
+	}, nil)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Any()).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 2}}, nil)
+
+	server := NewTestServer(t, grpc)
+	server.exchange = newTestExchange(t)
+	recorder := httptest.NewRecorder()
+
+	// priced in the member's currency
+	data, err := json.Marshal(gin.H{"items": []gin.H{{"item_id": 3, "quantity": 2}}})
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/quote", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var quote Quote
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &quote))
+	require.Equal(t, money.New(1500, "JPY"), quote.Lines[0].UnitPrice)
+	require.Equal(t, money.New(3000, "JPY"), quote.Total)
+}
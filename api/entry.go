@@ -1,10 +1,14 @@
 package api
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/machearn/galaxy_controller/money"
+	"github.com/machearn/galaxy_controller/payments"
 	"github.com/machearn/galaxy_controller/pb"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -13,19 +17,114 @@ import (
 type CreateEntryRequest struct {
 	UserID   int32 `json:"member_id"`
 	ItemID   int32 `json:"item_id"`
-	Quantity int32 `json:"quantity"`
-	Total    int32 `json:"total"`
+	Quantity int32 `json:"quantity" binding:"required,min=1"`
+	// Total is the total the client expects to pay, in the minor units of
+	// Currency. It is optional; the total is always computed from the price
+	// of the item less the member discount of the plan, and the entry is
+	// rejected if they differ.
+	Total int64 `json:"total"`
+	// Currency is the currency to pay in, the member's currency if unset.
+	Currency string `json:"currency"`
+	// QuoteID buys at the price of a quote from /quote instead of the price
+	// of the item.
+	QuoteID string `json:"quote_id"`
+	// CouponCode takes the discount of a coupon off the total.
+	CouponCode string `json:"coupon_code"`
+	// Region is the tax region, the default region of the tax rules if unset.
+	Region string `json:"region"`
+	// PayWithWallet pays the entry out of the member's wallet instead of
+	// through the payment provider.
+	PayWithWallet bool `json:"pay_with_wallet"`
+	// GiftCardCode pays as much of the total as its balance covers with a
+	// gift card.
+	GiftCardCode string `json:"gift_card_code"`
+	// Points redeems loyalty points for a discount.
+	Points int64 `json:"points" binding:"min=0"`
 }
 
 type Entry struct {
-	ID        int32     `json:"id"`
-	UserID    int32     `json:"member_id"`
-	ItemID    int32     `json:"item_id"`
-	Quantity  int32     `json:"quantity"`
-	Total     int32     `json:"total"`
+	ID        int32       `json:"id"`
+	UserID    int32       `json:"member_id"`
+	ItemID    int32       `json:"item_id"`
+	Quantity  int32       `json:"quantity"`
+	Total     money.Money `json:"total"`
+	Status    string      `json:"status"`
+	OrderID   int32       `json:"order_id,omitempty"`
+	Discount  money.Money `json:"discount"`
+	CouponID  int32       `json:"coupon_id,omitempty"`
+	Tax       money.Money `json:"tax"`
+	TaxRegion string      `json:"tax_region,omitempty"`
+	CreatedAt time.Time   `json:"created_at"`
+
+	PaidFromWallet bool         `json:"paid_from_wallet,omitempty"`
+	GiftCardID     int32        `json:"gift_card_id,omitempty"`
+	GiftCardAmount *money.Money `json:"gift_card_amount,omitempty"`
+	PointsRedeemed int64        `json:"points_redeemed,omitempty"`
+	PointsDiscount *money.Money `json:"points_discount,omitempty"`
+
+	Transitions []EntryTransition `json:"transitions,omitempty"`
+}
+
+type EntryTransition struct {
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	ActorID   int32     `json:"actor_id,omitempty"`
+	Reason    string    `json:"reason,omitempty"`
 	CreatedAt time.Time `json:"created_at"`
 }
 
+func newEntryResponse(entry *pb.Entry) Entry {
+	res := Entry{
+		ID:        entry.GetID(),
+		UserID:    entry.GetUserId(),
+		ItemID:    entry.GetItemId(),
+		Quantity:  entry.GetQuantity(),
+		Total:     money.FromProto(entry.GetTotal()),
+		Status:    entryStatusNames[entry.GetStatus()],
+		OrderID:   entry.GetOrderId(),
+		Discount:  money.FromProto(entry.GetDiscount()),
+		CouponID:  entry.GetCouponId(),
+		Tax:       money.FromProto(entry.GetTax()),
+		TaxRegion: entry.GetTaxRegion(),
+		CreatedAt: entry.GetCreatedAt().AsTime(),
+
+		PaidFromWallet: entry.GetPaidFromWallet(),
+		GiftCardID:     entry.GetGiftCardId(),
+	}
+	if entry.GetGiftCardId() != 0 {
+		giftCardAmount := money.FromProto(entry.GetGiftCardAmount())
+		res.GiftCardAmount = &giftCardAmount
+	}
+	if entry.GetPointsRedeemed() != 0 {
+		pointsDiscount := money.FromProto(entry.GetPointsDiscount())
+		res.PointsDiscount = &pointsDiscount
+		res.PointsRedeemed = entry.GetPointsRedeemed()
+	}
+	for _, transition := range entry.GetTransitions() {
+		res.Transitions = append(res.Transitions, EntryTransition{
+			From:      entryStatusNames[transition.GetFromStatus()],
+			To:        entryStatusNames[transition.GetToStatus()],
+			ActorID:   transition.GetActorId(),
+			Reason:    transition.GetReason(),
+			CreatedAt: transition.GetCreatedAt().AsTime(),
+		})
+	}
+	return res
+}
+
+type CreateEntryResponse struct {
+	Entry
+	// PaymentClientSecret lets the client confirm the payment of a pending
+	// entry with the payment provider.
+	PaymentClientSecret string `json:"payment_client_secret,omitempty"`
+	// GiftCardCode is the code of the gift card bought by the entry.
+	GiftCardCode string `json:"gift_card_code,omitempty"`
+}
+
+// CreateEntry buys an item for a member. Members can only buy for
+// themselves, staff for anyone, but loyalty points can only be redeemed by
+// the member who earned them. Gift card items are always sold at their
+// price, which becomes the value of the gift card issued to the member.
 func (server *Server) CreateEntry(ctx *gin.Context) {
 	var req CreateEntryRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -33,7 +132,33 @@ func (server *Server) CreateEntry(ctx *gin.Context) {
 		return
 	}
 
-	_, err := server.grpc.GetUser(ctx, &pb.GetUserRequest{ID: req.UserID})
+	authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+	if req.UserID != authPayload.UserID && authPayload.Role == pb.Role_ROLE_MEMBER {
+		ctx.JSON(http.StatusForbidden, errorResponse(errors.New("you are not allowed to access this resource")))
+		return
+	}
+	if req.Points > 0 && req.UserID != authPayload.UserID {
+		ctx.JSON(http.StatusForbidden, errorResponse(errors.New("only the member can redeem their points")))
+		return
+	}
+
+	region, err := server.tax.Region(req.Region)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var quoted *money.Money
+	if len(req.QuoteID) > 0 {
+		total, err := server.verifyQuote(ctx, req.QuoteID, req.UserID, req.ItemID, req.Quantity, region, req.Currency, req.CouponCode)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+		quoted = &total
+	}
+
+	userResult, err := server.grpc.GetUser(ctx, &pb.GetUserRequest{ID: req.UserID})
 	if err != nil {
 		if apiErr, ok := status.FromError(err); ok {
 			if apiErr.Code() == codes.NotFound {
@@ -47,7 +172,12 @@ func (server *Server) CreateEntry(ctx *gin.Context) {
 		return
 	}
 
-	_, err = server.grpc.GetItem(ctx, &pb.GetItemRequest{Id: req.ItemID})
+	if !inTenant(ctx, userResult.GetUser().GetTeamId()) {
+		ctx.JSON(http.StatusNotFound, errorResponse(errors.New("user not found")))
+		return
+	}
+
+	itemResult, err := server.grpc.GetItem(tenantContext(ctx), &pb.GetItemRequest{Id: req.ItemID})
 	if err != nil {
 		if apiErr, ok := status.FromError(err); ok {
 			if apiErr.Code() == codes.NotFound {
@@ -61,18 +191,231 @@ func (server *Server) CreateEntry(ctx *gin.Context) {
 		return
 	}
 
-	grpcReq := pb.CreateEntryRequest{
-		UserId:   req.UserID,
-		ItemId:   req.ItemID,
-		Quantity: req.Quantity,
-		Total:    req.Total,
+	item := itemResult.GetItem()
+	if !inTenant(ctx, item.GetTenantId()) {
+		ctx.JSON(http.StatusNotFound, errorResponse(errors.New("item not found")))
+		return
+	}
+	if item.GetGiftCard() {
+		if quoted != nil || len(req.CouponCode) > 0 || req.Points > 0 {
+			ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("gift cards cannot be discounted")))
+			return
+		}
+		if len(req.GiftCardCode) > 0 {
+			ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("gift cards cannot be paid with a gift card")))
+			return
+		}
 	}
 
-	result, err := server.grpc.CreateEntry(ctx, &grpcReq)
+	planID, err := server.subscriptionPlan(ctx, userResult.GetUser())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	plan, err := server.lookupPlan(ctx, planID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	quota := plan.GetMonthlyEntryQuota()
+
+	var total money.Money
+	if quoted != nil {
+		total = *quoted
+	} else {
+		currency := money.NormalizeCurrency(req.Currency)
+		if len(currency) == 0 {
+			currency = server.userCurrency(userResult.GetUser())
+		}
+		if err := server.checkCurrency(currency); err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+
+		price, err := server.itemPrice(item, currency)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+		total = money.New(price.Amount*int64(req.Quantity), currency)
+		if !item.GetGiftCard() {
+			// as a quote would have priced it
+			total.Amount -= percentOf(total.Amount, plan.GetMemberDiscountPercent())
+		}
+	}
+	if req.Total != 0 && req.Total != total.Amount {
+		ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("total does not match the price of %d %s", req.Quantity, item.GetName())))
+		return
+	}
+
+	var giftCardValue int64
+	if item.GetGiftCard() {
+		giftCardValue = total.Amount
+	}
+
+	var coupon *pb.Coupon
+	discount := money.New(0, total.Currency)
+	if len(req.CouponCode) > 0 {
+		couponResult, err := server.grpc.GetCouponByCode(ctx, &pb.GetCouponByCodeRequest{Code: normalizeCouponCode(req.CouponCode)})
+		if err != nil {
+			if apiErr, ok := status.FromError(err); ok {
+				if apiErr.Code() == codes.NotFound {
+					ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("coupon is invalid")))
+					return
+				}
+				ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		coupon = couponResult.GetCoupon()
+		discount, err = couponDiscount(coupon, req.ItemID, planID, total, server.exchange, time.Now())
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+	}
+
+	pointsDiscount := money.New(0, total.Currency)
+	if req.Points > 0 {
+		if !server.pointsEnabled() {
+			ctx.JSON(http.StatusBadRequest, errorResponse(errPointsDisabled))
+			return
+		}
+
+		balance, err := server.grpc.GetPointsBalance(ctx, &pb.GetPointsBalanceRequest{UserId: req.UserID})
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+		if balance.GetBalance() < req.Points {
+			ctx.JSON(http.StatusBadRequest, errorResponse(errInsufficientPoints))
+			return
+		}
+
+		pointsDiscount, err = server.pointsValue(req.Points, total.Currency)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+		if pointsDiscount.Amount > total.Amount-discount.Amount {
+			ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("points are worth more than the total")))
+			return
+		}
+	}
+
+	taxResult, err := server.tax.Calculate(region, req.ItemID, total.Amount-discount.Amount-pointsDiscount.Amount)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	var giftCard *pb.GiftCard
+	var giftCardAmount int64
+	if len(req.GiftCardCode) > 0 {
+		card, purchase, err := server.lookupGiftCard(ctx, req.GiftCardCode)
+		if err != nil {
+			if errors.Is(err, errInvalidGiftCard) {
+				ctx.JSON(http.StatusBadRequest, errorResponse(err))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+		if err := checkGiftCard(card, purchase, time.Now()); err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+
+		cardBalance := money.FromProto(card.GetBalance())
+		if cardBalance.Currency != total.Currency {
+			ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("gift card is in %s", cardBalance.Currency)))
+			return
+		}
+		giftCard = card
+		giftCardAmount = cardBalance.Amount
+		if giftCardAmount > taxResult.Total {
+			giftCardAmount = taxResult.Total
+		}
+	}
+
+	if req.PayWithWallet {
+		balance, err := server.walletBalance(ctx, userResult.GetUser())
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+		if balance.Currency != total.Currency {
+			ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("wallet balance is in %s", balance.Currency)))
+			return
+		}
+		if balance.Amount < taxResult.Total-giftCardAmount {
+			ctx.JSON(http.StatusPaymentRequired, errorResponse(errInsufficientBalance))
+			return
+		}
+	}
+
+	period, resetAt := usagePeriod(time.Now())
+	data := newPurchaseData(authPayload.TenantID, req.UserID, 1, quota, period, money.New(taxResult.Total, total.Currency))
+	if req.PayWithWallet {
+		payFromWallet(data)
+	}
+	if giftCard != nil {
+		payWithGiftCard(data, giftCard.GetID(), giftCardAmount)
+	}
+	data.SetInt64("gift_card_value", giftCardValue)
+	data.SetInt32("item_id", req.ItemID)
+	data.SetInt32("quantity", req.Quantity)
+	if coupon != nil {
+		data.SetInt32("coupon_id", coupon.GetID())
+		data.SetInt64("discount", discount.Amount)
+	}
+	if req.Points > 0 {
+		data.SetInt64("points", req.Points)
+		data.SetInt64("points_discount", pointsDiscount.Amount)
+	}
+	setTaxData(data, taxResult)
+
+	var res entrySagaResult
+	if err := server.sagas.Run(ctx, server.createEntrySaga(&res), data); err != nil {
+		writePurchaseError(ctx, err, quota, resetAt)
+		return
+	}
+	if res.entry.GetStatus() == pb.EntryStatus_ENTRY_STATUS_PAID {
+		server.logAwardPoints(ctx, res.entry)
+	}
+
+	setPurchaseQuotaHeaders(ctx, data, resetAt)
+	ctx.JSON(http.StatusOK, CreateEntryResponse{
+		Entry:               newEntryResponse(res.entry),
+		PaymentClientSecret: data["client_secret"],
+		GiftCardCode:        res.giftCard.GetCode(),
+	})
+}
+
+type CaptureEntryRequest struct {
+	ID int32 `json:"id" binding:"required,min=1"`
+}
+
+// CaptureEntry takes the money authorized for a pending entry. Members can
+// only capture their own entries; staff and admins can capture any. The entry
+// is marked paid once the capture succeeds; captures the provider completes
+// asynchronously are confirmed by the payments webhook instead.
+func (server *Server) CaptureEntry(ctx *gin.Context) {
+	var req CaptureEntryRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	result, err := server.grpc.GetEntry(tenantContext(ctx), &pb.GetEntryRequest{Id: req.ID})
 	if err != nil {
 		if apiErr, ok := status.FromError(err); ok {
-			if apiErr.Code() == codes.InvalidArgument {
-				ctx.JSON(http.StatusBadRequest, errorResponse(apiErr.Err()))
+			if apiErr.Code() == codes.NotFound {
+				ctx.JSON(http.StatusNotFound, errorResponse(apiErr.Err()))
 				return
 			}
 			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
@@ -83,14 +426,56 @@ func (server *Server) CreateEntry(ctx *gin.Context) {
 	}
 
 	entry := result.GetEntry()
-	ctx.JSON(http.StatusOK, Entry{
-		ID:        entry.ID,
-		UserID:    entry.UserId,
-		ItemID:    entry.ItemId,
-		Quantity:  entry.Quantity,
-		Total:     entry.Total,
-		CreatedAt: entry.CreatedAt.AsTime(),
+	if !inTenant(ctx, entry.GetTenantId()) {
+		ctx.JSON(http.StatusNotFound, errorResponse(errors.New("entry not found")))
+		return
+	}
+	authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+	if entry.GetUserId() != authPayload.UserID && authPayload.Role == pb.Role_ROLE_MEMBER {
+		ctx.JSON(http.StatusForbidden, errorResponse(errors.New("you are not allowed to access this resource")))
+		return
+	}
+	if entry.GetStatus() != pb.EntryStatus_ENTRY_STATUS_PENDING || len(entry.GetPaymentIntentId()) == 0 {
+		ctx.JSON(http.StatusConflict, errorResponse(errors.New("entry has no payment to capture")))
+		return
+	}
+
+	intent, err := server.payments.Capture(ctx, entry.GetPaymentIntentId())
+	if err != nil {
+		switch {
+		case errors.Is(err, payments.ErrDeclined):
+			ctx.JSON(http.StatusPaymentRequired, errorResponse(err))
+		case errors.Is(err, payments.ErrInvalidState):
+			ctx.JSON(http.StatusConflict, errorResponse(errors.New("payment has not been authorized yet")))
+		default:
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		}
+		return
+	}
+
+	if intent.Status != payments.IntentSucceeded {
+		ctx.JSON(http.StatusAccepted, newEntryResponse(entry))
+		return
+	}
+
+	updateResult, err := server.grpc.UpdateEntryStatus(tenantContext(ctx), &pb.UpdateEntryStatusRequest{
+		Id:             entry.GetID(),
+		Status:         pb.EntryStatus_ENTRY_STATUS_PAID,
+		ExpectedStatus: pb.EntryStatus_ENTRY_STATUS_PENDING,
+		ActorId:        authPayload.UserID,
+		Reason:         "payment captured",
 	})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok && apiErr.Code() == codes.FailedPrecondition {
+			ctx.JSON(http.StatusConflict, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	server.logAwardPoints(ctx, updateResult.GetEntry())
+
+	ctx.JSON(http.StatusOK, newEntryResponse(updateResult.GetEntry()))
 }
 
 type GetEntryRequest struct {
@@ -104,7 +489,7 @@ func (server *Server) GetEntry(ctx *gin.Context) {
 		return
 	}
 
-	result, err := server.grpc.GetEntry(ctx, &pb.GetEntryRequest{Id: req.ID})
+	result, err := server.grpc.GetEntry(tenantContext(ctx), &pb.GetEntryRequest{Id: req.ID})
 	if err != nil {
 		if apiErr, ok := status.FromError(err); ok {
 			if apiErr.Code() == codes.NotFound {
@@ -119,19 +504,18 @@ func (server *Server) GetEntry(ctx *gin.Context) {
 	}
 
 	entry := result.GetEntry()
-	ctx.JSON(http.StatusOK, Entry{
-		ID:        entry.ID,
-		UserID:    entry.UserId,
-		ItemID:    entry.ItemId,
-		Quantity:  entry.Quantity,
-		Total:     entry.Total,
-		CreatedAt: entry.CreatedAt.AsTime(),
-	})
+	if !inTenant(ctx, entry.GetTenantId()) {
+		ctx.JSON(http.StatusNotFound, errorResponse(errors.New("entry not found")))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newEntryResponse(entry))
 }
 
 type ListEntriesRequest struct {
-	Offset int32 `json:"offset"`
-	Limit  int32 `json:"limit"`
+	Offset int32  `json:"offset"`
+	Limit  int32  `json:"limit"`
+	Status string `json:"status"`
 }
 
 type ListEntriesResponse struct {
@@ -145,12 +529,20 @@ func (server *Server) ListEntries(ctx *gin.Context) {
 		return
 	}
 
+	statusFilter, err := parseEntryStatusFilter(req.Status)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
 	grpcReq := pb.ListEntriesRequest{
-		Offset: req.Offset,
-		Limit:  req.Limit,
+		Status:   statusFilter,
+		Offset:   req.Offset,
+		Limit:    req.Limit,
+		TenantId: requestTenant(ctx),
 	}
 
-	result, err := server.grpc.ListEntries(ctx, &grpcReq)
+	result, err := server.grpc.ListEntries(tenantContext(ctx), &grpcReq)
 	if err != nil {
 		if apiErr, ok := status.FromError(err); ok {
 			if apiErr.Code() == codes.NotFound {
@@ -165,16 +557,9 @@ func (server *Server) ListEntries(ctx *gin.Context) {
 	}
 
 	rows := result.GetEntries()
-	entries := make([]Entry, len(rows))
-	for i, row := range rows {
-		entries[i] = Entry{
-			ID:        row.ID,
-			UserID:    row.UserId,
-			ItemID:    row.ItemId,
-			Quantity:  row.Quantity,
-			Total:     row.Total,
-			CreatedAt: row.CreatedAt.AsTime(),
-		}
+	entries := make([]Entry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, newEntryResponse(row))
 	}
 
 	ctx.JSON(http.StatusOK, ListEntriesResponse{
@@ -183,9 +568,10 @@ func (server *Server) ListEntries(ctx *gin.Context) {
 }
 
 type ListEntriesByUserRequest struct {
-	UserID int32 `json:"user_id"`
-	Offset int32 `json:"offset"`
-	Limit  int32 `json:"limit"`
+	UserID int32  `json:"user_id"`
+	Offset int32  `json:"offset"`
+	Limit  int32  `json:"limit"`
+	Status string `json:"status"`
 }
 
 func (server *Server) ListEntriesByUser(ctx *gin.Context) {
@@ -195,13 +581,21 @@ func (server *Server) ListEntriesByUser(ctx *gin.Context) {
 		return
 	}
 
+	statusFilter, err := parseEntryStatusFilter(req.Status)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
 	grpcReq := pb.ListEntriesByUserRequest{
-		UserId: req.UserID,
-		Offset: req.Offset,
-		Limit:  req.Limit,
+		Status:   statusFilter,
+		UserId:   req.UserID,
+		Offset:   req.Offset,
+		Limit:    req.Limit,
+		TenantId: requestTenant(ctx),
 	}
 
-	result, err := server.grpc.ListEntriesByUser(ctx, &grpcReq)
+	result, err := server.grpc.ListEntriesByUser(tenantContext(ctx), &grpcReq)
 	if err != nil {
 		if apiErr, ok := status.FromError(err); ok {
 			if apiErr.Code() == codes.NotFound {
@@ -216,16 +610,9 @@ func (server *Server) ListEntriesByUser(ctx *gin.Context) {
 	}
 
 	rows := result.GetEntries()
-	entries := make([]Entry, len(rows))
-	for i, row := range rows {
-		entries[i] = Entry{
-			ID:        row.ID,
-			UserID:    row.UserId,
-			ItemID:    row.ItemId,
-			Quantity:  row.Quantity,
-			Total:     row.Total,
-			CreatedAt: row.CreatedAt.AsTime(),
-		}
+	entries := make([]Entry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, newEntryResponse(row))
 	}
 
 	ctx.JSON(http.StatusOK, ListEntriesResponse{
@@ -234,9 +621,10 @@ func (server *Server) ListEntriesByUser(ctx *gin.Context) {
 }
 
 type ListEntriesByItemRequest struct {
-	ItemID int32 `json:"item_id"`
-	Offset int32 `json:"offset"`
-	Limit  int32 `json:"limit"`
+	ItemID int32  `json:"item_id"`
+	Offset int32  `json:"offset"`
+	Limit  int32  `json:"limit"`
+	Status string `json:"status"`
 }
 
 func (server *Server) ListEntriesByItem(ctx *gin.Context) {
@@ -246,13 +634,21 @@ func (server *Server) ListEntriesByItem(ctx *gin.Context) {
 		return
 	}
 
+	statusFilter, err := parseEntryStatusFilter(req.Status)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
 	grpcReq := pb.ListEntriesByItemRequest{
-		ItemId: req.ItemID,
-		Offset: req.Offset,
-		Limit:  req.Limit,
+		Status:   statusFilter,
+		ItemId:   req.ItemID,
+		Offset:   req.Offset,
+		Limit:    req.Limit,
+		TenantId: requestTenant(ctx),
 	}
 
-	result, err := server.grpc.ListEntriesByItem(ctx, &grpcReq)
+	result, err := server.grpc.ListEntriesByItem(tenantContext(ctx), &grpcReq)
 	if err != nil {
 		if apiErr, ok := status.FromError(err); ok {
 			if apiErr.Code() == codes.NotFound {
@@ -267,16 +663,9 @@ func (server *Server) ListEntriesByItem(ctx *gin.Context) {
 	}
 
 	rows := result.GetEntries()
-	entries := make([]Entry, len(rows))
-	for i, row := range rows {
-		entries[i] = Entry{
-			ID:        row.ID,
-			UserID:    row.UserId,
-			ItemID:    row.ItemId,
-			Quantity:  row.Quantity,
-			Total:     row.Total,
-			CreatedAt: row.CreatedAt.AsTime(),
-		}
+	entries := make([]Entry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, newEntryResponse(row))
 	}
 
 	ctx.JSON(http.StatusOK, ListEntriesResponse{
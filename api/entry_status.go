@@ -0,0 +1,225 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/machearn/galaxy_controller/money"
+	"github.com/machearn/galaxy_controller/payments"
+	"github.com/machearn/galaxy_controller/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var entryStatusNames = map[pb.EntryStatus]string{
+	pb.EntryStatus_ENTRY_STATUS_PENDING:   "pending",
+	pb.EntryStatus_ENTRY_STATUS_PAID:      "paid",
+	pb.EntryStatus_ENTRY_STATUS_FULFILLED: "fulfilled",
+	pb.EntryStatus_ENTRY_STATUS_CANCELLED: "cancelled",
+	pb.EntryStatus_ENTRY_STATUS_REFUNDED:  "refunded",
+}
+
+var entryStatuses = map[string]pb.EntryStatus{
+	"pending":   pb.EntryStatus_ENTRY_STATUS_PENDING,
+	"paid":      pb.EntryStatus_ENTRY_STATUS_PAID,
+	"fulfilled": pb.EntryStatus_ENTRY_STATUS_FULFILLED,
+	"cancelled": pb.EntryStatus_ENTRY_STATUS_CANCELLED,
+	"refunded":  pb.EntryStatus_ENTRY_STATUS_REFUNDED,
+}
+
+// entryTransitions lists the states an entry may move to from each state.
+// Cancelled and refunded entries are final.
+var entryTransitions = map[pb.EntryStatus][]pb.EntryStatus{
+	pb.EntryStatus_ENTRY_STATUS_PENDING: {
+		pb.EntryStatus_ENTRY_STATUS_PAID,
+		pb.EntryStatus_ENTRY_STATUS_CANCELLED,
+	},
+	pb.EntryStatus_ENTRY_STATUS_PAID: {
+		pb.EntryStatus_ENTRY_STATUS_FULFILLED,
+		pb.EntryStatus_ENTRY_STATUS_REFUNDED,
+	},
+	pb.EntryStatus_ENTRY_STATUS_FULFILLED: {
+		pb.EntryStatus_ENTRY_STATUS_REFUNDED,
+	},
+}
+
+func canTransition(from, to pb.EntryStatus) bool {
+	for _, next := range entryTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// parseEntryStatusFilter turns the optional status filter of the entry list
+// requests into its proto form. An empty name means no filter.
+func parseEntryStatusFilter(name string) (*pb.EntryStatus, error) {
+	if name == "" {
+		return nil, nil
+	}
+	entryStatus, ok := entryStatuses[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown entry status %q", name)
+	}
+	return &entryStatus, nil
+}
+
+type UpdateEntryStatusRequest struct {
+	ID     int32  `json:"id" binding:"required,min=1"`
+	Status string `json:"status" binding:"required"`
+	Reason string `json:"reason"`
+}
+
+// UpdateEntryStatus moves an entry to the next state of its lifecycle on
+// behalf of staff. Refunding an entry that was paid through the payment
+// provider, from the wallet or with a gift card returns the money before the
+// state changes. An entry that bought a gift card is only refunded while
+// none of the card has been spent, and the card is deleted. Cancelling an
+// entry releases its payment, puts what it took from a gift card back and
+// gives back its coupon redemption and quota; it fails if the payment has
+// been captured meanwhile. Paying for an entry awards the loyalty points it
+// earns, and refunding or cancelling it reverses the points it earned or
+// redeemed.
+func (server *Server) UpdateEntryStatus(ctx *gin.Context) {
+	var req UpdateEntryStatusRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	next, ok := entryStatuses[req.Status]
+	if !ok {
+		ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("unknown entry status %q", req.Status)))
+		return
+	}
+
+	result, err := server.grpc.GetEntry(tenantContext(ctx), &pb.GetEntryRequest{Id: req.ID})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.NotFound {
+				ctx.JSON(http.StatusNotFound, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	entry := result.GetEntry()
+	if !inTenant(ctx, entry.GetTenantId()) {
+		ctx.JSON(http.StatusNotFound, errorResponse(errors.New("entry not found")))
+		return
+	}
+
+	current := entry.GetStatus()
+	if !canTransition(current, next) {
+		err := fmt.Errorf("entry cannot move from %s to %s", entryStatusNames[current], req.Status)
+		ctx.JSON(http.StatusConflict, errorResponse(err))
+		return
+	}
+
+	if next == pb.EntryStatus_ENTRY_STATUS_REFUNDED {
+		if err := server.voidIssuedGiftCard(ctx, entry); err != nil {
+			if status.Code(err) == codes.FailedPrecondition {
+				ctx.JSON(http.StatusConflict, errorResponse(errors.New("the gift card bought by the entry has been used")))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+	}
+
+	if next == pb.EntryStatus_ENTRY_STATUS_REFUNDED && entry.GetPaymentIntentId() != "" {
+		if _, err := server.payments.Refund(ctx, entry.GetPaymentIntentId(), 0); err != nil {
+			ctx.JSON(http.StatusBadGateway, errorResponse(err))
+			return
+		}
+	}
+
+	if next == pb.EntryStatus_ENTRY_STATUS_CANCELLED && entry.GetPaymentIntentId() != "" {
+		_, err := server.payments.Cancel(ctx, entry.GetPaymentIntentId())
+		if errors.Is(err, payments.ErrInvalidState) {
+			ctx.JSON(http.StatusConflict, errorResponse(errors.New("the payment of the entry has been captured")))
+			return
+		}
+		if err != nil && !errors.Is(err, payments.ErrNotFound) {
+			ctx.JSON(http.StatusBadGateway, errorResponse(err))
+			return
+		}
+	}
+
+	authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+	if next == pb.EntryStatus_ENTRY_STATUS_REFUNDED && entry.GetPaidFromWallet() {
+		_, err := server.grpc.CreateWalletTransaction(ctx, &pb.CreateWalletTransactionRequest{
+			UserId:         entry.GetUserId(),
+			Type:           pb.WalletTransactionType_WALLET_TRANSACTION_TYPE_REFUND,
+			Amount:         money.New(entry.GetTotal().GetAmount()-entry.GetGiftCardAmount().GetAmount(), entry.GetTotal().GetCurrency()).Proto(),
+			EntryId:        entry.GetID(),
+			Reason:         req.Reason,
+			CreatedBy:      authPayload.UserID,
+			IdempotencyKey: fmt.Sprintf("refund:entry:%d", entry.GetID()),
+		})
+		if err != nil && status.Code(err) != codes.AlreadyExists {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+	}
+
+	if (next == pb.EntryStatus_ENTRY_STATUS_REFUNDED || next == pb.EntryStatus_ENTRY_STATUS_CANCELLED) && entry.GetGiftCardId() != 0 {
+		if err := server.reverseGiftCardRedemptions(ctx, entry); err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+	}
+
+	if next == pb.EntryStatus_ENTRY_STATUS_CANCELLED && entry.GetCouponId() != 0 {
+		if err := server.releaseCouponRedemption(ctx, entry); err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+	}
+
+	if next == pb.EntryStatus_ENTRY_STATUS_CANCELLED {
+		if err := server.releaseUsage(ctx, entry); err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+	}
+
+	if next == pb.EntryStatus_ENTRY_STATUS_REFUNDED || next == pb.EntryStatus_ENTRY_STATUS_CANCELLED {
+		if err := server.reversePoints(ctx, entry, req.Reason); err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+	}
+
+	updateResult, err := server.grpc.UpdateEntryStatus(tenantContext(ctx), &pb.UpdateEntryStatusRequest{
+		Id:             entry.GetID(),
+		Status:         next,
+		ExpectedStatus: current,
+		ActorId:        authPayload.UserID,
+		Reason:         req.Reason,
+	})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.FailedPrecondition {
+				ctx.JSON(http.StatusConflict, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if next == pb.EntryStatus_ENTRY_STATUS_PAID {
+		server.logAwardPoints(ctx, updateResult.GetEntry())
+	}
+
+	ctx.JSON(http.StatusOK, newEntryResponse(updateResult.GetEntry()))
+}
@@ -0,0 +1,377 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/machearn/galaxy_controller/payments"
+	"github.com/machearn/galaxy_controller/pb"
+	mockpb "github.com/machearn/galaxy_controller/pb/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCanTransition(t *testing.T) {
+	testCases := []struct {
+		from pb.EntryStatus
+		to   pb.EntryStatus
+		ok   bool
+	}{
+		{pb.EntryStatus_ENTRY_STATUS_PENDING, pb.EntryStatus_ENTRY_STATUS_PAID, true},
+		{pb.EntryStatus_ENTRY_STATUS_PENDING, pb.EntryStatus_ENTRY_STATUS_CANCELLED, true},
+		{pb.EntryStatus_ENTRY_STATUS_PENDING, pb.EntryStatus_ENTRY_STATUS_FULFILLED, false},
+		{pb.EntryStatus_ENTRY_STATUS_PENDING, pb.EntryStatus_ENTRY_STATUS_REFUNDED, false},
+		{pb.EntryStatus_ENTRY_STATUS_PAID, pb.EntryStatus_ENTRY_STATUS_FULFILLED, true},
+		{pb.EntryStatus_ENTRY_STATUS_PAID, pb.EntryStatus_ENTRY_STATUS_REFUNDED, true},
+		{pb.EntryStatus_ENTRY_STATUS_PAID, pb.EntryStatus_ENTRY_STATUS_CANCELLED, false},
+		{pb.EntryStatus_ENTRY_STATUS_FULFILLED, pb.EntryStatus_ENTRY_STATUS_REFUNDED, true},
+		{pb.EntryStatus_ENTRY_STATUS_FULFILLED, pb.EntryStatus_ENTRY_STATUS_PAID, false},
+		{pb.EntryStatus_ENTRY_STATUS_CANCELLED, pb.EntryStatus_ENTRY_STATUS_PAID, false},
+		{pb.EntryStatus_ENTRY_STATUS_REFUNDED, pb.EntryStatus_ENTRY_STATUS_PAID, false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.ok, canTransition(tc.from, tc.to), "%s -> %s", tc.from, tc.to)
+	}
+}
+
+func TestUpdateEntryStatusAPI(t *testing.T) {
+	testCases := []struct {
+		name          string
+		body          gin.H
+		role          pb.Role
+		buildStubs    func(grpc *mockpb.MockGalaxyClient)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Fulfill",
+			body: gin.H{"id": 4, "status": "fulfilled", "reason": "shipped"},
+			role: pb.Role_ROLE_STAFF,
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetEntry(gomock.Any(), gomock.Eq(&pb.GetEntryRequest{Id: 4})).Return(&pb.GetEntryResponse{
+					Entry: &pb.Entry{ID: 4, Status: pb.EntryStatus_ENTRY_STATUS_PAID},
+				}, nil)
+				grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Eq(&pb.UpdateEntryStatusRequest{
+					Id:             4,
+					Status:         pb.EntryStatus_ENTRY_STATUS_FULFILLED,
+					ExpectedStatus: pb.EntryStatus_ENTRY_STATUS_PAID,
+					ActorId:        1,
+					Reason:         "shipped",
+				})).Return(&pb.UpdateEntryResponse{
+					Entry: &pb.Entry{
+						ID:     4,
+						Status: pb.EntryStatus_ENTRY_STATUS_FULFILLED,
+						Transitions: []*pb.EntryTransition{
+							{FromStatus: pb.EntryStatus_ENTRY_STATUS_PENDING, ToStatus: pb.EntryStatus_ENTRY_STATUS_PAID, CreatedAt: timestamppb.Now()},
+							{FromStatus: pb.EntryStatus_ENTRY_STATUS_PAID, ToStatus: pb.EntryStatus_ENTRY_STATUS_FULFILLED, ActorId: 1, Reason: "shipped", CreatedAt: timestamppb.Now()},
+						},
+					},
+				}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var entry Entry
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &entry))
+				require.Equal(t, "fulfilled", entry.Status)
+				require.Len(t, entry.Transitions, 2)
+				require.Equal(t, "paid", entry.Transitions[1].From)
+				require.Equal(t, "fulfilled", entry.Transitions[1].To)
+				require.Equal(t, int32(1), entry.Transitions[1].ActorID)
+				require.Equal(t, "shipped", entry.Transitions[1].Reason)
+			},
+		},
+		{
+			name: "InvalidTransition",
+			body: gin.H{"id": 4, "status": "paid"},
+			role: pb.Role_ROLE_ADMIN,
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetEntry(gomock.Any(), gomock.Any()).Return(&pb.GetEntryResponse{
+					Entry: &pb.Entry{ID: 4, Status: pb.EntryStatus_ENTRY_STATUS_CANCELLED},
+				}, nil)
+				grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "ConcurrentChange",
+			body: gin.H{"id": 4, "status": "cancelled"},
+			role: pb.Role_ROLE_STAFF,
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetEntry(gomock.Any(), gomock.Any()).Return(&pb.GetEntryResponse{
+					Entry: &pb.Entry{ID: 4, Status: pb.EntryStatus_ENTRY_STATUS_PENDING},
+				}, nil)
+				grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any())
+				grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.FailedPrecondition, "entry status changed"))
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "UnknownStatus",
+			body: gin.H{"id": 4, "status": "shipped"},
+			role: pb.Role_ROLE_STAFF,
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetEntry(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "NotFound",
+			body: gin.H{"id": 4, "status": "paid"},
+			role: pb.Role_ROLE_STAFF,
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetEntry(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.NotFound, "entry not found"))
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Forbidden",
+			body: gin.H{"id": 4, "status": "paid"},
+			role: pb.Role_ROLE_MEMBER,
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetEntry(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			grpc := mockpb.NewMockGalaxyClient(ctrl)
+			token := expectAuthorize(grpc, 1, tc.role)
+			tc.buildStubs(grpc)
+
+			server := NewTestServer(t, grpc)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/entry/status", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthHeader(request, token)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestUpdateEntryStatusAPIRefund(t *testing.T) {
+	provider := payments.NewFakeProvider()
+	intent, err := provider.CreateIntent(context.Background(), payments.CreateIntentRequest{Amount: 500, Currency: "usd"})
+	require.NoError(t, err)
+	_, err = provider.Capture(context.Background(), intent.ID)
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_STAFF)
+	grpc.EXPECT().GetEntry(gomock.Any(), gomock.Any()).Return(&pb.GetEntryResponse{
+		Entry: &pb.Entry{ID: 4, Total: usd(500), Status: pb.EntryStatus_ENTRY_STATUS_FULFILLED, PaymentIntentId: intent.ID},
+	}, nil)
+	expectNoIssuedGiftCard(grpc)
+	grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Eq(&pb.UpdateEntryStatusRequest{
+		Id:             4,
+		Status:         pb.EntryStatus_ENTRY_STATUS_REFUNDED,
+		ExpectedStatus: pb.EntryStatus_ENTRY_STATUS_FULFILLED,
+		ActorId:        1,
+		Reason:         "damaged",
+	})).Return(&pb.UpdateEntryResponse{
+		Entry: &pb.Entry{ID: 4, Total: usd(500), Status: pb.EntryStatus_ENTRY_STATUS_REFUNDED},
+	}, nil)
+
+	server := NewTestServer(t, grpc)
+	server.payments = provider
+	recorder := httptest.NewRecorder()
+
+	data, err := json.Marshal(gin.H{"id": 4, "status": "refunded", "reason": "damaged"})
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/entry/status", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	// the whole amount went back, so nothing is left to refund
+	_, err = provider.Refund(context.Background(), intent.ID, 0)
+	require.ErrorIs(t, err, payments.ErrInvalidState)
+}
+
+func TestUpdateEntryStatusAPICancel(t *testing.T) {
+	provider := payments.NewFakeProvider()
+	intent, err := provider.CreateIntent(context.Background(), payments.CreateIntentRequest{Amount: 300, Currency: "usd"})
+	require.NoError(t, err)
+
+	createdAt := time.Date(2026, time.March, 31, 23, 0, 0, 0, time.UTC)
+	entry := &pb.Entry{
+		ID:              4,
+		UserId:          2,
+		Total:           usd(500),
+		Status:          pb.EntryStatus_ENTRY_STATUS_PENDING,
+		PaymentIntentId: intent.ID,
+		CouponId:        7,
+		GiftCardId:      9,
+		GiftCardAmount:  usd(200),
+		CreatedAt:       timestamppb.New(createdAt),
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_STAFF)
+	grpc.EXPECT().GetEntry(gomock.Any(), gomock.Any()).Return(&pb.GetEntryResponse{Entry: entry}, nil)
+	grpc.EXPECT().ListGiftCardRedemptions(gomock.Any(), gomock.Eq(&pb.ListGiftCardRedemptionsRequest{
+		GiftCardId: 9,
+		EntryId:    4,
+	})).Return(&pb.ListGiftCardRedemptionsResponse{
+		Redemptions: []*pb.GiftCardRedemption{{ID: 11, GiftCardId: 9, EntryId: 4, Amount: usd(200)}},
+	}, nil)
+	grpc.EXPECT().ReverseGiftCardRedemption(gomock.Any(), gomock.Eq(&pb.ReverseGiftCardRedemptionRequest{Id: 11}))
+	grpc.EXPECT().ListCouponRedemptions(gomock.Any(), gomock.Eq(&pb.ListCouponRedemptionsRequest{
+		CouponId:       7,
+		IdempotencyKey: "coupon:entry:4",
+		Limit:          1,
+	})).Return(&pb.ListCouponRedemptionsResponse{
+		Redemptions: []*pb.CouponRedemption{{ID: 13, CouponId: 7, UserId: 2, EntryId: 4}},
+	}, nil)
+	grpc.EXPECT().DeleteCouponRedemption(gomock.Any(), gomock.Eq(&pb.DeleteCouponRedemptionRequest{Id: 13}))
+	grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Eq(&pb.IncrementUsageRequest{
+		UserId:         2,
+		Metric:         usageMetricEntries,
+		Period:         "2026-03",
+		Amount:         -1,
+		IdempotencyKey: "cancel:entry:4",
+	}))
+	grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Eq(&pb.UpdateEntryStatusRequest{
+		Id:             4,
+		Status:         pb.EntryStatus_ENTRY_STATUS_CANCELLED,
+		ExpectedStatus: pb.EntryStatus_ENTRY_STATUS_PENDING,
+		ActorId:        1,
+		Reason:         "out of stock",
+	})).Return(&pb.UpdateEntryResponse{
+		Entry: &pb.Entry{ID: 4, Total: usd(500), Status: pb.EntryStatus_ENTRY_STATUS_CANCELLED},
+	}, nil)
+
+	server := NewTestServer(t, grpc)
+	server.payments = provider
+	recorder := httptest.NewRecorder()
+
+	data, err := json.Marshal(gin.H{"id": 4, "status": "cancelled", "reason": "out of stock"})
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/entry/status", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	canceled, ok := provider.Intent(intent.ID)
+	require.True(t, ok)
+	require.Equal(t, payments.IntentCanceled, canceled.Status)
+}
+
+func TestUpdateEntryStatusAPICancelCaptured(t *testing.T) {
+	provider := payments.NewFakeProvider()
+	intent, err := provider.CreateIntent(context.Background(), payments.CreateIntentRequest{Amount: 500, Currency: "usd"})
+	require.NoError(t, err)
+	_, err = provider.Capture(context.Background(), intent.ID)
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_STAFF)
+	grpc.EXPECT().GetEntry(gomock.Any(), gomock.Any()).Return(&pb.GetEntryResponse{
+		Entry: &pb.Entry{ID: 4, Total: usd(500), Status: pb.EntryStatus_ENTRY_STATUS_PENDING, PaymentIntentId: intent.ID},
+	}, nil)
+	grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Times(0)
+	grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	server.payments = provider
+	recorder := httptest.NewRecorder()
+
+	data, err := json.Marshal(gin.H{"id": 4, "status": "cancelled"})
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/entry/status", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusConflict, recorder.Code)
+}
+
+func TestListEntriesAPIStatusFilter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	unknownToken := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+
+	paid := pb.EntryStatus_ENTRY_STATUS_PAID
+	grpc.EXPECT().ListEntries(gomock.Any(), gomock.Eq(&pb.ListEntriesRequest{Limit: 10, Status: &paid})).Return(&pb.ListEntriesResponse{
+		Entries: []*pb.Entry{{ID: 4, Status: paid}},
+	}, nil)
+
+	server := NewTestServer(t, grpc)
+
+	data, err := json.Marshal(gin.H{"limit": 10, "status": "paid"})
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodPost, "/entry/list", bytes.NewReader(data))
+	require.NoError(t, err)
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	data, err = json.Marshal(gin.H{"limit": 10, "status": "lost"})
+	require.NoError(t, err)
+
+	recorder = httptest.NewRecorder()
+	request, err = http.NewRequest(http.MethodPost, "/entry/list", bytes.NewReader(data))
+	require.NoError(t, err)
+	addAuthHeader(request, unknownToken)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+}
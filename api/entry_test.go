@@ -12,10 +12,13 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
+	"github.com/machearn/galaxy_controller/money"
 	"github.com/machearn/galaxy_controller/pb"
 	mockpb "github.com/machearn/galaxy_controller/pb/mock"
 	"github.com/machearn/galaxy_controller/util"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -30,10 +33,14 @@ func TestCreateEntry(t *testing.T) {
 	require.NoError(t, err)
 
 	grpcReq := pb.CreateEntryRequest{
-		UserId:   1,
-		ItemId:   1,
-		Quantity: 1,
-		Total:    1,
+		UserId:         1,
+		ItemId:         1,
+		Quantity:       1,
+		Total:          usd(1),
+		Discount:       usd(0),
+		Tax:            usd(0),
+		GiftCardAmount: usd(0),
+		PointsDiscount: usd(0),
 	}
 	createAt := time.Now().UTC().Truncate(time.Second)
 	grpcRes := pb.CreateEntryResponse{
@@ -42,7 +49,7 @@ func TestCreateEntry(t *testing.T) {
 			UserId:    1,
 			ItemId:    1,
 			Quantity:  1,
-			Total:     1,
+			Total:     usd(1),
 			CreatedAt: timestamppb.New(createAt),
 		},
 	}
@@ -61,9 +68,18 @@ func TestCreateEntry(t *testing.T) {
 	defer ctrl.Finish()
 
 	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	expectSagas(grpc)
 	grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(nil, nil)
-	grpc.EXPECT().GetItem(gomock.Any(), gomock.Any()).Return(nil, nil)
-	grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Eq(&grpcReq)).Return(&grpcRes, nil)
+	grpc.EXPECT().GetItem(gomock.Any(), gomock.Any()).Return(&pb.GetItemResponse{Item: &pb.Item{ID: 1, Price: usd(1)}}, nil)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 0})).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 0, Name: "free"}}, nil)
+	grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{UserId: 1, Count: 1}}, nil)
+	grpc.EXPECT().CreateEntry(gomock.Any(), EqKeyedRequest(&grpcReq)).Return(&grpcRes, nil)
+	intentID := "pi_fake_1"
+	grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Eq(&pb.UpdateEntryStatusRequest{
+		Id:              1,
+		Status:          pb.EntryStatus_ENTRY_STATUS_PENDING,
+		PaymentIntentId: &intentID,
+	})).Return(&pb.UpdateEntryResponse{Entry: grpcRes.Entry}, nil)
 	grpc.EXPECT().Authorize(gomock.Any(), gomock.Eq(&grpcAuthReq)).Return(&grpcAuthRes, nil)
 
 	server := NewTestServer(t, grpc)
@@ -80,18 +96,242 @@ func TestCreateEntry(t *testing.T) {
 	data, err = io.ReadAll(recoder.Body)
 	require.NoError(t, err)
 
-	var entry Entry
+	var entry CreateEntryResponse
 	err = json.Unmarshal(data, &entry)
 	require.NoError(t, err)
 
+	require.Equal(t, "pending", entry.Status)
+	require.Equal(t, "pi_fake_1_secret", entry.PaymentClientSecret)
 	require.Equal(t, int32(1), entry.ID)
 	require.Equal(t, int32(1), entry.UserID)
 	require.Equal(t, int32(1), entry.ItemID)
 	require.Equal(t, int32(1), entry.Quantity)
-	require.Equal(t, int32(1), entry.Total)
+	require.Equal(t, money.New(1, "USD"), entry.Total)
 	require.Equal(t, createAt, entry.CreatedAt)
 }
 
+func TestCreateEntryAPICreatedBefore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	expectSagas(grpc)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(nil, nil)
+	grpc.EXPECT().GetItem(gomock.Any(), gomock.Any()).Return(&pb.GetItemResponse{Item: &pb.Item{ID: 1, Price: usd(1)}}, nil)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Any()).Return(&pb.GetPlanResponse{Plan: &pb.Plan{}}, nil)
+
+	var key string
+	gomock.InOrder(
+		grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.AlreadyExists, "key used")),
+		grpc.EXPECT().GetUsage(gomock.Any(), gomock.Any()).Return(&pb.GetUsageResponse{Usage: &pb.Usage{Count: 1}}, nil),
+		grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ any, req *pb.CreateEntryRequest, _ ...any) (*pb.CreateEntryResponse, error) {
+				key = req.GetIdempotencyKey()
+				return nil, status.Error(codes.AlreadyExists, "key used")
+			}),
+		// the entry created with the key is taken as the step's result
+		grpc.EXPECT().GetEntry(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ any, req *pb.GetEntryRequest, _ ...any) (*pb.GetEntryResponse, error) {
+				require.NotEmpty(t, key)
+				require.Equal(t, key, req.GetIdempotencyKey())
+				return &pb.GetEntryResponse{Entry: &pb.Entry{ID: 4, UserId: 1, ItemId: 1, Quantity: 1, Total: usd(1)}}, nil
+			}),
+		grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ any, req *pb.UpdateEntryStatusRequest, _ ...any) (*pb.UpdateEntryResponse, error) {
+				require.Equal(t, int32(4), req.GetId())
+				return &pb.UpdateEntryResponse{Entry: &pb.Entry{ID: 4, UserId: 1, ItemId: 1, Quantity: 1, Total: usd(1)}}, nil
+			}),
+	)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	data, err := json.Marshal(gin.H{"member_id": 1, "item_id": 1, "quantity": 1})
+	require.NoError(t, err)
+	request, err := http.NewRequest(http.MethodPost, "/entry/create", bytes.NewReader(data))
+	require.NoError(t, err)
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var entry CreateEntryResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &entry))
+	require.Equal(t, int32(4), entry.ID)
+}
+
+func TestCreateEntryAPITotal(t *testing.T) {
+	testCases := []struct {
+		name       string
+		body       gin.H
+		buildStubs func(grpc *mockpb.MockGalaxyClient)
+		status     int
+	}{
+		{
+			// the item's price is charged when the client sends no total
+			name: "Computed",
+			body: gin.H{"member_id": 1, "item_id": 1, "quantity": 2},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				expectSagas(grpc)
+				grpc.EXPECT().GetPlan(gomock.Any(), gomock.Any()).Return(&pb.GetPlanResponse{Plan: &pb.Plan{}}, nil)
+				grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{Count: 1}}, nil)
+				grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, req *pb.CreateEntryRequest, _ ...any) (*pb.CreateEntryResponse, error) {
+						require.Equal(t, usd(1000), req.GetTotal())
+						require.Equal(t, pb.EntryStatus_ENTRY_STATUS_PENDING, req.GetStatus())
+						return &pb.CreateEntryResponse{Entry: &pb.Entry{ID: 1, UserId: 1, ItemId: 1, Quantity: 2, Total: req.GetTotal()}}, nil
+					})
+				grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Any()).Return(&pb.UpdateEntryResponse{Entry: &pb.Entry{ID: 1}}, nil)
+			},
+			status: http.StatusOK,
+		},
+		{
+			// the member discount of the plan applies without a quote too
+			name: "PlanDiscount",
+			body: gin.H{"member_id": 1, "item_id": 1, "quantity": 2, "total": 800},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				expectSagas(grpc)
+				grpc.EXPECT().GetPlan(gomock.Any(), gomock.Any()).Return(&pb.GetPlanResponse{Plan: &pb.Plan{MemberDiscountPercent: 20}}, nil)
+				grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{Count: 1}}, nil)
+				grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, req *pb.CreateEntryRequest, _ ...any) (*pb.CreateEntryResponse, error) {
+						require.Equal(t, usd(800), req.GetTotal())
+						return &pb.CreateEntryResponse{Entry: &pb.Entry{ID: 1, UserId: 1, ItemId: 1, Quantity: 2, Total: req.GetTotal()}}, nil
+					})
+				grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Any()).Return(&pb.UpdateEntryResponse{Entry: &pb.Entry{ID: 1}}, nil)
+			},
+			status: http.StatusOK,
+		},
+		{
+			name:   "Mismatch",
+			body:   gin.H{"member_id": 1, "item_id": 1, "quantity": 2, "total": 1},
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "Negative",
+			body:   gin.H{"member_id": 1, "item_id": 1, "quantity": 2, "total": -1000, "pay_with_wallet": true},
+			status: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			grpc := mockpb.NewMockGalaxyClient(ctrl)
+			token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+			grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(&pb.GetUserResponse{User: &pb.User{ID: 1}}, nil)
+			grpc.EXPECT().GetItem(gomock.Any(), gomock.Any()).Return(&pb.GetItemResponse{Item: &pb.Item{ID: 1, Price: usd(500)}}, nil)
+			if tc.buildStubs != nil {
+				tc.buildStubs(grpc)
+			} else {
+				grpc.EXPECT().GetPlan(gomock.Any(), gomock.Any()).Return(&pb.GetPlanResponse{Plan: &pb.Plan{}}, nil)
+				grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Times(0)
+			}
+
+			server := NewTestServer(t, grpc)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+			request, err := http.NewRequest(http.MethodPost, "/entry/create", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthHeader(request, token)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.status, recorder.Code)
+		})
+	}
+}
+
+func TestCreateEntryAPIInvalidQuantity(t *testing.T) {
+	for _, quantity := range []int32{0, -1} {
+		ctrl := gomock.NewController(t)
+
+		grpc := mockpb.NewMockGalaxyClient(ctrl)
+		token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+		grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Times(0)
+
+		server := NewTestServer(t, grpc)
+		recorder := httptest.NewRecorder()
+
+		data, err := json.Marshal(gin.H{"member_id": 1, "item_id": 1, "quantity": quantity})
+		require.NoError(t, err)
+		request, err := http.NewRequest(http.MethodPost, "/entry/create", bytes.NewReader(data))
+		require.NoError(t, err)
+
+		addAuthHeader(request, token)
+
+		server.router.ServeHTTP(recorder, request)
+		require.Equal(t, http.StatusBadRequest, recorder.Code)
+		ctrl.Finish()
+	}
+}
+
+func TestCreateEntryAPIOtherMember(t *testing.T) {
+	testCases := []struct {
+		name       string
+		role       pb.Role
+		buildStubs func(grpc *mockpb.MockGalaxyClient)
+		status     int
+	}{
+		{
+			name: "Member",
+			role: pb.Role_ROLE_MEMBER,
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(0)
+				grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Times(0)
+			},
+			status: http.StatusForbidden,
+		},
+		{
+			name: "Staff",
+			role: pb.Role_ROLE_STAFF,
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				expectSagas(grpc)
+				grpc.EXPECT().GetUser(gomock.Any(), gomock.Eq(&pb.GetUserRequest{ID: 2})).Return(&pb.GetUserResponse{User: &pb.User{ID: 2}}, nil)
+				grpc.EXPECT().GetItem(gomock.Any(), gomock.Any()).Return(&pb.GetItemResponse{Item: &pb.Item{ID: 1, Price: usd(500)}}, nil)
+				grpc.EXPECT().GetPlan(gomock.Any(), gomock.Any()).Return(&pb.GetPlanResponse{Plan: &pb.Plan{}}, nil)
+				grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{Count: 1}}, nil)
+				grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, req *pb.CreateEntryRequest, _ ...any) (*pb.CreateEntryResponse, error) {
+						require.Equal(t, int32(2), req.GetUserId())
+						return &pb.CreateEntryResponse{Entry: &pb.Entry{ID: 1, UserId: 2, ItemId: 1, Quantity: 1, Total: req.GetTotal()}}, nil
+					})
+				grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Any()).Return(&pb.UpdateEntryResponse{Entry: &pb.Entry{ID: 1}}, nil)
+			},
+			status: http.StatusOK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			grpc := mockpb.NewMockGalaxyClient(ctrl)
+			token := expectAuthorize(grpc, 1, tc.role)
+			tc.buildStubs(grpc)
+
+			server := NewTestServer(t, grpc)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(gin.H{"member_id": 2, "item_id": 1, "quantity": 1})
+			require.NoError(t, err)
+			request, err := http.NewRequest(http.MethodPost, "/entry/create", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthHeader(request, token)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.status, recorder.Code)
+		})
+	}
+}
+
 func TestGetEntry(t *testing.T) {
 	url := "/entry/get/1"
 
@@ -106,7 +346,7 @@ func TestGetEntry(t *testing.T) {
 			UserId:    1,
 			ItemId:    1,
 			Quantity:  1,
-			Total:     1,
+			Total:     usd(1),
 			CreatedAt: timestamppb.New(createdAt),
 		},
 	}
@@ -150,7 +390,7 @@ func TestGetEntry(t *testing.T) {
 	require.Equal(t, int32(1), entry.UserID)
 	require.Equal(t, int32(1), entry.ItemID)
 	require.Equal(t, int32(1), entry.Quantity)
-	require.Equal(t, int32(1), entry.Total)
+	require.Equal(t, money.New(1, "USD"), entry.Total)
 	require.Equal(t, createdAt, entry.CreatedAt)
 }
 
@@ -175,7 +415,7 @@ func TestListEntries(t *testing.T) {
 			UserId:    1,
 			ItemId:    1,
 			Quantity:  1,
-			Total:     1,
+			Total:     usd(1),
 			CreatedAt: timestamppb.New(createdAt),
 		}
 	}
@@ -226,7 +466,7 @@ func TestListEntries(t *testing.T) {
 		require.Equal(t, int32(1), res.Entries[i].UserID)
 		require.Equal(t, int32(1), res.Entries[i].ItemID)
 		require.Equal(t, int32(1), res.Entries[i].Quantity)
-		require.Equal(t, int32(1), res.Entries[i].Total)
+		require.Equal(t, money.New(1, "USD"), res.Entries[i].Total)
 		require.Equal(t, grpcEntries[i].CreatedAt.AsTime(), res.Entries[i].CreatedAt)
 	}
 }
@@ -254,7 +494,7 @@ func TestListEntriesByUser(t *testing.T) {
 			UserId:    1,
 			ItemId:    1,
 			Quantity:  1,
-			Total:     1,
+			Total:     usd(1),
 			CreatedAt: timestamppb.New(createdAt),
 		}
 	}
@@ -305,7 +545,7 @@ func TestListEntriesByUser(t *testing.T) {
 		require.Equal(t, int32(1), res.Entries[i].UserID)
 		require.Equal(t, int32(1), res.Entries[i].ItemID)
 		require.Equal(t, int32(1), res.Entries[i].Quantity)
-		require.Equal(t, int32(1), res.Entries[i].Total)
+		require.Equal(t, money.New(1, "USD"), res.Entries[i].Total)
 		require.Equal(t, grpcEntries[i].CreatedAt.AsTime(), res.Entries[i].CreatedAt)
 	}
 }
@@ -333,7 +573,7 @@ func TestListEntriesByItem(t *testing.T) {
 			UserId:    1,
 			ItemId:    1,
 			Quantity:  1,
-			Total:     1,
+			Total:     usd(1),
 			CreatedAt: timestamppb.New(createdAt),
 		}
 	}
@@ -384,7 +624,7 @@ func TestListEntriesByItem(t *testing.T) {
 		require.Equal(t, int32(1), res.Entries[i].UserID)
 		require.Equal(t, int32(1), res.Entries[i].ItemID)
 		require.Equal(t, int32(1), res.Entries[i].Quantity)
-		require.Equal(t, int32(1), res.Entries[i].Total)
+		require.Equal(t, money.New(1, "USD"), res.Entries[i].Total)
 		require.Equal(t, grpcEntries[i].CreatedAt.AsTime(), res.Entries[i].CreatedAt)
 	}
 }
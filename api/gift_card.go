@@ -0,0 +1,360 @@
+package api
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/machearn/galaxy_controller/money"
+	"github.com/machearn/galaxy_controller/pb"
+	"github.com/machearn/galaxy_controller/saga"
+	"github.com/machearn/galaxy_controller/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// giftCardAlphabet leaves out characters that are easily mistaken for one
+// another. Its length divides 256 so that every character is equally likely.
+const giftCardAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+const giftCardCodeLength = 16
+
+const (
+	giftCardUnpaid  = "unpaid"
+	giftCardActive  = "active"
+	giftCardSpent   = "spent"
+	giftCardExpired = "expired"
+	giftCardVoid    = "void"
+)
+
+var errInvalidGiftCard = errors.New("gift card is invalid")
+
+// errGiftCardSpent is returned by the sagas when the balance of a gift card
+// dropped below the amount to redeem in the meantime.
+var errGiftCardSpent = errors.New("gift card balance is too low")
+
+type GiftCard struct {
+	ID      int32       `json:"id"`
+	Code    string      `json:"code"`
+	Value   money.Money `json:"value"`
+	Balance money.Money `json:"balance"`
+	// Status is one of unpaid, active, spent, expired and void, the latter
+	// for cards whose purchase was cancelled or refunded.
+	Status      string               `json:"status"`
+	PurchaserID int32                `json:"purchaser_id"`
+	EntryID     int32                `json:"entry_id"`
+	ExpiresAt   *time.Time           `json:"expires_at"`
+	CreatedAt   time.Time            `json:"created_at"`
+	Redemptions []GiftCardRedemption `json:"redemptions"`
+}
+
+func newGiftCardResponse(card *pb.GiftCard, purchase *pb.Entry, now time.Time) GiftCard {
+	res := GiftCard{
+		ID:          card.GetID(),
+		Code:        card.GetCode(),
+		Value:       money.FromProto(card.GetValue()),
+		Balance:     money.FromProto(card.GetBalance()),
+		Status:      giftCardStatus(card, purchase, now),
+		PurchaserID: card.GetPurchaserId(),
+		EntryID:     card.GetEntryId(),
+		CreatedAt:   card.GetCreatedAt().AsTime(),
+		Redemptions: []GiftCardRedemption{},
+	}
+	if card.GetExpiresAt() != nil {
+		expiresAt := card.GetExpiresAt().AsTime()
+		res.ExpiresAt = &expiresAt
+	}
+	return res
+}
+
+type GiftCardRedemption struct {
+	ID         int32       `json:"id"`
+	UserID     int32       `json:"member_id"`
+	Amount     money.Money `json:"amount"`
+	EntryID    int32       `json:"entry_id,omitempty"`
+	ToWallet   bool        `json:"to_wallet"`
+	ReversedAt *time.Time  `json:"reversed_at,omitempty"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
+func newGiftCardRedemptionResponse(redemption *pb.GiftCardRedemption) GiftCardRedemption {
+	res := GiftCardRedemption{
+		ID:        redemption.GetID(),
+		UserID:    redemption.GetUserId(),
+		Amount:    money.FromProto(redemption.GetAmount()),
+		EntryID:   redemption.GetEntryId(),
+		ToWallet:  redemption.GetToWallet(),
+		CreatedAt: redemption.GetCreatedAt().AsTime(),
+	}
+	if redemption.GetReversedAt() != nil {
+		reversedAt := redemption.GetReversedAt().AsTime()
+		res.ReversedAt = &reversedAt
+	}
+	return res
+}
+
+// newGiftCardCode returns a random gift card code. Codes are bearer
+// credentials, so they come from crypto/rand.
+func newGiftCardCode() (string, error) {
+	b := make([]byte, giftCardCodeLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	for i := range b {
+		b[i] = giftCardAlphabet[int(b[i])%len(giftCardAlphabet)]
+	}
+	return string(b), nil
+}
+
+// normalizeGiftCardCode makes codes case-insensitive and drops the spaces and
+// dashes people group them with.
+func normalizeGiftCardCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(strings.TrimSpace(code)))
+}
+
+// giftCardStatus tells whether a gift card can be used. A card can only be
+// used while the entry that bought it is paid.
+func giftCardStatus(card *pb.GiftCard, purchase *pb.Entry, now time.Time) string {
+	switch purchase.GetStatus() {
+	case pb.EntryStatus_ENTRY_STATUS_PENDING:
+		return giftCardUnpaid
+	case pb.EntryStatus_ENTRY_STATUS_CANCELLED, pb.EntryStatus_ENTRY_STATUS_REFUNDED:
+		return giftCardVoid
+	}
+	if card.GetExpiresAt() != nil && !now.Before(card.GetExpiresAt().AsTime()) {
+		return giftCardExpired
+	}
+	if card.GetBalance().GetAmount() <= 0 {
+		return giftCardSpent
+	}
+	return giftCardActive
+}
+
+// checkGiftCard returns why a gift card cannot be used, if it cannot.
+func checkGiftCard(card *pb.GiftCard, purchase *pb.Entry, now time.Time) error {
+	if cardStatus := giftCardStatus(card, purchase, now); cardStatus != giftCardActive {
+		return fmt.Errorf("gift card is %s", cardStatus)
+	}
+	return nil
+}
+
+// lookupGiftCard fetches a gift card by code along with the entry that bought
+// it. Unknown codes fail with errInvalidGiftCard.
+func (server *Server) lookupGiftCard(ctx *gin.Context, code string) (*pb.GiftCard, *pb.Entry, error) {
+	result, err := server.grpc.GetGiftCardByCode(ctx, &pb.GetGiftCardByCodeRequest{Code: normalizeGiftCardCode(code)})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil, errInvalidGiftCard
+		}
+		return nil, nil, err
+	}
+
+	card := result.GetGiftCard()
+	entryResult, err := server.grpc.GetEntry(tenantContext(ctx), &pb.GetEntryRequest{Id: card.GetEntryId()})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil, errInvalidGiftCard
+		}
+		return nil, nil, err
+	}
+
+	purchase := entryResult.GetEntry()
+	if !inTenant(ctx, purchase.GetTenantId()) {
+		return nil, nil, errInvalidGiftCard
+	}
+	return card, purchase, nil
+}
+
+// reverseGiftCardRedemptions puts what an entry paid with its gift card back
+// on the card.
+func (server *Server) reverseGiftCardRedemptions(ctx *gin.Context, entry *pb.Entry) error {
+	result, err := server.grpc.ListGiftCardRedemptions(ctx, &pb.ListGiftCardRedemptionsRequest{
+		GiftCardId: entry.GetGiftCardId(),
+		EntryId:    entry.GetID(),
+	})
+	if err != nil {
+		return err
+	}
+	for _, redemption := range result.GetRedemptions() {
+		if redemption.GetReversedAt() != nil {
+			continue
+		}
+		if _, err := server.grpc.ReverseGiftCardRedemption(ctx, &pb.ReverseGiftCardRedemptionRequest{Id: redemption.GetID()}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// voidIssuedGiftCard deletes the gift card bought by entry, if it bought one,
+// so that a refunded card cannot be spent. It fails with FAILED_PRECONDITION
+// if some of the card has been spent already.
+func (server *Server) voidIssuedGiftCard(ctx *gin.Context, entry *pb.Entry) error {
+	result, err := server.grpc.GetGiftCardByEntry(ctx, &pb.GetGiftCardByEntryRequest{EntryId: entry.GetID()})
+	if status.Code(err) == codes.NotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	_, err = server.grpc.DeleteGiftCard(ctx, &pb.DeleteGiftCardRequest{Id: result.GetGiftCard().GetID(), Unused: true})
+	return err
+}
+
+type RedeemGiftCardRequest struct {
+	Code string `json:"code" binding:"required"`
+	// Amount is in the minor units of the card's currency. The whole balance
+	// is redeemed if it is zero.
+	Amount int64 `json:"amount" binding:"min=0"`
+}
+
+type RedeemGiftCardResponse struct {
+	Amount          money.Money `json:"amount"`
+	GiftCardBalance money.Money `json:"gift_card_balance"`
+	WalletBalance   money.Money `json:"wallet_balance"`
+}
+
+// RedeemGiftCard moves the balance of a gift card, or part of it, into the
+// authenticated user's wallet.
+func (server *Server) RedeemGiftCard(ctx *gin.Context) {
+	var req RedeemGiftCardRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	card, purchase, err := server.lookupGiftCard(ctx, req.Code)
+	if err != nil {
+		if errors.Is(err, errInvalidGiftCard) {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if err := checkGiftCard(card, purchase, time.Now()); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	cardBalance := money.FromProto(card.GetBalance())
+	amount := money.New(req.Amount, cardBalance.Currency)
+	if amount.Amount == 0 {
+		amount.Amount = cardBalance.Amount
+	}
+	if amount.Amount > cardBalance.Amount {
+		ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("gift card has only %d left", cardBalance.Amount)))
+		return
+	}
+
+	authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+	userResult, err := server.grpc.GetUser(ctx, &pb.GetUserRequest{ID: authPayload.UserID})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	walletBalance, err := server.walletBalance(ctx, userResult.GetUser())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if walletBalance.Currency != cardBalance.Currency {
+		err := fmt.Errorf("gift card is in %s but the wallet is in %s", cardBalance.Currency, walletBalance.Currency)
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	data := saga.Data{
+		"currency":       amount.Currency,
+		"to_wallet":      "true",
+		"gift_card_key":  "wallet:" + util.GetRandomString(32),
+		"gift_card_code": card.GetCode(),
+	}
+	data.SetInt32("user_id", authPayload.UserID)
+	data.SetInt32("gift_card_id", card.GetID())
+	data.SetInt64("gift_card_amount", amount.Amount)
+
+	var res giftCardSagaResult
+	if err := server.sagas.Run(ctx, server.redeemGiftCardSaga(&res), data); err != nil {
+		if errors.Is(err, errGiftCardSpent) {
+			ctx.JSON(http.StatusConflict, errorResponse(err))
+			return
+		}
+		if apiErr, ok := status.FromError(err); ok && apiErr.Code() == codes.InvalidArgument {
+			ctx.JSON(http.StatusBadRequest, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, RedeemGiftCardResponse{
+		Amount:          amount,
+		GiftCardBalance: money.New(data.Int64("gift_card_balance"), amount.Currency),
+		WalletBalance:   money.FromProto(res.wallet.GetBalance()),
+	})
+}
+
+type GetGiftCardRequest struct {
+	Code string `uri:"code" binding:"required"`
+}
+
+type ListGiftCardRedemptionsRequest struct {
+	Offset int32 `form:"offset" binding:"min=0"`
+	Limit  int32 `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// GetGiftCard shows admins what is left on a gift card and how it was spent.
+func (server *Server) GetGiftCard(ctx *gin.Context) {
+	var req GetGiftCardRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var page ListGiftCardRedemptionsRequest
+	if err := ctx.ShouldBindQuery(&page); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	if page.Limit == 0 {
+		page.Limit = 20
+	}
+
+	card, purchase, err := server.lookupGiftCard(ctx, req.Code)
+	if err != nil {
+		if errors.Is(err, errInvalidGiftCard) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("gift card not found")))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	result, err := server.grpc.ListGiftCardRedemptions(ctx, &pb.ListGiftCardRedemptionsRequest{
+		GiftCardId: card.GetID(),
+		Offset:     page.Offset,
+		Limit:      page.Limit,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := newGiftCardResponse(card, purchase, time.Now())
+	for _, redemption := range result.GetRedemptions() {
+		res.Redemptions = append(res.Redemptions, newGiftCardRedemptionResponse(redemption))
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,784 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/machearn/galaxy_controller/payments"
+	"github.com/machearn/galaxy_controller/pb"
+	mockpb "github.com/machearn/galaxy_controller/pb/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestNewGiftCardCode(t *testing.T) {
+	code, err := newGiftCardCode()
+	require.NoError(t, err)
+	require.Len(t, code, giftCardCodeLength)
+	for _, r := range code {
+		require.Contains(t, giftCardAlphabet, string(r))
+	}
+
+	other, err := newGiftCardCode()
+	require.NoError(t, err)
+	require.NotEqual(t, code, other)
+}
+
+func TestNormalizeGiftCardCode(t *testing.T) {
+	require.Equal(t, "ABCD2345EFGH6789", normalizeGiftCardCode(" abcd-2345 efgh-6789 "))
+}
+
+func TestGiftCardStatus(t *testing.T) {
+	now := time.Now()
+	paid := &pb.Entry{Status: pb.EntryStatus_ENTRY_STATUS_PAID}
+
+	testCases := []struct {
+		name     string
+		card     *pb.GiftCard
+		purchase *pb.Entry
+		status   string
+	}{
+		{
+			name:     "Active",
+			card:     &pb.GiftCard{Balance: usd(500), ExpiresAt: timestamppb.New(now.Add(time.Hour))},
+			purchase: paid,
+			status:   giftCardActive,
+		},
+		{
+			name:     "Fulfilled",
+			card:     &pb.GiftCard{Balance: usd(500)},
+			purchase: &pb.Entry{Status: pb.EntryStatus_ENTRY_STATUS_FULFILLED},
+			status:   giftCardActive,
+		},
+		{
+			name:     "Unpaid",
+			card:     &pb.GiftCard{Balance: usd(500)},
+			purchase: &pb.Entry{Status: pb.EntryStatus_ENTRY_STATUS_PENDING},
+			status:   giftCardUnpaid,
+		},
+		{
+			name:     "Refunded",
+			card:     &pb.GiftCard{Balance: usd(500)},
+			purchase: &pb.Entry{Status: pb.EntryStatus_ENTRY_STATUS_REFUNDED},
+			status:   giftCardVoid,
+		},
+		{
+			name:     "Expired",
+			card:     &pb.GiftCard{Balance: usd(500), ExpiresAt: timestamppb.New(now)},
+			purchase: paid,
+			status:   giftCardExpired,
+		},
+		{
+			name:     "Spent",
+			card:     &pb.GiftCard{Balance: usd(0)},
+			purchase: paid,
+			status:   giftCardSpent,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.status, giftCardStatus(tc.card, tc.purchase, now))
+		})
+	}
+}
+
+// expectGiftCard makes the gift card ABCD2345EFGH6789 known to grpc.
+func expectGiftCard(grpc *mockpb.MockGalaxyClient, card *pb.GiftCard, purchaseStatus pb.EntryStatus) {
+	card.Code = "ABCD2345EFGH6789"
+	card.EntryId = 2
+	grpc.EXPECT().GetGiftCardByCode(gomock.Any(), gomock.Eq(&pb.GetGiftCardByCodeRequest{Code: card.Code})).Return(&pb.GiftCardResponse{GiftCard: card}, nil)
+	grpc.EXPECT().GetEntry(gomock.Any(), gomock.Eq(&pb.GetEntryRequest{Id: 2})).Return(&pb.GetEntryResponse{
+		Entry: &pb.Entry{ID: 2, Status: purchaseStatus},
+	}, nil)
+}
+
+func TestCreateEntryAPIBuyGiftCard(t *testing.T) {
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(grpc *mockpb.MockGalaxyClient)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"member_id": 1, "item_id": 3, "quantity": 2},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetPlan(gomock.Any(), gomock.Any()).Return(&pb.GetPlanResponse{Plan: &pb.Plan{}}, nil)
+				grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{Count: 1}}, nil)
+				grpc.EXPECT().CreateEntry(gomock.Any(), EqKeyedRequest(&pb.CreateEntryRequest{
+					UserId:         1,
+					ItemId:         3,
+					Quantity:       2,
+					Total:          usd(5000),
+					Discount:       usd(0),
+					Tax:            usd(0),
+					GiftCardAmount: usd(0),
+					PointsDiscount: usd(0),
+				})).Return(&pb.CreateEntryResponse{Entry: &pb.Entry{ID: 4, Total: usd(5000)}}, nil)
+				grpc.EXPECT().CreateGiftCard(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ any, req *pb.CreateGiftCardRequest, _ ...any) (*pb.GiftCardResponse, error) {
+						require.Len(t, req.GetCode(), giftCardCodeLength)
+						require.Equal(t, int64(5000), req.GetValue().GetAmount())
+						require.Equal(t, int32(1), req.GetPurchaserId())
+						require.Equal(t, int32(4), req.GetEntryId())
+						require.Equal(t, "gift-card:entry:4", req.GetIdempotencyKey())
+						require.WithinDuration(t, time.Now().Add(365*24*time.Hour), req.GetExpiresAt().AsTime(), time.Minute)
+						return &pb.GiftCardResponse{GiftCard: &pb.GiftCard{ID: 6, Code: req.GetCode()}}, nil
+					})
+				grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Any()).Return(&pb.UpdateEntryResponse{Entry: &pb.Entry{ID: 4, Total: usd(5000)}}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var entry CreateEntryResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &entry))
+				require.Len(t, entry.GiftCardCode, giftCardCodeLength)
+				require.NotEmpty(t, entry.PaymentClientSecret)
+			},
+		},
+		{
+			name: "CodeTaken",
+			body: gin.H{"member_id": 1, "item_id": 3, "quantity": 1},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetPlan(gomock.Any(), gomock.Any()).Return(&pb.GetPlanResponse{Plan: &pb.Plan{}}, nil)
+				grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{Count: 1}}, nil)
+				grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Return(&pb.CreateEntryResponse{Entry: &pb.Entry{ID: 4}}, nil)
+				gomock.InOrder(
+					grpc.EXPECT().CreateGiftCard(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.AlreadyExists, "code taken")),
+					grpc.EXPECT().GetGiftCardByEntry(gomock.Any(), gomock.Eq(&pb.GetGiftCardByEntryRequest{EntryId: 4})).Return(nil, status.Error(codes.NotFound, "not found")),
+					grpc.EXPECT().CreateGiftCard(gomock.Any(), gomock.Any()).Return(&pb.GiftCardResponse{GiftCard: &pb.GiftCard{ID: 6, Code: "ABCD2345EFGH6789"}}, nil),
+				)
+				grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Any()).Return(&pb.UpdateEntryResponse{Entry: &pb.Entry{ID: 4}}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var entry CreateEntryResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &entry))
+				require.Equal(t, "ABCD2345EFGH6789", entry.GiftCardCode)
+			},
+		},
+		{
+			name: "IssuedBefore",
+			body: gin.H{"member_id": 1, "item_id": 3, "quantity": 1},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetPlan(gomock.Any(), gomock.Any()).Return(&pb.GetPlanResponse{Plan: &pb.Plan{}}, nil)
+				grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{Count: 1}}, nil)
+				grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Return(&pb.CreateEntryResponse{Entry: &pb.Entry{ID: 4}}, nil)
+				// the card issued for the entry is taken as the step's result
+				grpc.EXPECT().CreateGiftCard(gomock.Any(), gomock.Any()).Times(1).Return(nil, status.Error(codes.AlreadyExists, "key used"))
+				grpc.EXPECT().GetGiftCardByEntry(gomock.Any(), gomock.Eq(&pb.GetGiftCardByEntryRequest{EntryId: 4})).
+					Return(&pb.GiftCardResponse{GiftCard: &pb.GiftCard{ID: 6, Code: "ABCD2345EFGH6789"}}, nil)
+				grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Any()).Return(&pb.UpdateEntryResponse{Entry: &pb.Entry{ID: 4}}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var entry CreateEntryResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &entry))
+				require.Equal(t, "ABCD2345EFGH6789", entry.GiftCardCode)
+			},
+		},
+		{
+			name: "WithCoupon",
+			body: gin.H{"member_id": 1, "item_id": 3, "quantity": 1, "coupon_code": "SAVE10"},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "WithGiftCard",
+			body: gin.H{"member_id": 1, "item_id": 3, "quantity": 1, "gift_card_code": "ABCD2345EFGH6789"},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetGiftCardByCode(gomock.Any(), gomock.Any()).Times(0)
+				grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			grpc := mockpb.NewMockGalaxyClient(ctrl)
+			expectSagas(grpc)
+			token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+			grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(&pb.GetUserResponse{User: &pb.User{ID: 1}}, nil)
+			grpc.EXPECT().GetItem(gomock.Any(), gomock.Any()).Return(&pb.GetItemResponse{
+				Item: &pb.Item{ID: 3, Price: usd(2500), GiftCard: true},
+			}, nil)
+			tc.buildStubs(grpc)
+
+			server := NewTestServer(t, grpc)
+			server.payments = payments.NewFakeProvider()
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/entry/create", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthHeader(request, token)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestCreateEntryAPIWithGiftCard(t *testing.T) {
+	testCases := []struct {
+		name          string
+		buildStubs    func(grpc *mockpb.MockGalaxyClient)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder, provider *payments.FakeProvider)
+	}{
+		{
+			name: "Partial",
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				expectGiftCard(grpc, &pb.GiftCard{ID: 6, Balance: usd(300)}, pb.EntryStatus_ENTRY_STATUS_PAID)
+				grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{Count: 1}}, nil)
+				grpc.EXPECT().CreateEntry(gomock.Any(), EqKeyedRequest(&pb.CreateEntryRequest{
+					UserId:         1,
+					ItemId:         3,
+					Quantity:       1,
+					Total:          usd(1000),
+					Discount:       usd(0),
+					Tax:            usd(0),
+					GiftCardId:     6,
+					GiftCardAmount: usd(300),
+					PointsDiscount: usd(0),
+				})).Return(&pb.CreateEntryResponse{Entry: &pb.Entry{ID: 4, Total: usd(1000), GiftCardId: 6, GiftCardAmount: usd(300)}}, nil)
+				grpc.EXPECT().RedeemGiftCard(gomock.Any(), gomock.Eq(&pb.RedeemGiftCardRequest{
+					GiftCardId:     6,
+					UserId:         1,
+					Amount:         usd(300),
+					EntryId:        4,
+					IdempotencyKey: "entry:4",
+				})).Return(&pb.RedeemGiftCardResponse{Redemption: &pb.GiftCardRedemption{ID: 8}, GiftCard: &pb.GiftCard{ID: 6, Balance: usd(0)}}, nil)
+				grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Any()).Return(&pb.UpdateEntryResponse{
+					Entry: &pb.Entry{ID: 4, Total: usd(1000), GiftCardId: 6, GiftCardAmount: usd(300)},
+				}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, provider *payments.FakeProvider) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var entry CreateEntryResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &entry))
+				require.Equal(t, dollars(300), *entry.GiftCardAmount)
+
+				intent, ok := provider.Intent("pi_fake_1")
+				require.True(t, ok)
+				require.Equal(t, int64(700), intent.Amount)
+			},
+		},
+		{
+			name: "Full",
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				expectGiftCard(grpc, &pb.GiftCard{ID: 6, Balance: usd(2500)}, pb.EntryStatus_ENTRY_STATUS_FULFILLED)
+				grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{Count: 1}}, nil)
+				grpc.EXPECT().CreateEntry(gomock.Any(), EqKeyedRequest(&pb.CreateEntryRequest{
+					UserId:         1,
+					ItemId:         3,
+					Quantity:       1,
+					Total:          usd(1000),
+					Status:         pb.EntryStatus_ENTRY_STATUS_PAID,
+					Discount:       usd(0),
+					Tax:            usd(0),
+					GiftCardId:     6,
+					GiftCardAmount: usd(1000),
+					PointsDiscount: usd(0),
+				})).Return(&pb.CreateEntryResponse{Entry: &pb.Entry{ID: 4, Status: pb.EntryStatus_ENTRY_STATUS_PAID}}, nil)
+				grpc.EXPECT().RedeemGiftCard(gomock.Any(), gomock.Any()).Return(&pb.RedeemGiftCardResponse{Redemption: &pb.GiftCardRedemption{ID: 8}, GiftCard: &pb.GiftCard{ID: 6, Balance: usd(1500)}}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, provider *payments.FakeProvider) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var entry CreateEntryResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &entry))
+				require.Equal(t, "paid", entry.Status)
+				require.Empty(t, entry.PaymentClientSecret)
+
+				_, ok := provider.Intent("pi_fake_1")
+				require.False(t, ok)
+			},
+		},
+		{
+			name: "Unknown",
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetGiftCardByCode(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.NotFound, "no gift card"))
+				grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, provider *payments.FakeProvider) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Unpaid",
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				expectGiftCard(grpc, &pb.GiftCard{ID: 6, Balance: usd(300)}, pb.EntryStatus_ENTRY_STATUS_PENDING)
+				grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, provider *payments.FakeProvider) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Contains(t, recorder.Body.String(), "gift card is unpaid")
+			},
+		},
+		{
+			name: "Expired",
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				card := &pb.GiftCard{ID: 6, Balance: usd(300), ExpiresAt: timestamppb.New(time.Now().Add(-time.Hour))}
+				expectGiftCard(grpc, card, pb.EntryStatus_ENTRY_STATUS_PAID)
+				grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, provider *payments.FakeProvider) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Contains(t, recorder.Body.String(), "gift card is expired")
+			},
+		},
+		{
+			name: "OtherCurrency",
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				expectGiftCard(grpc, &pb.GiftCard{ID: 6, Balance: &pb.Money{Amount: 300, Currency: "EUR"}}, pb.EntryStatus_ENTRY_STATUS_PAID)
+				grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, provider *payments.FakeProvider) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "SpentMeanwhile",
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				expectGiftCard(grpc, &pb.GiftCard{ID: 6, Balance: usd(300)}, pb.EntryStatus_ENTRY_STATUS_PAID)
+				gomock.InOrder(
+					grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{Count: 1}}, nil),
+					grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{}}, nil),
+				)
+				grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Return(&pb.CreateEntryResponse{Entry: &pb.Entry{ID: 4}}, nil)
+				grpc.EXPECT().RedeemGiftCard(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.FailedPrecondition, "balance too low"))
+				grpc.EXPECT().ReverseGiftCardRedemption(gomock.Any(), gomock.Any()).Times(0)
+				grpc.EXPECT().DeleteEntry(gomock.Any(), gomock.Eq(&pb.DeleteEntryRequest{Id: 4})).Return(&pb.Empty{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, provider *payments.FakeProvider) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			grpc := mockpb.NewMockGalaxyClient(ctrl)
+			expectSagas(grpc)
+			token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+			grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(&pb.GetUserResponse{User: &pb.User{ID: 1}}, nil)
+			grpc.EXPECT().GetItem(gomock.Any(), gomock.Any()).Return(&pb.GetItemResponse{Item: &pb.Item{ID: 3, Price: usd(1000)}}, nil)
+			grpc.EXPECT().GetPlan(gomock.Any(), gomock.Any()).Return(&pb.GetPlanResponse{Plan: &pb.Plan{}}, nil)
+			tc.buildStubs(grpc)
+
+			server := NewTestServer(t, grpc)
+			provider := payments.NewFakeProvider()
+			server.payments = provider
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(gin.H{"member_id": 1, "item_id": 3, "quantity": 1, "total": 1000, "gift_card_code": "abcd-2345-efgh-6789"})
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/entry/create", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthHeader(request, token)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder, provider)
+		})
+	}
+}
+
+func TestRedeemGiftCardAPI(t *testing.T) {
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(grpc *mockpb.MockGalaxyClient)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "WholeBalance",
+			body: gin.H{"code": "ABCD2345EFGH6789"},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				expectGiftCard(grpc, &pb.GiftCard{ID: 6, Balance: usd(2500)}, pb.EntryStatus_ENTRY_STATUS_PAID)
+				grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(&pb.GetUserResponse{User: &pb.User{ID: 1}}, nil)
+				grpc.EXPECT().GetWallet(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.NotFound, "no wallet"))
+				grpc.EXPECT().RedeemGiftCard(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ any, req *pb.RedeemGiftCardRequest, _ ...any) (*pb.RedeemGiftCardResponse, error) {
+						require.Equal(t, int32(6), req.GetGiftCardId())
+						require.Equal(t, int32(1), req.GetUserId())
+						require.Equal(t, usd(2500).String(), req.GetAmount().String())
+						require.True(t, req.GetToWallet())
+						require.True(t, strings.HasPrefix(req.GetIdempotencyKey(), "wallet:"))
+						return &pb.RedeemGiftCardResponse{
+							Redemption: &pb.GiftCardRedemption{ID: 8},
+							GiftCard:   &pb.GiftCard{ID: 6, Balance: usd(0)},
+						}, nil
+					})
+				grpc.EXPECT().CreateWalletTransaction(gomock.Any(), gomock.Eq(&pb.CreateWalletTransactionRequest{
+					UserId:         1,
+					Type:           pb.WalletTransactionType_WALLET_TRANSACTION_TYPE_GIFT_CARD,
+					Amount:         usd(2500),
+					Reason:         "gift card redeemed",
+					IdempotencyKey: "gift-card:redemption:8",
+				})).Return(&pb.WalletTransactionResponse{Wallet: &pb.Wallet{UserId: 1, Balance: usd(2500)}}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res RedeemGiftCardResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Equal(t, dollars(2500), res.Amount)
+				require.Equal(t, dollars(0), res.GiftCardBalance)
+				require.Equal(t, dollars(2500), res.WalletBalance)
+			},
+		},
+		{
+			name: "Partial",
+			body: gin.H{"code": "ABCD2345EFGH6789", "amount": 1000},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				expectGiftCard(grpc, &pb.GiftCard{ID: 6, Balance: usd(2500)}, pb.EntryStatus_ENTRY_STATUS_PAID)
+				grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(&pb.GetUserResponse{User: &pb.User{ID: 1}}, nil)
+				grpc.EXPECT().GetWallet(gomock.Any(), gomock.Any()).Return(&pb.WalletResponse{Wallet: &pb.Wallet{UserId: 1, Balance: usd(200)}}, nil)
+				grpc.EXPECT().RedeemGiftCard(gomock.Any(), gomock.Any()).Return(&pb.RedeemGiftCardResponse{
+					Redemption: &pb.GiftCardRedemption{ID: 8},
+					GiftCard:   &pb.GiftCard{ID: 6, Balance: usd(1500)},
+				}, nil)
+				grpc.EXPECT().CreateWalletTransaction(gomock.Any(), gomock.Any()).Return(&pb.WalletTransactionResponse{Wallet: &pb.Wallet{UserId: 1, Balance: usd(1200)}}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res RedeemGiftCardResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Equal(t, dollars(1000), res.Amount)
+				require.Equal(t, dollars(1500), res.GiftCardBalance)
+				require.Equal(t, dollars(1200), res.WalletBalance)
+			},
+		},
+		{
+			name: "MoreThanBalance",
+			body: gin.H{"code": "ABCD2345EFGH6789", "amount": 3000},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				expectGiftCard(grpc, &pb.GiftCard{ID: 6, Balance: usd(2500)}, pb.EntryStatus_ENTRY_STATUS_PAID)
+				grpc.EXPECT().RedeemGiftCard(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "WalletInOtherCurrency",
+			body: gin.H{"code": "ABCD2345EFGH6789"},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				expectGiftCard(grpc, &pb.GiftCard{ID: 6, Balance: usd(2500)}, pb.EntryStatus_ENTRY_STATUS_PAID)
+				grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(&pb.GetUserResponse{User: &pb.User{ID: 1}}, nil)
+				grpc.EXPECT().GetWallet(gomock.Any(), gomock.Any()).Return(&pb.WalletResponse{Wallet: &pb.Wallet{Balance: &pb.Money{Currency: "EUR"}}}, nil)
+				grpc.EXPECT().RedeemGiftCard(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Void",
+			body: gin.H{"code": "ABCD2345EFGH6789"},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				expectGiftCard(grpc, &pb.GiftCard{ID: 6, Balance: usd(2500)}, pb.EntryStatus_ENTRY_STATUS_REFUNDED)
+				grpc.EXPECT().RedeemGiftCard(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Contains(t, recorder.Body.String(), "gift card is void")
+			},
+		},
+		{
+			name: "SpentMeanwhile",
+			body: gin.H{"code": "ABCD2345EFGH6789"},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				expectGiftCard(grpc, &pb.GiftCard{ID: 6, Balance: usd(2500)}, pb.EntryStatus_ENTRY_STATUS_PAID)
+				grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(&pb.GetUserResponse{User: &pb.User{ID: 1}}, nil)
+				grpc.EXPECT().GetWallet(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.NotFound, "no wallet"))
+				grpc.EXPECT().RedeemGiftCard(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.FailedPrecondition, "balance too low"))
+				grpc.EXPECT().CreateWalletTransaction(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "WalletFails",
+			body: gin.H{"code": "ABCD2345EFGH6789"},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				expectGiftCard(grpc, &pb.GiftCard{ID: 6, Balance: usd(2500)}, pb.EntryStatus_ENTRY_STATUS_PAID)
+				grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(&pb.GetUserResponse{User: &pb.User{ID: 1}}, nil)
+				grpc.EXPECT().GetWallet(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.NotFound, "no wallet"))
+				grpc.EXPECT().RedeemGiftCard(gomock.Any(), gomock.Any()).Return(&pb.RedeemGiftCardResponse{
+					Redemption: &pb.GiftCardRedemption{ID: 8},
+					GiftCard:   &pb.GiftCard{ID: 6, Balance: usd(0)},
+				}, nil)
+				grpc.EXPECT().CreateWalletTransaction(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Unavailable, "unavailable"))
+				grpc.EXPECT().ReverseGiftCardRedemption(gomock.Any(), gomock.Eq(&pb.ReverseGiftCardRedemptionRequest{Id: 8})).Return(&pb.RedeemGiftCardResponse{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			grpc := mockpb.NewMockGalaxyClient(ctrl)
+			expectSagas(grpc)
+			token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+			tc.buildStubs(grpc)
+
+			server := NewTestServer(t, grpc)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/giftcard/redeem", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthHeader(request, token)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestGetGiftCardAPI(t *testing.T) {
+	testCases := []struct {
+		name          string
+		role          pb.Role
+		buildStubs    func(grpc *mockpb.MockGalaxyClient)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			role: pb.Role_ROLE_ADMIN,
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				expectGiftCard(grpc, &pb.GiftCard{ID: 6, Value: usd(2500), Balance: usd(1200), PurchaserId: 3}, pb.EntryStatus_ENTRY_STATUS_PAID)
+				grpc.EXPECT().ListGiftCardRedemptions(gomock.Any(), gomock.Eq(&pb.ListGiftCardRedemptionsRequest{GiftCardId: 6, Limit: 20})).Return(&pb.ListGiftCardRedemptionsResponse{
+					Redemptions: []*pb.GiftCardRedemption{
+						{ID: 9, GiftCardId: 6, UserId: 1, Amount: usd(300), EntryId: 4, ReversedAt: timestamppb.Now()},
+						{ID: 8, GiftCardId: 6, UserId: 1, Amount: usd(1000), ToWallet: true},
+						{ID: 7, GiftCardId: 6, UserId: 5, Amount: usd(300), EntryId: 3},
+					},
+				}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var card GiftCard
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &card))
+				require.Equal(t, "active", card.Status)
+				require.Equal(t, dollars(2500), card.Value)
+				require.Equal(t, dollars(1200), card.Balance)
+				require.Equal(t, int32(3), card.PurchaserID)
+				require.Len(t, card.Redemptions, 3)
+				require.NotNil(t, card.Redemptions[0].ReversedAt)
+				require.True(t, card.Redemptions[1].ToWallet)
+				require.Equal(t, int32(3), card.Redemptions[2].EntryID)
+			},
+		},
+		{
+			name: "NotFound",
+			role: pb.Role_ROLE_ADMIN,
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetGiftCardByCode(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.NotFound, "no gift card"))
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "NotAdmin",
+			role: pb.Role_ROLE_MEMBER,
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetGiftCardByCode(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			grpc := mockpb.NewMockGalaxyClient(ctrl)
+			token := expectAuthorize(grpc, 1, tc.role)
+			tc.buildStubs(grpc)
+
+			server := NewTestServer(t, grpc)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/admin/giftcard/ABCD2345EFGH6789", nil)
+			require.NoError(t, err)
+
+			addAuthHeader(request, token)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+// expectNoIssuedGiftCard tells a refund that the entry bought no gift card.
+func expectNoIssuedGiftCard(grpc *mockpb.MockGalaxyClient) {
+	grpc.EXPECT().GetGiftCardByEntry(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.NotFound, "gift card not found"))
+}
+
+func TestUpdateEntryStatusAPIBoughtGiftCardRefund(t *testing.T) {
+	testCases := []struct {
+		name       string
+		buildStubs func(grpc *mockpb.MockGalaxyClient)
+		status     int
+	}{
+		{
+			name: "Unused",
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				gomock.InOrder(
+					grpc.EXPECT().DeleteGiftCard(gomock.Any(), gomock.Eq(&pb.DeleteGiftCardRequest{Id: 6, Unused: true})).Return(&pb.Empty{}, nil),
+					grpc.EXPECT().CreateWalletTransaction(gomock.Any(), gomock.Any()).Return(&pb.WalletTransactionResponse{}, nil),
+					grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Any()).Return(&pb.UpdateEntryResponse{Entry: &pb.Entry{ID: 4}}, nil),
+				)
+			},
+			status: http.StatusOK,
+		},
+		{
+			// the card was spent, so the refund would hand out its value twice
+			name: "Used",
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().DeleteGiftCard(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.FailedPrecondition, "gift card has been used"))
+				grpc.EXPECT().CreateWalletTransaction(gomock.Any(), gomock.Any()).Times(0)
+				grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Any()).Times(0)
+			},
+			status: http.StatusConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			grpc := mockpb.NewMockGalaxyClient(ctrl)
+			token := expectAuthorize(grpc, 9, pb.Role_ROLE_STAFF)
+			grpc.EXPECT().GetEntry(gomock.Any(), gomock.Any()).Return(&pb.GetEntryResponse{
+				Entry: &pb.Entry{ID: 4, UserId: 1, Total: usd(5000), Status: pb.EntryStatus_ENTRY_STATUS_PAID, PaidFromWallet: true},
+			}, nil)
+			grpc.EXPECT().GetGiftCardByEntry(gomock.Any(), gomock.Eq(&pb.GetGiftCardByEntryRequest{EntryId: 4})).Return(&pb.GiftCardResponse{
+				GiftCard: &pb.GiftCard{ID: 6, Value: usd(5000), Balance: usd(5000), PurchaserId: 1, EntryId: 4},
+			}, nil)
+			tc.buildStubs(grpc)
+
+			server := NewTestServer(t, grpc)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(gin.H{"id": 4, "status": "refunded"})
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/entry/status", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthHeader(request, token)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.status, recorder.Code)
+		})
+	}
+}
+
+func TestUpdateEntryStatusAPIGiftCardRefund(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 9, pb.Role_ROLE_STAFF)
+	entry := &pb.Entry{
+		ID:             4,
+		UserId:         1,
+		Total:          usd(1000),
+		Status:         pb.EntryStatus_ENTRY_STATUS_PAID,
+		PaidFromWallet: true,
+		GiftCardId:     6,
+		GiftCardAmount: usd(300),
+	}
+	grpc.EXPECT().GetEntry(gomock.Any(), gomock.Any()).Return(&pb.GetEntryResponse{Entry: entry}, nil)
+	expectNoIssuedGiftCard(grpc)
+	grpc.EXPECT().CreateWalletTransaction(gomock.Any(), gomock.Eq(&pb.CreateWalletTransactionRequest{
+		UserId:         1,
+		Type:           pb.WalletTransactionType_WALLET_TRANSACTION_TYPE_REFUND,
+		Amount:         usd(700),
+		EntryId:        4,
+		CreatedBy:      9,
+		IdempotencyKey: "refund:entry:4",
+	})).Return(&pb.WalletTransactionResponse{}, nil)
+	grpc.EXPECT().ListGiftCardRedemptions(gomock.Any(), gomock.Eq(&pb.ListGiftCardRedemptionsRequest{GiftCardId: 6, EntryId: 4})).Return(&pb.ListGiftCardRedemptionsResponse{
+		Redemptions: []*pb.GiftCardRedemption{
+			{ID: 8, GiftCardId: 6, Amount: usd(300), EntryId: 4},
+			{ID: 7, GiftCardId: 6, Amount: usd(300), EntryId: 4, ReversedAt: timestamppb.Now()},
+		},
+	}, nil)
+	grpc.EXPECT().ReverseGiftCardRedemption(gomock.Any(), gomock.Eq(&pb.ReverseGiftCardRedemptionRequest{Id: 8})).Return(&pb.RedeemGiftCardResponse{}, nil)
+	grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Any()).Return(&pb.UpdateEntryResponse{Entry: entry}, nil)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	data, err := json.Marshal(gin.H{"id": 4, "status": "refunded"})
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/entry/status", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+}
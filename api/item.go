@@ -1,25 +1,44 @@
 package api
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/machearn/galaxy_controller/money"
 	"github.com/machearn/galaxy_controller/pb"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 type Item struct {
-	ID       int32  `json:"id"`
-	Name     string `json:"name"`
-	Quantity int32  `json:"quantity"`
-	Price    int32  `json:"price"`
+	ID       int32       `json:"id"`
+	Name     string      `json:"name"`
+	Quantity int32       `json:"quantity"`
+	Price    money.Money `json:"price"`
+	// Prices are the prices in other currencies than the one of Price.
+	Prices []money.Money `json:"prices"`
+	// GiftCard items issue a gift card worth their price when bought.
+	GiftCard bool `json:"gift_card"`
+}
+
+func newItemResponse(item *pb.Item) Item {
+	return Item{
+		ID:       item.GetID(),
+		Name:     item.GetName(),
+		Quantity: item.GetQuantity(),
+		Price:    money.FromProto(item.GetPrice()),
+		Prices:   newMoneyList(item.GetPrices()),
+		GiftCard: item.GetGiftCard(),
+	}
 }
 
 type CreateItemRequest struct {
-	Name     string `json:"name"`
-	Quantity int32  `json:"quantity"`
-	Price    int32  `json:"price"`
+	Name     string        `json:"name"`
+	Quantity int32         `json:"quantity"`
+	Price    money.Money   `json:"price"`
+	Prices   []money.Money `json:"prices"`
+	GiftCard bool          `json:"gift_card"`
 }
 
 func (server *Server) CreateItem(ctx *gin.Context) {
@@ -29,13 +48,20 @@ func (server *Server) CreateItem(ctx *gin.Context) {
 		return
 	}
 
+	if err := server.checkPrices(&req.Price, req.Prices); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
 	grpcReq := pb.CreateItemRequest{
 		Name:     req.Name,
 		Quantity: req.Quantity,
-		Price:    req.Price,
+		Price:    money.New(req.Price.Amount, req.Price.Currency).Proto(),
+		Prices:   moneyList(req.Prices),
+		GiftCard: req.GiftCard,
 	}
 
-	result, err := server.grpc.CreateItem(ctx, &grpcReq)
+	result, err := server.grpc.CreateItem(tenantContext(ctx), &grpcReq)
 	if err != nil {
 		if apiErr, ok := status.FromError(err); ok {
 			if apiErr.Code() == codes.InvalidArgument {
@@ -50,12 +76,7 @@ func (server *Server) CreateItem(ctx *gin.Context) {
 	}
 
 	item := result.GetItem()
-	ctx.JSON(http.StatusOK, Item{
-		ID:       item.ID,
-		Name:     item.Name,
-		Quantity: item.Quantity,
-		Price:    item.Price,
-	})
+	ctx.JSON(http.StatusOK, newItemResponse(item))
 }
 
 type GetItemRequest struct {
@@ -69,31 +90,37 @@ func (server *Server) GetItem(ctx *gin.Context) {
 		return
 	}
 
-	grpcReq := pb.GetItemRequest{
-		Id: req.ID,
+	item, ok := server.loadItem(ctx, req.ID)
+	if !ok {
+		return
 	}
 
-	result, err := server.grpc.GetItem(ctx, &grpcReq)
+	ctx.JSON(http.StatusOK, newItemResponse(item))
+}
+
+// loadItem returns the item with the given id if it belongs to the tenant of
+// the request. It writes the response and returns false otherwise.
+func (server *Server) loadItem(ctx *gin.Context, id int32) (*pb.Item, bool) {
+	result, err := server.grpc.GetItem(tenantContext(ctx), &pb.GetItemRequest{Id: id})
 	if err != nil {
 		if apiErr, ok := status.FromError(err); ok {
 			if apiErr.Code() == codes.NotFound {
 				ctx.JSON(http.StatusNotFound, errorResponse(apiErr.Err()))
-				return
+				return nil, false
 			}
 			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
-			return
+			return nil, false
 		}
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
-		return
+		return nil, false
 	}
 
 	item := result.GetItem()
-	ctx.JSON(http.StatusOK, Item{
-		ID:       item.ID,
-		Name:     item.Name,
-		Quantity: item.Quantity,
-		Price:    item.Price,
-	})
+	if !inTenant(ctx, item.GetTenantId()) {
+		ctx.JSON(http.StatusNotFound, errorResponse(errors.New("item not found")))
+		return nil, false
+	}
+	return item, true
 }
 
 type ListItemsRequest struct {
@@ -113,11 +140,12 @@ func (server *Server) ListItems(ctx *gin.Context) {
 	}
 
 	grpcReq := pb.ListItemsRequest{
-		Offset: req.Offset,
-		Limit:  req.Limit,
+		Offset:   req.Offset,
+		Limit:    req.Limit,
+		TenantId: requestTenant(ctx),
 	}
 
-	result, err := server.grpc.ListItems(ctx, &grpcReq)
+	result, err := server.grpc.ListItems(tenantContext(ctx), &grpcReq)
 	if err != nil {
 		if apiErr, ok := status.FromError(err); ok {
 			if apiErr.Code() == codes.NotFound {
@@ -132,14 +160,9 @@ func (server *Server) ListItems(ctx *gin.Context) {
 	}
 
 	rows := result.GetItems()
-	items := make([]Item, len(rows))
-	for i, row := range rows {
-		items[i] = Item{
-			ID:       row.ID,
-			Name:     row.Name,
-			Quantity: row.Quantity,
-			Price:    row.Price,
-		}
+	items := make([]Item, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, newItemResponse(row))
 	}
 	res := ListItemsResponse{
 		Items: items,
@@ -149,10 +172,13 @@ func (server *Server) ListItems(ctx *gin.Context) {
 }
 
 type UpdateItemRequest struct {
-	ID       int32   `json:"id"`
-	Name     *string `json:"name"`
-	Quantity *int32  `json:"quantity"`
-	Price    *int32  `json:"price"`
+	ID       int32        `json:"id"`
+	Name     *string      `json:"name"`
+	Quantity *int32       `json:"quantity"`
+	Price    *money.Money `json:"price"`
+	// Prices replaces all the prices in other currencies when present.
+	Prices   []money.Money `json:"prices"`
+	GiftCard *bool         `json:"gift_card"`
 }
 
 func (server *Server) UpdateItem(ctx *gin.Context) {
@@ -162,14 +188,29 @@ func (server *Server) UpdateItem(ctx *gin.Context) {
 		return
 	}
 
+	if err := server.checkPrices(req.Price, req.Prices); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, ok := server.loadItem(ctx, req.ID); !ok {
+		return
+	}
+
 	grpcReq := pb.UpdateItemRequest{
 		Id:       req.ID,
 		Name:     req.Name,
 		Quantity: req.Quantity,
-		Price:    req.Price,
+		GiftCard: req.GiftCard,
+	}
+	if req.Price != nil {
+		grpcReq.Price = money.New(req.Price.Amount, req.Price.Currency).Proto()
+	}
+	if req.Prices != nil {
+		grpcReq.Prices = &pb.PriceList{Prices: moneyList(req.Prices)}
 	}
 
-	result, err := server.grpc.UpdateItem(ctx, &grpcReq)
+	result, err := server.grpc.UpdateItem(tenantContext(ctx), &grpcReq)
 	if err != nil {
 		if apiErr, ok := status.FromError(err); ok {
 			if apiErr.Code() == codes.NotFound {
@@ -188,12 +229,7 @@ func (server *Server) UpdateItem(ctx *gin.Context) {
 	}
 
 	item := result.GetItem()
-	ctx.JSON(http.StatusOK, Item{
-		ID:       item.ID,
-		Name:     item.Name,
-		Quantity: item.Quantity,
-		Price:    item.Price,
-	})
+	ctx.JSON(http.StatusOK, newItemResponse(item))
 }
 
 type DeleteItemRequest struct {
@@ -207,13 +243,21 @@ func (server *Server) DeleteItem(ctx *gin.Context) {
 		return
 	}
 
+	if _, ok := server.loadItem(ctx, req.ID); !ok {
+		return
+	}
+
 	grpcReq := pb.DeleteItemRequest{
 		Id: req.ID,
 	}
 
-	_, err := server.grpc.DeleteItem(ctx, &grpcReq)
+	_, err := server.grpc.DeleteItem(tenantContext(ctx), &grpcReq)
 	if err != nil {
 		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.NotFound {
+				ctx.JSON(http.StatusNotFound, errorResponse(apiErr.Err()))
+				return
+			}
 			if apiErr.Code() == codes.InvalidArgument {
 				ctx.JSON(http.StatusBadRequest, errorResponse(apiErr.Err()))
 				return
@@ -12,6 +12,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
+	"github.com/machearn/galaxy_controller/money"
 	"github.com/machearn/galaxy_controller/pb"
 	mockpb "github.com/machearn/galaxy_controller/pb/mock"
 	"github.com/machearn/galaxy_controller/util"
@@ -23,7 +24,7 @@ func TestCreateItem(t *testing.T) {
 	req := CreateItemRequest{
 		Name:     "test",
 		Quantity: 1,
-		Price:    1,
+		Price:    money.New(1, "USD"),
 	}
 
 	item := Item{
@@ -31,19 +32,21 @@ func TestCreateItem(t *testing.T) {
 		Name:     req.Name,
 		Quantity: req.Quantity,
 		Price:    req.Price,
+		Prices:   []money.Money{},
 	}
 
 	grpcReq := pb.CreateItemRequest{
 		Name:     req.Name,
 		Quantity: req.Quantity,
-		Price:    req.Price,
+		Price:    usd(1),
+		Prices:   []*pb.Money{},
 	}
 	grpcRes := pb.CreateItemResponse{
 		Item: &pb.Item{
 			ID:       1,
 			Name:     req.Name,
 			Quantity: req.Quantity,
-			Price:    req.Price,
+			Price:    usd(1),
 		},
 	}
 
@@ -56,6 +59,7 @@ func TestCreateItem(t *testing.T) {
 		UserId:    1,
 		CreatedAt: timestamppb.New(createdAt),
 		ExpiredAt: timestamppb.New(createdAt),
+		Role:      pb.Role_ROLE_STAFF,
 	}
 
 	ctrl := gomock.NewController(t)
@@ -70,7 +74,7 @@ func TestCreateItem(t *testing.T) {
 	data, err := json.Marshal(gin.H{
 		"name":     req.Name,
 		"quantity": req.Quantity,
-		"price":    req.Price,
+		"price":    gin.H{"amount": 1, "currency": "usd"},
 	})
 	require.NoError(t, err)
 
@@ -92,6 +96,35 @@ func TestCreateItem(t *testing.T) {
 	require.Equal(t, item, res)
 }
 
+func TestCreateItemAPIMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().CreateItem(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	// a free gift card would be worth its value to the member who made it
+	data, err := json.Marshal(gin.H{
+		"name":      "free money",
+		"quantity":  1,
+		"price":     gin.H{"amount": 0, "currency": "usd"},
+		"gift_card": true,
+	})
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/item/create", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusForbidden, recorder.Code)
+}
+
 func TestGetItem(t *testing.T) {
 	req := GetItemRequest{
 		ID: 1,
@@ -100,7 +133,8 @@ func TestGetItem(t *testing.T) {
 		ID:       1,
 		Name:     "test",
 		Quantity: 1,
-		Price:    1,
+		Price:    money.New(1, "USD"),
+		Prices:   []money.Money{},
 	}
 
 	grpcReq := pb.GetItemRequest{
@@ -111,7 +145,7 @@ func TestGetItem(t *testing.T) {
 			ID:       1,
 			Name:     "test",
 			Quantity: 1,
-			Price:    1,
+			Price:    usd(1),
 		},
 	}
 
@@ -166,7 +200,8 @@ func TestListItems(t *testing.T) {
 			ID:       int32(i),
 			Name:     "test",
 			Quantity: 1,
-			Price:    1,
+			Price:    money.New(1, "USD"),
+			Prices:   []money.Money{},
 		})
 	}
 	result := ListItemsResponse{
@@ -184,7 +219,7 @@ func TestListItems(t *testing.T) {
 			ID:       int32(i),
 			Name:     "test",
 			Quantity: 1,
-			Price:    1,
+			Price:    usd(1),
 		})
 	}
 
@@ -259,7 +294,7 @@ func TestUpdateItem(t *testing.T) {
 			ID:       1,
 			Name:     name,
 			Quantity: 1,
-			Price:    1,
+			Price:    usd(1),
 		},
 	}
 
@@ -272,12 +307,14 @@ func TestUpdateItem(t *testing.T) {
 		UserId:    1,
 		CreatedAt: timestamppb.New(createdAt),
 		ExpiredAt: timestamppb.New(createdAt),
+		Role:      pb.Role_ROLE_STAFF,
 	}
 
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().GetItem(gomock.Any(), gomock.Eq(&pb.GetItemRequest{Id: 1})).Return(&pb.GetItemResponse{Item: &pb.Item{ID: 1}}, nil)
 	grpc.EXPECT().UpdateItem(gomock.Any(), gomock.Eq(&grpcReq)).Return(&grpcRes, nil)
 	grpc.EXPECT().Authorize(gomock.Any(), gomock.Eq(&grpcAuthReq)).Return(&grpcAuthRes, nil)
 
@@ -302,7 +339,7 @@ func TestUpdateItem(t *testing.T) {
 	require.Equal(t, grpcRes.Item.ID, res.ID)
 	require.Equal(t, grpcRes.Item.Name, res.Name)
 	require.Equal(t, grpcRes.Item.Quantity, res.Quantity)
-	require.Equal(t, grpcRes.Item.Price, res.Price)
+	require.Equal(t, money.FromProto(grpcRes.Item.Price), res.Price)
 }
 
 func TestDeleteItem(t *testing.T) {
@@ -321,12 +358,14 @@ func TestDeleteItem(t *testing.T) {
 		UserId:    1,
 		CreatedAt: timestamppb.New(createdAt),
 		ExpiredAt: timestamppb.New(createdAt),
+		Role:      pb.Role_ROLE_STAFF,
 	}
 
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().GetItem(gomock.Any(), gomock.Eq(&pb.GetItemRequest{Id: 1})).Return(&pb.GetItemResponse{Item: &pb.Item{ID: 1}}, nil)
 	grpc.EXPECT().DeleteItem(gomock.Any(), gomock.Eq(&grpcReq)).Return(&pb.Empty{}, nil)
 	grpc.EXPECT().Authorize(gomock.Any(), gomock.Eq(&grpcAuthReq)).Return(&grpcAuthRes, nil)
 
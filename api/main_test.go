@@ -1,13 +1,25 @@
 package api
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/machearn/galaxy_controller/money"
+	"github.com/machearn/galaxy_controller/notify"
 	"github.com/machearn/galaxy_controller/pb"
+	mockpb "github.com/machearn/galaxy_controller/pb/mock"
+	"github.com/machearn/galaxy_controller/tax"
 	"github.com/machearn/galaxy_controller/util"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func addAuthHeader(req *http.Request, token string) {
@@ -19,6 +31,12 @@ func NewTestServer(t *testing.T, grpc pb.GalaxyClient) *Server {
 	require.NoError(t, err)
 	require.NotEmpty(t, config)
 
+	// tests that need taxes, exchange rates or loyalty points set up their
+	// own
+	config.TaxRulesFile = ""
+	config.ExchangeRatesFile = ""
+	config.PointsPerUnit = 0
+
 	server, err := NewServer(config, grpc)
 	require.NoError(t, err)
 	require.NotEmpty(t, server)
@@ -26,6 +44,127 @@ func NewTestServer(t *testing.T, grpc pb.GalaxyClient) *Server {
 	return server
 }
 
+// expectAuthorize makes the mock accept a fresh access token for the user and
+// returns that token.
+func expectAuthorize(grpc *mockpb.MockGalaxyClient, userID int32, role pb.Role) string {
+	token := util.GetRandomString(32)
+	now := time.Now().UTC().Truncate(time.Second)
+	grpc.EXPECT().Authorize(gomock.Any(), gomock.Eq(&pb.AuthRequest{Token: token})).Return(&pb.AuthResponse{
+		ID:        uuid.New().String(),
+		UserId:    userID,
+		CreatedAt: timestamppb.New(now),
+		ExpiredAt: timestamppb.New(now.Add(time.Minute * 15)),
+		Role:      role,
+	}, nil)
+	return token
+}
+
+// expectSagas lets the mock save the progress of any number of sagas.
+func expectSagas(grpc *mockpb.MockGalaxyClient) {
+	grpc.EXPECT().CreateSaga(gomock.Any(), gomock.Any()).Return(&pb.SagaResponse{Saga: &pb.Saga{ID: 1}}, nil).AnyTimes()
+	grpc.EXPECT().UpdateSaga(gomock.Any(), gomock.Any()).Return(&pb.SagaResponse{}, nil).AnyTimes()
+}
+
+type recordingNotifier struct {
+	messages []notify.Message
+}
+
+func (n *recordingNotifier) Notify(ctx context.Context, msg notify.Message) error {
+	n.messages = append(n.messages, msg)
+	return nil
+}
+
 func TestMain(m *testing.M) {
 	os.Exit(m.Run())
 }
+
+type eqSecurityEventMatcher struct {
+	userID    int32
+	eventType pb.SecurityEventType
+}
+
+func (m eqSecurityEventMatcher) Matches(x interface{}) bool {
+	req, ok := x.(*pb.CreateSecurityEventRequest)
+	if !ok {
+		return false
+	}
+	return req.GetUserId() == m.userID && req.GetType() == m.eventType
+}
+
+func (m eqSecurityEventMatcher) String() string {
+	return fmt.Sprintf("is a %s event for user %d", m.eventType, m.userID)
+}
+
+func EqSecurityEvent(userID int32, eventType pb.SecurityEventType) gomock.Matcher {
+	return eqSecurityEventMatcher{userID: userID, eventType: eventType}
+}
+
+type eqKeyedRequestMatcher struct {
+	req proto.Message
+}
+
+func (m eqKeyedRequestMatcher) Matches(x interface{}) bool {
+	req, ok := x.(proto.Message)
+	if !ok {
+		return false
+	}
+	req = proto.Clone(req)
+	field := req.ProtoReflect().Descriptor().Fields().ByName("idempotency_key")
+	if field == nil || req.ProtoReflect().Get(field).String() == "" {
+		return false
+	}
+	req.ProtoReflect().Clear(field)
+	return proto.Equal(req, m.req)
+}
+
+func (m eqKeyedRequestMatcher) String() string {
+	return fmt.Sprintf("is equal to %v with an idempotency key", m.req)
+}
+
+// EqKeyedRequest matches a request equal to req apart from its idempotency
+// key, which is random but must be set.
+func EqKeyedRequest(req proto.Message) gomock.Matcher {
+	return eqKeyedRequestMatcher{req: req}
+}
+
+// noTax returns a tax engine that charges no tax.
+func noTax(t *testing.T) *tax.Engine {
+	engine, err := tax.NewEngine(tax.Rules{})
+	require.NoError(t, err)
+	return engine
+}
+
+// newTestTaxEngine returns a tax engine with an exclusive 7.25% in US-CA,
+// where item 5 is exempt, and an inclusive 19% in DE.
+func newTestTaxEngine(t *testing.T) *tax.Engine {
+	engine, err := tax.NewEngine(tax.Rules{
+		DefaultRegion: "US-CA",
+		Regions: map[string]tax.Rule{
+			"US-CA": {Rate: 725, ExemptItems: []int32{5}},
+			"DE":    {Rate: 1900, Inclusive: true},
+		},
+	})
+	require.NoError(t, err)
+	return engine
+}
+
+// usd returns amount cents.
+func usd(amount int64) *pb.Money {
+	return &pb.Money{Amount: amount, Currency: "USD"}
+}
+
+// newTestExchange returns exchange rates from USD where one dollar buys
+// half a euro and 150 yen.
+func newTestExchange(t *testing.T) *money.Exchange {
+	exchange, err := money.NewExchange(money.Rates{
+		Base:  "USD",
+		Rates: map[string]json.Number{"EUR": "0.5", "JPY": "150"},
+	})
+	require.NoError(t, err)
+	return exchange
+}
+
+// dollars returns amount cents.
+func dollars(amount int64) money.Money {
+	return money.New(amount, "USD")
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 	"strings"
 	"time"
@@ -13,10 +14,15 @@ import (
 )
 
 type AuthPayload struct {
-	ID        string    `json:"id"`
-	UserID    int32     `json:"user_id"`
-	CreateAt  time.Time `json:"create_at"`
-	ExpiredAt time.Time `json:"expired_at"`
+	ID                    string     `json:"id"`
+	UserID                int32      `json:"user_id"`
+	Role                  pb.Role    `json:"role"`
+	Plan                  int32      `json:"plan"`
+	SubscriptionExpiredAt *time.Time `json:"subscription_expired_at"`
+	TeamID                int32      `json:"team_id"`
+	TenantID              int32      `json:"tenant_id"`
+	CreateAt              time.Time  `json:"create_at"`
+	ExpiredAt             time.Time  `json:"expired_at"`
 }
 
 func authMiddleware(server *Server) gin.HandlerFunc {
@@ -25,28 +31,28 @@ func authMiddleware(server *Server) gin.HandlerFunc {
 
 		if len(authHeader) == 0 {
 			err := errors.New("authorization header is required")
-			ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
 			return
 		}
 
 		fields := strings.Fields(authHeader)
 		if len(fields) < 2 {
 			err := errors.New("authorization header is invalid")
-			ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
 			return
 		}
 
 		authType := strings.ToLower(fields[0])
 		if authType != "bearer" {
 			err := errors.New("authorization header is invalid")
-			ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
 			return
 		}
 
 		accessToken := fields[1]
 		if len(accessToken) == 0 {
 			err := errors.New("access token is required")
-			ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
 			return
 		}
 
@@ -58,22 +64,84 @@ func authMiddleware(server *Server) gin.HandlerFunc {
 		if err != nil {
 			if apiErr, ok := status.FromError(err); ok {
 				if apiErr.Code() == codes.Unauthenticated {
-					ctx.JSON(http.StatusUnauthorized, errorResponse(apiErr.Err()))
+					ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(apiErr.Err()))
 					return
 				}
-				ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+				ctx.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
 				return
 			}
-			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse(err))
 			return
 		}
 
-		ctx.Set("auth_payload", &AuthPayload{
+		if !isUserActive(result.GetUserStatus(), time.Now()) {
+			server.rejectInactiveUser(ctx, result.GetUserId(), result.GetUserStatus())
+			return
+		}
+
+		tenantID, err := resolveTenant(ctx.GetHeader(tenantHeader), result)
+		if err != nil {
+			if errors.Is(err, errInvalidTenant) {
+				ctx.AbortWithStatusJSON(http.StatusBadRequest, errorResponse(err))
+				return
+			}
+			ctx.AbortWithStatusJSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+
+		authPayload := &AuthPayload{
 			ID:        result.ID,
 			UserID:    result.UserId,
+			Role:      result.Role,
+			Plan:      result.Plan,
+			TeamID:    result.TeamId,
+			TenantID:  tenantID,
 			CreateAt:  result.CreatedAt.AsTime(),
 			ExpiredAt: result.ExpiredAt.AsTime(),
-		})
+		}
+		if result.SubscriptionExpiredAt != nil {
+			subscriptionExpiredAt := result.SubscriptionExpiredAt.AsTime()
+			authPayload.SubscriptionExpiredAt = &subscriptionExpiredAt
+		}
+
+		ctx.Set("auth_payload", authPayload)
+		ctx.Next()
+	}
+}
+
+func roleMiddleware(roles ...pb.Role) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+		for _, role := range roles {
+			if authPayload.Role == role {
+				ctx.Next()
+				return
+			}
+		}
+
+		err := errors.New("you are not allowed to access this resource")
+		ctx.AbortWithStatusJSON(http.StatusForbidden, errorResponse(err))
+	}
+}
+
+// subscriptionMiddleware guards routes that need a paid subscription. Users
+// keep access for SUBSCRIPTION_GRACE_PERIOD after their subscription expires
+// and get 402 afterwards. Plans without an expiry date are never blocked.
+func subscriptionMiddleware(server *Server) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+		if authPayload.SubscriptionExpiredAt == nil {
+			ctx.Next()
+			return
+		}
+
+		deadline := authPayload.SubscriptionExpiredAt.Add(server.config.SubscriptionGracePeriod)
+		if time.Now().After(deadline) {
+			err := fmt.Errorf("subscription expired at %s, renew it to continue", authPayload.SubscriptionExpiredAt.Format(time.RFC3339))
+			ctx.AbortWithStatusJSON(http.StatusPaymentRequired, errorResponse(err))
+			return
+		}
+
 		ctx.Next()
 	}
 }
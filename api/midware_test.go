@@ -0,0 +1,108 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/machearn/galaxy_controller/pb"
+	mockpb "github.com/machearn/galaxy_controller/pb/mock"
+	"github.com/machearn/galaxy_controller/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func expectAuthorizeWithSubscription(grpc *mockpb.MockGalaxyClient, subscriptionExpiredAt time.Time) string {
+	token := util.GetRandomString(32)
+	now := time.Now().UTC().Truncate(time.Second)
+	grpc.EXPECT().Authorize(gomock.Any(), gomock.Eq(&pb.AuthRequest{Token: token})).Return(&pb.AuthResponse{
+		ID:                    uuid.New().String(),
+		UserId:                1,
+		CreatedAt:             timestamppb.New(now),
+		ExpiredAt:             timestamppb.New(now.Add(time.Minute * 15)),
+		Plan:                  2,
+		SubscriptionExpiredAt: timestamppb.New(subscriptionExpiredAt),
+	}, nil)
+	return token
+}
+
+func TestSubscriptionMiddlewareExpired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	server := NewTestServer(t, grpc)
+	token := expectAuthorizeWithSubscription(grpc, time.Now().Add(-server.config.SubscriptionGracePeriod-time.Hour))
+	grpc.EXPECT().GetCart(gomock.Any(), gomock.Any()).Times(0)
+
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodPost, "/cart/add", strings.NewReader(`{"item_id":1,"quantity":1}`))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusPaymentRequired, recorder.Code)
+}
+
+func TestSubscriptionMiddlewareAllowsBrowsing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	server := NewTestServer(t, grpc)
+	token := expectAuthorizeWithSubscription(grpc, time.Now().AddDate(-1, 0, 0))
+	grpc.EXPECT().GetItem(gomock.Any(), gomock.Eq(&pb.GetItemRequest{Id: 1})).Return(&pb.GetItemResponse{Item: &pb.Item{ID: 1}}, nil)
+
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodGet, "/item/get/1", nil)
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestSubscriptionMiddlewareGracePeriod(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	server := NewTestServer(t, grpc)
+	token := expectAuthorizeWithSubscription(grpc, time.Now().Add(-time.Hour))
+	grpc.EXPECT().GetCart(gomock.Any(), gomock.Any()).Times(0)
+
+	// the request gets past the middleware to the handler, which rejects it
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodPost, "/cart/add", strings.NewReader(`{}`))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestSubscriptionMiddlewareAllowsProfile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	server := NewTestServer(t, grpc)
+	token := expectAuthorizeWithSubscription(grpc, time.Now().AddDate(-1, 0, 0))
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Eq(&pb.GetUserRequest{ID: 1})).Return(&pb.GetUserResponse{User: &pb.User{ID: 1}}, nil)
+
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodGet, "/user/get/1", nil)
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+}
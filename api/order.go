@@ -0,0 +1,347 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/machearn/galaxy_controller/money"
+	"github.com/machearn/galaxy_controller/payments"
+	"github.com/machearn/galaxy_controller/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Order struct {
+	ID        int32       `json:"id"`
+	UserID    int32       `json:"member_id"`
+	Total     money.Money `json:"total"`
+	Status    string      `json:"status"`
+	Entries   []Entry     `json:"entries"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+func newOrderResponse(order *pb.Order) Order {
+	res := Order{
+		ID:        order.GetID(),
+		UserID:    order.GetUserId(),
+		Total:     money.FromProto(order.GetTotal()),
+		Status:    entryStatusNames[order.GetStatus()],
+		Entries:   make([]Entry, len(order.GetEntries())),
+		CreatedAt: order.GetCreatedAt().AsTime(),
+	}
+	for i, entry := range order.GetEntries() {
+		res.Entries[i] = newEntryResponse(entry)
+	}
+	return res
+}
+
+type CheckoutRequest struct {
+	// Region is the tax region, the default region of the tax rules if unset.
+	Region string `json:"region"`
+}
+
+type CheckoutResponse struct {
+	Order
+	// PaymentClientSecret lets the client confirm the payment of a pending
+	// order with the payment provider.
+	PaymentClientSecret string `json:"payment_client_secret,omitempty"`
+}
+
+// Checkout turns the authenticated user's cart into an order. Stock and
+// prices are taken from the items at the time of the checkout, every line is
+// taxed like an entry for it, and every line counts as one entry against the
+// user's monthly quota. The request body is optional.
+func (server *Server) Checkout(ctx *gin.Context) {
+	var req CheckoutRequest
+	if ctx.Request.ContentLength != 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+	}
+
+	region, err := server.tax.Region(req.Region)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+
+	cartResult, err := server.grpc.GetCart(ctx, &pb.GetCartRequest{UserId: authPayload.UserID})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	cartLines := cartResult.GetCart().GetLines()
+	if len(cartLines) == 0 {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("cart is empty")))
+		return
+	}
+
+	userResult, err := server.grpc.GetUser(ctx, &pb.GetUserRequest{ID: authPayload.UserID})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	currency := server.userCurrency(userResult.GetUser())
+	total := money.New(0, currency)
+	lines := make([]*pb.OrderLine, 0, len(cartLines))
+	for _, cartLine := range cartLines {
+		itemResult, err := server.grpc.GetItem(tenantContext(ctx), &pb.GetItemRequest{Id: cartLine.GetItemId()})
+		if err != nil {
+			if apiErr, ok := status.FromError(err); ok && apiErr.Code() == codes.NotFound {
+				ctx.JSON(http.StatusConflict, errorResponse(fmt.Errorf("item %d is no longer available", cartLine.GetItemId())))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		item := itemResult.GetItem()
+		if !inTenant(ctx, item.GetTenantId()) {
+			ctx.JSON(http.StatusConflict, errorResponse(fmt.Errorf("item %d is no longer available", cartLine.GetItemId())))
+			return
+		}
+		if item.GetGiftCard() {
+			// added before gift cards were kept out of the cart
+			ctx.JSON(http.StatusConflict, errorResponse(errGiftCardInCart))
+			return
+		}
+		if cartLine.GetQuantity() > item.GetQuantity() {
+			ctx.JSON(http.StatusConflict, errorResponse(fmt.Errorf("only %d of %s left in stock", item.GetQuantity(), item.GetName())))
+			return
+		}
+
+		price, err := server.itemPrice(item, currency)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		taxResult, err := server.tax.Calculate(region, item.GetID(), price.Amount*int64(cartLine.GetQuantity()))
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		total.Amount += taxResult.Total
+		lines = append(lines, &pb.OrderLine{
+			ItemId:       item.GetID(),
+			Quantity:     cartLine.GetQuantity(),
+			Total:        money.New(taxResult.Total, currency).Proto(),
+			Tax:          money.New(taxResult.Tax, currency).Proto(),
+			TaxRegion:    taxResult.Region,
+			TaxRate:      taxResult.Rate,
+			TaxInclusive: taxResult.Inclusive,
+		})
+	}
+
+	planID, err := server.subscriptionPlan(ctx, userResult.GetUser())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	quota, err := server.entryQuota(ctx, planID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	encodedLines, err := json.Marshal(lines)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	period, resetAt := usagePeriod(time.Now())
+	data := newPurchaseData(authPayload.TenantID, authPayload.UserID, int32(len(lines)), quota, period, total)
+	data["lines"] = string(encodedLines)
+
+	var res orderSagaResult
+	if err := server.sagas.Run(ctx, server.checkoutSaga(&res), data); err != nil {
+		writePurchaseError(ctx, err, quota, resetAt)
+		return
+	}
+
+	if _, err := server.grpc.ClearCart(ctx, &pb.ClearCartRequest{UserId: authPayload.UserID}); err != nil {
+		log.Printf("failed to clear cart of user %d: %v", authPayload.UserID, err)
+	}
+
+	setPurchaseQuotaHeaders(ctx, data, resetAt)
+	ctx.JSON(http.StatusOK, CheckoutResponse{
+		Order:               newOrderResponse(res.order),
+		PaymentClientSecret: data["client_secret"],
+	})
+}
+
+type CaptureOrderRequest struct {
+	ID int32 `json:"id" binding:"required,min=1"`
+}
+
+// CaptureOrder takes the money authorized for a pending order, like
+// CaptureEntry does for single entries. Members can only capture their own
+// orders.
+func (server *Server) CaptureOrder(ctx *gin.Context) {
+	var req CaptureOrderRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	result, err := server.grpc.GetOrder(tenantContext(ctx), &pb.GetOrderRequest{Id: req.ID})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.NotFound {
+				ctx.JSON(http.StatusNotFound, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	order := result.GetOrder()
+	if !inTenant(ctx, order.GetTenantId()) {
+		ctx.JSON(http.StatusNotFound, errorResponse(errors.New("order not found")))
+		return
+	}
+	authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+	if order.GetUserId() != authPayload.UserID && authPayload.Role == pb.Role_ROLE_MEMBER {
+		ctx.JSON(http.StatusForbidden, errorResponse(errors.New("you are not allowed to access this resource")))
+		return
+	}
+	if order.GetStatus() != pb.EntryStatus_ENTRY_STATUS_PENDING || len(order.GetPaymentIntentId()) == 0 {
+		ctx.JSON(http.StatusConflict, errorResponse(errors.New("order has no payment to capture")))
+		return
+	}
+
+	intent, err := server.payments.Capture(ctx, order.GetPaymentIntentId())
+	if err != nil {
+		switch {
+		case errors.Is(err, payments.ErrDeclined):
+			ctx.JSON(http.StatusPaymentRequired, errorResponse(err))
+		case errors.Is(err, payments.ErrInvalidState):
+			ctx.JSON(http.StatusConflict, errorResponse(errors.New("payment has not been authorized yet")))
+		default:
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		}
+		return
+	}
+
+	if intent.Status != payments.IntentSucceeded {
+		ctx.JSON(http.StatusAccepted, newOrderResponse(order))
+		return
+	}
+
+	updateResult, err := server.grpc.UpdateOrderStatus(tenantContext(ctx), &pb.UpdateOrderStatusRequest{
+		Id:             order.GetID(),
+		Status:         pb.EntryStatus_ENTRY_STATUS_PAID,
+		ExpectedStatus: pb.EntryStatus_ENTRY_STATUS_PENDING,
+		ActorId:        authPayload.UserID,
+		Reason:         "payment captured",
+	})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok && apiErr.Code() == codes.FailedPrecondition {
+			ctx.JSON(http.StatusConflict, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	server.logAwardPoints(ctx, updateResult.GetOrder().GetEntries()...)
+
+	ctx.JSON(http.StatusOK, newOrderResponse(updateResult.GetOrder()))
+}
+
+type GetOrderRequest struct {
+	ID int32 `uri:"id" binding:"required,min=1"`
+}
+
+// GetOrder returns an order. Members can only see their own orders.
+func (server *Server) GetOrder(ctx *gin.Context) {
+	var req GetOrderRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	result, err := server.grpc.GetOrder(tenantContext(ctx), &pb.GetOrderRequest{Id: req.ID})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.NotFound {
+				ctx.JSON(http.StatusNotFound, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	order := result.GetOrder()
+	if !inTenant(ctx, order.GetTenantId()) {
+		ctx.JSON(http.StatusNotFound, errorResponse(errors.New("order not found")))
+		return
+	}
+	authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+	if order.GetUserId() != authPayload.UserID && authPayload.Role == pb.Role_ROLE_MEMBER {
+		ctx.JSON(http.StatusForbidden, errorResponse(errors.New("you are not allowed to access this resource")))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newOrderResponse(order))
+}
+
+type ListOrdersRequest struct {
+	Offset int32 `json:"offset"`
+	Limit  int32 `json:"limit"`
+}
+
+type ListOrdersResponse struct {
+	Orders []Order `json:"orders"`
+}
+
+// ListOrders lists the orders of the authenticated user.
+func (server *Server) ListOrders(ctx *gin.Context) {
+	var req ListOrdersRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+	result, err := server.grpc.ListOrders(tenantContext(ctx), &pb.ListOrdersRequest{
+		UserId: authPayload.UserID,
+		Offset: req.Offset,
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	rows := result.GetOrders()
+	orders := make([]Order, 0, len(rows))
+	for _, row := range rows {
+		if !inTenant(ctx, row.GetTenantId()) {
+			continue
+		}
+		orders = append(orders, newOrderResponse(row))
+	}
+
+	ctx.JSON(http.StatusOK, ListOrdersResponse{
+		Orders: orders,
+	})
+}
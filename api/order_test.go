@@ -0,0 +1,520 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/machearn/galaxy_controller/money"
+	"github.com/machearn/galaxy_controller/payments"
+	"github.com/machearn/galaxy_controller/pb"
+	mockpb "github.com/machearn/galaxy_controller/pb/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func expectCheckoutCart(grpc *mockpb.MockGalaxyClient) {
+	grpc.EXPECT().GetCart(gomock.Any(), gomock.Eq(&pb.GetCartRequest{UserId: 1})).Return(&pb.CartResponse{
+		Cart: &pb.Cart{UserId: 1, Lines: []*pb.CartLine{{ItemId: 3, Quantity: 2}, {ItemId: 5, Quantity: 1}}},
+	}, nil)
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(&pb.GetUserResponse{User: &pb.User{ID: 1, Plan: 2}}, nil)
+	grpc.EXPECT().GetItem(gomock.Any(), gomock.Eq(&pb.GetItemRequest{Id: 3})).Return(&pb.GetItemResponse{
+		Item: &pb.Item{ID: 3, Name: "lamp", Quantity: 5, Price: usd(100)},
+	}, nil)
+	grpc.EXPECT().GetItem(gomock.Any(), gomock.Eq(&pb.GetItemRequest{Id: 5})).Return(&pb.GetItemResponse{
+		Item: &pb.Item{ID: 5, Name: "desk", Quantity: 1, Price: usd(300)},
+	}, nil)
+}
+
+func TestCheckoutAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	expectSagas(grpc)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	expectCheckoutCart(grpc)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 2})).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 2}}, nil)
+
+	var intentID string
+	gomock.InOrder(
+		grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ any, req *pb.IncrementUsageRequest, _ ...any) (*pb.IncrementUsageResponse, error) {
+				require.Equal(t, int32(2), req.GetAmount())
+				return &pb.IncrementUsageResponse{Usage: &pb.Usage{Count: 2}}, nil
+			}),
+		grpc.EXPECT().CreateOrder(gomock.Any(), EqKeyedRequest(&pb.CreateOrderRequest{
+			UserId: 1,
+			Lines: []*pb.OrderLine{
+				{ItemId: 3, Quantity: 2, Total: usd(200), Tax: usd(0)},
+				{ItemId: 5, Quantity: 1, Total: usd(300), Tax: usd(0)},
+			},
+			Total:  usd(500),
+			Status: pb.EntryStatus_ENTRY_STATUS_PENDING,
+		})).Return(&pb.OrderResponse{
+			Order: &pb.Order{ID: 7, UserId: 1, Total: usd(500)},
+		}, nil),
+		grpc.EXPECT().UpdateOrderStatus(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ any, req *pb.UpdateOrderStatusRequest, _ ...any) (*pb.OrderResponse, error) {
+				require.Equal(t, int32(7), req.GetId())
+				require.NotEmpty(t, req.GetPaymentIntentId())
+				intentID = req.GetPaymentIntentId()
+				return &pb.OrderResponse{Order: &pb.Order{
+					ID:              7,
+					UserId:          1,
+					Total:           usd(500),
+					PaymentIntentId: req.GetPaymentIntentId(),
+					Entries: []*pb.Entry{
+						{ID: 10, UserId: 1, ItemId: 3, Quantity: 2, Total: usd(200), OrderId: 7},
+						{ID: 11, UserId: 1, ItemId: 5, Quantity: 1, Total: usd(300), OrderId: 7},
+					},
+					CreatedAt: timestamppb.Now(),
+				}}, nil
+			}),
+		grpc.EXPECT().ClearCart(gomock.Any(), gomock.Eq(&pb.ClearCartRequest{UserId: 1})).Return(&pb.Empty{}, nil),
+	)
+
+	server := NewTestServer(t, grpc)
+	provider := payments.NewFakeProvider()
+	server.payments = provider
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/order/checkout", nil)
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var res CheckoutResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+	require.Equal(t, int32(7), res.ID)
+	require.Equal(t, "pending", res.Status)
+	require.Len(t, res.Entries, 2)
+	require.Equal(t, int32(7), res.Entries[1].OrderID)
+	require.Equal(t, intentID+"_secret", res.PaymentClientSecret)
+
+	intent, ok := provider.Intent(intentID)
+	require.True(t, ok)
+	require.Equal(t, int64(500), intent.Amount)
+	require.Equal(t, "7", intent.Metadata["order_id"])
+}
+
+func TestCheckoutAPIInCurrency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	expectSagas(grpc)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().GetCart(gomock.Any(), gomock.Any()).Return(&pb.CartResponse{
+		Cart: &pb.Cart{UserId: 1, Lines: []*pb.CartLine{{ItemId: 3, Quantity: 2}, {ItemId: 5, Quantity: 1}}},
+	}, nil)
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(&pb.GetUserResponse{User: &pb.User{ID: 1, Plan: 2, Currency: "EUR"}}, nil)
+	grpc.EXPECT().GetItem(gomock.Any(), gomock.Eq(&pb.GetItemRequest{Id: 3})).Return(&pb.GetItemResponse{
+		Item: &pb.Item{ID: 3, Name: "lamp", Quantity: 5, Price: usd(100), Prices: []*pb.Money{{Amount: 45, Currency: "EUR"}}},
+	}, nil)
+	grpc.EXPECT().GetItem(gomock.Any(), gomock.Eq(&pb.GetItemRequest{Id: 5})).Return(&pb.GetItemResponse{
+		Item: &pb.Item{ID: 5, Name: "desk", Quantity: 1, Price: usd(300)},
+	}, nil)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Any()).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 2}}, nil)
+	grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{Count: 2}}, nil)
+
+	// the lamp has a euro price, the desk is converted from dollars
+	grpc.EXPECT().CreateOrder(gomock.Any(), EqKeyedRequest(&pb.CreateOrderRequest{
+		UserId: 1,
+		Lines: []*pb.OrderLine{
+			{ItemId: 3, Quantity: 2, Total: &pb.Money{Amount: 90, Currency: "EUR"}, Tax: &pb.Money{Currency: "EUR"}},
+			{ItemId: 5, Quantity: 1, Total: &pb.Money{Amount: 150, Currency: "EUR"}, Tax: &pb.Money{Currency: "EUR"}},
+		},
+		Total:  &pb.Money{Amount: 240, Currency: "EUR"},
+		Status: pb.EntryStatus_ENTRY_STATUS_PENDING,
+	})).Return(&pb.OrderResponse{Order: &pb.Order{ID: 7, UserId: 1, Total: &pb.Money{Amount: 240, Currency: "EUR"}}}, nil)
+
+	var intentID string
+	grpc.EXPECT().UpdateOrderStatus(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ any, req *pb.UpdateOrderStatusRequest, _ ...any) (*pb.OrderResponse, error) {
+			intentID = req.GetPaymentIntentId()
+			return &pb.OrderResponse{Order: &pb.Order{ID: 7, UserId: 1, Total: &pb.Money{Amount: 240, Currency: "EUR"}}}, nil
+		})
+	grpc.EXPECT().ClearCart(gomock.Any(), gomock.Any()).Return(&pb.Empty{}, nil)
+
+	server := NewTestServer(t, grpc)
+	server.exchange = newTestExchange(t)
+	provider := payments.NewFakeProvider()
+	server.payments = provider
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/order/checkout", nil)
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var res CheckoutResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+	require.Equal(t, money.New(240, "EUR"), res.Total)
+
+	intent, ok := provider.Intent(intentID)
+	require.True(t, ok)
+	require.Equal(t, int64(240), intent.Amount)
+	require.Equal(t, "EUR", intent.Currency)
+}
+
+func TestCheckoutAPIWithTax(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	expectSagas(grpc)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	expectCheckoutCart(grpc)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Any()).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 2}}, nil)
+	grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{Count: 2}}, nil)
+
+	// 7.25% on the lamp, the desk is exempt
+	grpc.EXPECT().CreateOrder(gomock.Any(), EqKeyedRequest(&pb.CreateOrderRequest{
+		UserId: 1,
+		Lines: []*pb.OrderLine{
+			{ItemId: 3, Quantity: 2, Total: usd(215), Tax: usd(15), TaxRegion: "US-CA", TaxRate: 725},
+			{ItemId: 5, Quantity: 1, Total: usd(300), Tax: usd(0), TaxRegion: "US-CA"},
+		},
+		Total:  usd(515),
+		Status: pb.EntryStatus_ENTRY_STATUS_PENDING,
+	})).Return(&pb.OrderResponse{Order: &pb.Order{ID: 7, UserId: 1, Total: usd(515)}}, nil)
+
+	var intentID string
+	grpc.EXPECT().UpdateOrderStatus(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ any, req *pb.UpdateOrderStatusRequest, _ ...any) (*pb.OrderResponse, error) {
+			intentID = req.GetPaymentIntentId()
+			return &pb.OrderResponse{Order: &pb.Order{ID: 7, UserId: 1, Total: usd(515)}}, nil
+		})
+	grpc.EXPECT().ClearCart(gomock.Any(), gomock.Any()).Return(&pb.Empty{}, nil)
+
+	server := NewTestServer(t, grpc)
+	server.tax = newTestTaxEngine(t)
+	provider := payments.NewFakeProvider()
+	server.payments = provider
+	recorder := httptest.NewRecorder()
+
+	data, err := json.Marshal(gin.H{"region": "us-ca"})
+	require.NoError(t, err)
+	request, err := http.NewRequest(http.MethodPost, "/order/checkout", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	intent, ok := provider.Intent(intentID)
+	require.True(t, ok)
+	require.Equal(t, int64(515), intent.Amount)
+}
+
+func TestCheckoutAPIEmptyCart(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().GetCart(gomock.Any(), gomock.Any()).Return(&pb.CartResponse{Cart: &pb.Cart{UserId: 1}}, nil)
+	grpc.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/order/checkout", nil)
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestCheckoutAPIOutOfStock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().GetCart(gomock.Any(), gomock.Any()).Return(&pb.CartResponse{
+		Cart: &pb.Cart{UserId: 1, Lines: []*pb.CartLine{{ItemId: 3, Quantity: 6}}},
+	}, nil)
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(&pb.GetUserResponse{User: &pb.User{ID: 1}}, nil)
+	grpc.EXPECT().GetItem(gomock.Any(), gomock.Any()).Return(&pb.GetItemResponse{
+		Item: &pb.Item{ID: 3, Name: "lamp", Quantity: 5, Price: usd(100)},
+	}, nil)
+	grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Times(0)
+	grpc.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/order/checkout", nil)
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusConflict, recorder.Code)
+}
+
+func TestCheckoutAPIGiftCard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().GetCart(gomock.Any(), gomock.Any()).Return(&pb.CartResponse{
+		Cart: &pb.Cart{UserId: 1, Lines: []*pb.CartLine{{ItemId: 3, Quantity: 1}}},
+	}, nil)
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(&pb.GetUserResponse{User: &pb.User{ID: 1}}, nil)
+	grpc.EXPECT().GetItem(gomock.Any(), gomock.Any()).Return(&pb.GetItemResponse{
+		Item: &pb.Item{ID: 3, Name: "gift card", Quantity: 5, Price: usd(5000), GiftCard: true},
+	}, nil)
+	grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Times(0)
+	grpc.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/order/checkout", nil)
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusConflict, recorder.Code)
+}
+
+func TestCheckoutAPIStockTaken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	expectSagas(grpc)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	expectCheckoutCart(grpc)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Any()).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 2}}, nil)
+	gomock.InOrder(
+		grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{Count: 2}}, nil),
+		grpc.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.FailedPrecondition, "desk is out of stock")),
+		grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ any, req *pb.IncrementUsageRequest, _ ...any) (*pb.IncrementUsageResponse, error) {
+				require.Equal(t, int32(-2), req.GetAmount())
+				return &pb.IncrementUsageResponse{Usage: &pb.Usage{}}, nil
+			}),
+	)
+	grpc.EXPECT().ClearCart(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/order/checkout", nil)
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusConflict, recorder.Code)
+}
+
+func TestCheckoutAPIDeclined(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	expectSagas(grpc)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	expectCheckoutCart(grpc)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Any()).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 2}}, nil)
+	gomock.InOrder(
+		grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{Count: 2}}, nil),
+		grpc.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(&pb.OrderResponse{Order: &pb.Order{ID: 7, UserId: 1, Total: usd(500)}}, nil),
+		grpc.EXPECT().DeleteOrder(gomock.Any(), gomock.Eq(&pb.DeleteOrderRequest{Id: 7})).Return(&pb.Empty{}, nil),
+		grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{}}, nil),
+	)
+	grpc.EXPECT().ClearCart(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	provider := payments.NewFakeProvider()
+	provider.Decline()
+	server.payments = provider
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/order/checkout", nil)
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusPaymentRequired, recorder.Code)
+}
+
+func TestPaymentWebhookAPIOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().GetOrder(gomock.Any(), gomock.Eq(&pb.GetOrderRequest{Id: 7})).Return(&pb.OrderResponse{
+		Order: &pb.Order{ID: 7, PaymentIntentId: "pi_1"},
+	}, nil)
+	grpc.EXPECT().UpdateOrderStatus(gomock.Any(), gomock.Eq(&pb.UpdateOrderStatusRequest{
+		Id:             7,
+		Status:         pb.EntryStatus_ENTRY_STATUS_PAID,
+		ExpectedStatus: pb.EntryStatus_ENTRY_STATUS_PENDING,
+		Reason:         "payment confirmed by provider",
+	})).Return(&pb.OrderResponse{}, nil)
+	grpc.EXPECT().GetEntry(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	server.router.ServeHTTP(recorder, newWebhookRequest(t, server, payments.EventIntentSucceeded, "pi_1", gin.H{"order_id": "7"}))
+	require.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestCheckoutAPICancelsPaymentOnFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	expectSagas(grpc)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	expectCheckoutCart(grpc)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Any()).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 2}}, nil)
+
+	var intentID string
+	gomock.InOrder(
+		grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{Count: 2}}, nil),
+		grpc.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(&pb.OrderResponse{Order: &pb.Order{ID: 7, UserId: 1, Total: usd(500)}}, nil),
+		grpc.EXPECT().UpdateOrderStatus(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ any, req *pb.UpdateOrderStatusRequest, _ ...any) (*pb.OrderResponse, error) {
+				intentID = req.GetPaymentIntentId()
+				return nil, status.Error(codes.Unavailable, "backend unavailable")
+			}),
+		grpc.EXPECT().DeleteOrder(gomock.Any(), gomock.Eq(&pb.DeleteOrderRequest{Id: 7})).Return(&pb.Empty{}, nil),
+		grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{}}, nil),
+	)
+	grpc.EXPECT().ClearCart(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	provider := payments.NewFakeProvider()
+	server.payments = provider
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/order/checkout", nil)
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusInternalServerError, recorder.Code)
+
+	intent, ok := provider.Intent(intentID)
+	require.True(t, ok)
+	require.Equal(t, payments.IntentCanceled, intent.Status)
+}
+
+func TestGetOrderAPIOtherMember(t *testing.T) {
+	testCases := []struct {
+		name   string
+		userID int32
+		role   pb.Role
+		status int
+	}{
+		{name: "Owner", userID: 1, role: pb.Role_ROLE_MEMBER, status: http.StatusOK},
+		{name: "OtherMember", userID: 2, role: pb.Role_ROLE_MEMBER, status: http.StatusForbidden},
+		{name: "Staff", userID: 2, role: pb.Role_ROLE_STAFF, status: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			grpc := mockpb.NewMockGalaxyClient(ctrl)
+			token := expectAuthorize(grpc, tc.userID, tc.role)
+			grpc.EXPECT().GetOrder(gomock.Any(), gomock.Eq(&pb.GetOrderRequest{Id: 7})).Return(&pb.OrderResponse{
+				Order: &pb.Order{ID: 7, UserId: 1, Total: usd(500), CreatedAt: timestamppb.Now()},
+			}, nil)
+
+			server := NewTestServer(t, grpc)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/order/get/7", nil)
+			require.NoError(t, err)
+
+			addAuthHeader(request, token)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.status, recorder.Code)
+		})
+	}
+}
+
+func TestCaptureOrderAPIOtherMember(t *testing.T) {
+	testCases := []struct {
+		name   string
+		role   pb.Role
+		status int
+	}{
+		{name: "Member", role: pb.Role_ROLE_MEMBER, status: http.StatusForbidden},
+		{name: "Staff", role: pb.Role_ROLE_STAFF, status: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			provider := payments.NewFakeProvider()
+			intent, err := provider.CreateIntent(context.Background(), payments.CreateIntentRequest{Amount: 500, Currency: "usd"})
+			require.NoError(t, err)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			grpc := mockpb.NewMockGalaxyClient(ctrl)
+			token := expectAuthorize(grpc, 2, tc.role)
+			grpc.EXPECT().GetOrder(gomock.Any(), gomock.Eq(&pb.GetOrderRequest{Id: 7})).Return(&pb.OrderResponse{
+				Order: &pb.Order{ID: 7, UserId: 1, Total: usd(500), Status: pb.EntryStatus_ENTRY_STATUS_PENDING, PaymentIntentId: intent.ID},
+			}, nil)
+			if tc.status == http.StatusOK {
+				grpc.EXPECT().UpdateOrderStatus(gomock.Any(), gomock.Any()).Return(&pb.OrderResponse{
+					Order: &pb.Order{ID: 7, UserId: 1, Total: usd(500), Status: pb.EntryStatus_ENTRY_STATUS_PAID, CreatedAt: timestamppb.Now()},
+				}, nil)
+			} else {
+				grpc.EXPECT().UpdateOrderStatus(gomock.Any(), gomock.Any()).Times(0)
+			}
+
+			server := NewTestServer(t, grpc)
+			server.payments = provider
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(gin.H{"id": 7})
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/order/capture", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthHeader(request, token)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.status, recorder.Code)
+
+			captured, ok := provider.Intent(intent.ID)
+			require.True(t, ok)
+			require.Equal(t, tc.status == http.StatusOK, captured.Status == payments.IntentSucceeded)
+		})
+	}
+}
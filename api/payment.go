@@ -0,0 +1,165 @@
+package api
+
+import (
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/machearn/galaxy_controller/payments"
+	"github.com/machearn/galaxy_controller/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxWebhookSize bounds the body of inbound webhooks.
+const maxWebhookSize = 64 << 10
+
+// PaymentWebhook receives signed notifications from the payment provider and
+// marks entries and orders paid, or credits wallet top-ups, once their
+// payment has succeeded. Errors are reported
+// with a 5xx status so that the provider retries the delivery.
+func (server *Server) PaymentWebhook(ctx *gin.Context) {
+	payload, err := io.ReadAll(io.LimitReader(ctx.Request.Body, maxWebhookSize))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	event, err := payments.VerifyWebhook(payload, ctx.GetHeader(payments.SignatureHeader), server.config.PaymentsWebhookSecret, time.Now())
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if event.Type != payments.EventIntentSucceeded {
+		log.Printf("ignoring payment event %s of type %s", event.ID, event.Type)
+		ctx.JSON(http.StatusOK, nil)
+		return
+	}
+
+	intent := event.Data.Object
+	if _, ok := intent.Metadata["wallet_user_id"]; ok {
+		server.confirmTopUp(ctx, intent)
+		return
+	}
+	if _, ok := intent.Metadata["order_id"]; ok {
+		server.confirmOrderPayment(ctx, intent)
+		return
+	}
+
+	entryID, err := strconv.ParseInt(intent.Metadata["entry_id"], 10, 32)
+	if err != nil {
+		// not a payment for an entry
+		ctx.JSON(http.StatusOK, nil)
+		return
+	}
+
+	result, err := server.grpc.GetEntry(ctx, &pb.GetEntryRequest{Id: int32(entryID)})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok && apiErr.Code() == codes.NotFound {
+			log.Printf("payment %s refers to unknown entry %d", intent.ID, entryID)
+			ctx.JSON(http.StatusOK, nil)
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	entry := result.GetEntry()
+	if entry.GetPaymentIntentId() != intent.ID {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("payment does not belong to the entry")))
+		return
+	}
+	if entry.GetStatus() != pb.EntryStatus_ENTRY_STATUS_PENDING {
+		// already confirmed, webhooks may be delivered more than once
+		ctx.JSON(http.StatusOK, nil)
+		return
+	}
+
+	updateResult, err := server.grpc.UpdateEntryStatus(ctx, &pb.UpdateEntryStatusRequest{
+		Id:             entry.GetID(),
+		Status:         pb.EntryStatus_ENTRY_STATUS_PAID,
+		ExpectedStatus: pb.EntryStatus_ENTRY_STATUS_PENDING,
+		Reason:         "payment confirmed by provider",
+	})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok && apiErr.Code() == codes.FailedPrecondition {
+			// the entry moved on while the webhook was in flight
+			ctx.JSON(http.StatusOK, nil)
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	server.logAwardPoints(ctx, updateResult.GetEntry())
+
+	ctx.JSON(http.StatusOK, nil)
+}
+
+// confirmOrderPayment marks the order paid for by intent as paid.
+func (server *Server) confirmOrderPayment(ctx *gin.Context, intent payments.Intent) {
+	orderID, err := strconv.ParseInt(intent.Metadata["order_id"], 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	result, err := server.grpc.GetOrder(ctx, &pb.GetOrderRequest{Id: int32(orderID)})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok && apiErr.Code() == codes.NotFound {
+			log.Printf("payment %s refers to unknown order %d", intent.ID, orderID)
+			ctx.JSON(http.StatusOK, nil)
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	order := result.GetOrder()
+	if order.GetPaymentIntentId() != intent.ID {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("payment does not belong to the order")))
+		return
+	}
+	if order.GetStatus() != pb.EntryStatus_ENTRY_STATUS_PENDING {
+		ctx.JSON(http.StatusOK, nil)
+		return
+	}
+
+	updateResult, err := server.grpc.UpdateOrderStatus(ctx, &pb.UpdateOrderStatusRequest{
+		Id:             order.GetID(),
+		Status:         pb.EntryStatus_ENTRY_STATUS_PAID,
+		ExpectedStatus: pb.EntryStatus_ENTRY_STATUS_PENDING,
+		Reason:         "payment confirmed by provider",
+	})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok && apiErr.Code() == codes.FailedPrecondition {
+			ctx.JSON(http.StatusOK, nil)
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	server.logAwardPoints(ctx, updateResult.GetOrder().GetEntries()...)
+
+	ctx.JSON(http.StatusOK, nil)
+}
+
+// confirmTopUp credits the wallet topped up by intent.
+func (server *Server) confirmTopUp(ctx *gin.Context, intent payments.Intent) {
+	userID, err := strconv.ParseInt(intent.Metadata["wallet_user_id"], 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, err := server.creditTopUp(ctx, int32(userID), intent); err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
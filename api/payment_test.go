@@ -0,0 +1,225 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/machearn/galaxy_controller/payments"
+	"github.com/machearn/galaxy_controller/pb"
+	mockpb "github.com/machearn/galaxy_controller/pb/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCreateEntryAPIDeclined(t *testing.T) {
+	data, err := json.Marshal(gin.H{
+		"member_id": 1,
+		"item_id":   1,
+		"quantity":  1,
+		"total":     500,
+	})
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	expectSagas(grpc)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(&pb.GetUserResponse{User: &pb.User{ID: 1}}, nil)
+	grpc.EXPECT().GetItem(gomock.Any(), gomock.Any()).Return(&pb.GetItemResponse{Item: &pb.Item{ID: 1, Price: usd(500)}}, nil)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Any()).Return(&pb.GetPlanResponse{Plan: &pb.Plan{}}, nil)
+	gomock.InOrder(
+		grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{Count: 1}}, nil),
+		grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Return(&pb.CreateEntryResponse{Entry: &pb.Entry{ID: 4, UserId: 1, Total: usd(500)}}, nil),
+		grpc.EXPECT().DeleteEntry(gomock.Any(), gomock.Eq(&pb.DeleteEntryRequest{Id: 4})).Return(&pb.Empty{}, nil),
+		grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{}}, nil),
+	)
+	grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	provider := payments.NewFakeProvider()
+	provider.Decline()
+	server.payments = provider
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/entry/create", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusPaymentRequired, recorder.Code)
+}
+
+func TestCaptureEntryAPI(t *testing.T) {
+	provider := payments.NewFakeProvider()
+	intent, err := provider.CreateIntent(context.Background(), payments.CreateIntentRequest{Amount: 500, Currency: "usd"})
+	require.NoError(t, err)
+
+	data, err := json.Marshal(gin.H{
+		"id": 4,
+	})
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().GetEntry(gomock.Any(), gomock.Eq(&pb.GetEntryRequest{Id: 4})).Return(&pb.GetEntryResponse{
+		Entry: &pb.Entry{ID: 4, UserId: 1, Total: usd(500), PaymentIntentId: intent.ID},
+	}, nil)
+	grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Eq(&pb.UpdateEntryStatusRequest{
+		Id:             4,
+		Status:         pb.EntryStatus_ENTRY_STATUS_PAID,
+		ExpectedStatus: pb.EntryStatus_ENTRY_STATUS_PENDING,
+		ActorId:        1,
+		Reason:         "payment captured",
+	})).Return(&pb.UpdateEntryResponse{
+		Entry: &pb.Entry{ID: 4, UserId: 1, Total: usd(500), PaymentIntentId: intent.ID, Status: pb.EntryStatus_ENTRY_STATUS_PAID, CreatedAt: timestamppb.Now()},
+	}, nil)
+
+	server := NewTestServer(t, grpc)
+	server.payments = provider
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/entry/capture", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var res Entry
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+	require.Equal(t, "paid", res.Status)
+
+	captured, ok := provider.Intent(intent.ID)
+	require.True(t, ok)
+	require.Equal(t, payments.IntentSucceeded, captured.Status)
+}
+
+func TestCaptureEntryAPIOtherMember(t *testing.T) {
+	testCases := []struct {
+		name   string
+		role   pb.Role
+		status int
+	}{
+		{name: "Member", role: pb.Role_ROLE_MEMBER, status: http.StatusForbidden},
+		{name: "Staff", role: pb.Role_ROLE_STAFF, status: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			provider := payments.NewFakeProvider()
+			intent, err := provider.CreateIntent(context.Background(), payments.CreateIntentRequest{Amount: 500, Currency: "usd"})
+			require.NoError(t, err)
+
+			data, err := json.Marshal(gin.H{
+				"id": 4,
+			})
+			require.NoError(t, err)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			grpc := mockpb.NewMockGalaxyClient(ctrl)
+			token := expectAuthorize(grpc, 2, tc.role)
+			grpc.EXPECT().GetEntry(gomock.Any(), gomock.Eq(&pb.GetEntryRequest{Id: 4})).Return(&pb.GetEntryResponse{
+				Entry: &pb.Entry{ID: 4, UserId: 1, Total: usd(500), PaymentIntentId: intent.ID},
+			}, nil)
+			if tc.status == http.StatusOK {
+				grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Any()).Return(&pb.UpdateEntryResponse{
+					Entry: &pb.Entry{ID: 4, UserId: 1, Total: usd(500), Status: pb.EntryStatus_ENTRY_STATUS_PAID, CreatedAt: timestamppb.Now()},
+				}, nil)
+			} else {
+				grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Any()).Times(0)
+			}
+
+			server := NewTestServer(t, grpc)
+			server.payments = provider
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodPost, "/entry/capture", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthHeader(request, token)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.status, recorder.Code)
+
+			captured, ok := provider.Intent(intent.ID)
+			require.True(t, ok)
+			require.Equal(t, tc.status == http.StatusOK, captured.Status == payments.IntentSucceeded)
+		})
+	}
+}
+
+func newWebhookRequest(t *testing.T, server *Server, eventType string, intentID string, metadata gin.H) *http.Request {
+	payload, err := json.Marshal(gin.H{
+		"id":   "evt_1",
+		"type": eventType,
+		"data": gin.H{
+			"object": gin.H{
+				"id":       intentID,
+				"status":   "succeeded",
+				"metadata": metadata,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/payments/webhook", bytes.NewReader(payload))
+	require.NoError(t, err)
+	request.Header.Set(payments.SignatureHeader, payments.SignWebhook(payload, server.config.PaymentsWebhookSecret, time.Now()))
+	return request
+}
+
+func TestPaymentWebhookAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().GetEntry(gomock.Any(), gomock.Eq(&pb.GetEntryRequest{Id: 4})).Return(&pb.GetEntryResponse{
+		Entry: &pb.Entry{ID: 4, PaymentIntentId: "pi_1"},
+	}, nil)
+	grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Eq(&pb.UpdateEntryStatusRequest{
+		Id:             4,
+		Status:         pb.EntryStatus_ENTRY_STATUS_PAID,
+		ExpectedStatus: pb.EntryStatus_ENTRY_STATUS_PENDING,
+		Reason:         "payment confirmed by provider",
+	})).Return(&pb.UpdateEntryResponse{}, nil)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	server.router.ServeHTTP(recorder, newWebhookRequest(t, server, payments.EventIntentSucceeded, "pi_1", gin.H{"entry_id": "4"}))
+	require.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestPaymentWebhookAPIInvalidSignature(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().GetEntry(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request := newWebhookRequest(t, server, payments.EventIntentSucceeded, "pi_1", gin.H{"entry_id": "4"})
+	request.Header.Set(payments.SignatureHeader, payments.SignWebhook([]byte("{}"), server.config.PaymentsWebhookSecret, time.Now()))
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+}
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/machearn/galaxy_controller/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Plan struct {
+	ID                int32    `json:"id"`
+	Name              string   `json:"name"`
+	Price             int32    `json:"price"`
+	DurationDays      int32    `json:"duration_days"`
+	MaxSessions       int32    `json:"max_sessions"`
+	MonthlyEntryQuota int32    `json:"monthly_entry_quota"`
+	Features          []string `json:"features"`
+	TrialDays         int32    `json:"trial_days"`
+
+	MemberDiscountPercent   int32 `json:"member_discount_percent"`
+	PointsMultiplierPercent int32 `json:"points_multiplier_percent"`
+}
+
+func newPlanResponse(plan *pb.Plan) Plan {
+	features := plan.GetFeatures()
+	if features == nil {
+		features = []string{}
+	}
+	return Plan{
+		ID:                plan.GetID(),
+		Name:              plan.GetName(),
+		Price:             plan.GetPrice(),
+		DurationDays:      plan.GetDurationDays(),
+		MaxSessions:       plan.GetMaxSessions(),
+		MonthlyEntryQuota: plan.GetMonthlyEntryQuota(),
+		Features:          features,
+		TrialDays:         plan.GetTrialDays(),
+
+		MemberDiscountPercent:   plan.GetMemberDiscountPercent(),
+		PointsMultiplierPercent: plan.GetPointsMultiplierPercent(),
+	}
+}
+
+// planExpiry returns when a subscription to plan started at from runs out, or
+// nil for plans that never expire.
+func planExpiry(plan *pb.Plan, from time.Time) *timestamppb.Timestamp {
+	if plan.GetDurationDays() <= 0 {
+		return nil
+	}
+	return timestamppb.New(from.AddDate(0, 0, int(plan.GetDurationDays())))
+}
+
+type GetPlanRequest struct {
+	ID int32 `uri:"id" binding:"min=0"`
+}
+
+func (server *Server) GetPlan(ctx *gin.Context) {
+	var req GetPlanRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	result, err := server.grpc.GetPlan(ctx, &pb.GetPlanRequest{Id: req.ID})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.NotFound {
+				ctx.JSON(http.StatusNotFound, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newPlanResponse(result.GetPlan()))
+}
+
+type ListPlansResponse struct {
+	Plans []Plan `json:"plans"`
+}
+
+func (server *Server) ListPlans(ctx *gin.Context) {
+	result, err := server.grpc.ListPlans(ctx, &pb.ListPlansRequest{})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	rows := result.GetPlans()
+	plans := make([]Plan, len(rows))
+	for i, row := range rows {
+		plans[i] = newPlanResponse(row)
+	}
+
+	ctx.JSON(http.StatusOK, ListPlansResponse{
+		Plans: plans,
+	})
+}
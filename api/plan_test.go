@@ -0,0 +1,72 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/machearn/galaxy_controller/pb"
+	mockpb "github.com/machearn/galaxy_controller/pb/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListPlansAPI(t *testing.T) {
+	grpcRes := pb.ListPlansResponse{
+		Plans: []*pb.Plan{
+			{ID: 0, Name: "free", MaxSessions: 1},
+			{ID: 1, Name: "pro", Price: 999, DurationDays: 30, MaxSessions: 3, MonthlyEntryQuota: 100, Features: []string{"export"}},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().ListPlans(gomock.Any(), gomock.Eq(&pb.ListPlansRequest{})).Return(&grpcRes, nil)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodGet, "/plan/list", nil)
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var res ListPlansResponse
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+	require.Len(t, res.Plans, 2)
+	require.Equal(t, "free", res.Plans[0].Name)
+	require.Empty(t, res.Plans[0].Features)
+	require.Equal(t, Plan{
+		ID:                1,
+		Name:              "pro",
+		Price:             999,
+		DurationDays:      30,
+		MaxSessions:       3,
+		MonthlyEntryQuota: 100,
+		Features:          []string{"export"},
+	}, res.Plans[1])
+}
+
+func TestGetPlanAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 3})).Return(nil, status.Error(codes.NotFound, "plan not found"))
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodGet, "/plan/get/3", nil)
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusNotFound, recorder.Code)
+}
@@ -0,0 +1,332 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/machearn/galaxy_controller/money"
+	"github.com/machearn/galaxy_controller/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var pointsTransactionTypeNames = map[pb.PointsTransactionType]string{
+	pb.PointsTransactionType_POINTS_TRANSACTION_TYPE_EARN:     "earn",
+	pb.PointsTransactionType_POINTS_TRANSACTION_TYPE_REDEEM:   "redeem",
+	pb.PointsTransactionType_POINTS_TRANSACTION_TYPE_EXPIRE:   "expire",
+	pb.PointsTransactionType_POINTS_TRANSACTION_TYPE_REVERSAL: "reversal",
+}
+
+// pointsExpiryLease names the lease that lets one controller at a time expire
+// points.
+const pointsExpiryLease = "points-expiry"
+
+var errPointsDisabled = errors.New("loyalty points are not enabled")
+
+// errInsufficientPoints is returned by the create entry saga when the member
+// spent the points to redeem in the meantime.
+var errInsufficientPoints = errors.New("not enough loyalty points")
+
+type PointsTransaction struct {
+	ID     int32  `json:"id"`
+	Type   string `json:"type"`
+	Points int64  `json:"points"`
+	// Balance is the balance after the transaction.
+	Balance   int64      `json:"balance"`
+	EntryID   int32      `json:"entry_id,omitempty"`
+	Reason    string     `json:"reason,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+func newPointsTransactionResponse(transaction *pb.PointsTransaction) PointsTransaction {
+	res := PointsTransaction{
+		ID:        transaction.GetID(),
+		Type:      pointsTransactionTypeNames[transaction.GetType()],
+		Points:    transaction.GetPoints(),
+		Balance:   transaction.GetBalance(),
+		EntryID:   transaction.GetEntryId(),
+		Reason:    transaction.GetReason(),
+		CreatedAt: transaction.GetCreatedAt().AsTime(),
+	}
+	if transaction.GetExpiresAt() != nil {
+		expiresAt := transaction.GetExpiresAt().AsTime()
+		res.ExpiresAt = &expiresAt
+	}
+	return res
+}
+
+func (server *Server) pointsEnabled() bool {
+	return server.config.PointsPerUnit > 0
+}
+
+// earnedPoints returns the points earned by spending total as a subscriber of
+// plan, rounded down.
+func (server *Server) earnedPoints(total money.Money, plan *pb.Plan) (int64, error) {
+	base, err := server.exchange.Convert(total, server.exchange.Base())
+	if err != nil {
+		return 0, err
+	}
+
+	multiplier := int64(plan.GetPointsMultiplierPercent())
+	if multiplier == 0 {
+		multiplier = 100
+	}
+	unit := int64(1)
+	for i := 0; i < money.Exponent(base.Currency); i++ {
+		unit *= 10
+	}
+	return base.Amount * server.config.PointsPerUnit * multiplier / (100 * unit), nil
+}
+
+// pointsValue returns what points are worth in currency.
+func (server *Server) pointsValue(points int64, currency string) (money.Money, error) {
+	return server.exchange.Convert(money.New(points*server.config.PointValue, server.exchange.Base()), currency)
+}
+
+// awardPoints credits the owner of a paid entry with the points it earns.
+// Only what was paid through the payment provider earns points: money from
+// the wallet or a gift card has earned them already or will when spent, and
+// buying a gift card earns nothing. Awarding an entry twice changes nothing.
+func (server *Server) awardPoints(ctx context.Context, entry *pb.Entry) error {
+	if !server.pointsEnabled() || entry.GetPaidFromWallet() {
+		return nil
+	}
+
+	paid := money.New(entry.GetTotal().GetAmount()-entry.GetGiftCardAmount().GetAmount(), entry.GetTotal().GetCurrency())
+	if paid.Amount <= 0 {
+		return nil
+	}
+
+	itemResult, err := server.grpc.GetItem(withTenant(ctx, entry.GetTenantId()), &pb.GetItemRequest{Id: entry.GetItemId()})
+	if err != nil {
+		return err
+	}
+	if itemResult.GetItem().GetGiftCard() {
+		return nil
+	}
+
+	userResult, err := server.grpc.GetUser(ctx, &pb.GetUserRequest{ID: entry.GetUserId()})
+	if err != nil {
+		return err
+	}
+
+	planID, err := server.subscriptionPlan(ctx, userResult.GetUser())
+	if err != nil {
+		return err
+	}
+
+	planResult, err := server.grpc.GetPlan(ctx, &pb.GetPlanRequest{Id: planID})
+	if err != nil && status.Code(err) != codes.NotFound {
+		return err
+	}
+
+	points, err := server.earnedPoints(paid, planResult.GetPlan())
+	if err != nil {
+		return err
+	}
+	if points <= 0 {
+		return nil
+	}
+
+	req := &pb.CreatePointsTransactionRequest{
+		UserId:         entry.GetUserId(),
+		Type:           pb.PointsTransactionType_POINTS_TRANSACTION_TYPE_EARN,
+		Points:         points,
+		EntryId:        entry.GetID(),
+		IdempotencyKey: fmt.Sprintf("earn:entry:%d", entry.GetID()),
+	}
+	if server.config.PointsValidity > 0 {
+		req.ExpiresAt = timestamppb.New(time.Now().Add(server.config.PointsValidity))
+	}
+	_, err = server.grpc.CreatePointsTransaction(ctx, req)
+	if status.Code(err) == codes.AlreadyExists {
+		return nil
+	}
+	return err
+}
+
+// logAwardPoints awards the points of entries whose payment went through.
+// Failing to award them does not undo the payment, so it is only logged.
+func (server *Server) logAwardPoints(ctx context.Context, entries ...*pb.Entry) {
+	for _, entry := range entries {
+		if err := server.awardPoints(ctx, entry); err != nil {
+			log.Printf("failed to award points for entry %d: %v", entry.GetID(), err)
+		}
+	}
+}
+
+// reversePoints takes back the points a refunded or cancelled entry earned
+// and gives back the points redeemed on it.
+func (server *Server) reversePoints(ctx context.Context, entry *pb.Entry, reason string) error {
+	if !server.pointsEnabled() {
+		return nil
+	}
+
+	result, err := server.grpc.ListPointsTransactions(ctx, &pb.ListPointsTransactionsRequest{
+		UserId:  entry.GetUserId(),
+		EntryId: entry.GetID(),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, transaction := range result.GetTransactions() {
+		switch transaction.GetType() {
+		case pb.PointsTransactionType_POINTS_TRANSACTION_TYPE_EARN, pb.PointsTransactionType_POINTS_TRANSACTION_TYPE_REDEEM:
+			if err := server.reversePointsTransaction(ctx, transaction, reason); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// reversePointsTransaction undoes a transaction. Reversing a transaction
+// twice changes nothing.
+func (server *Server) reversePointsTransaction(ctx context.Context, transaction *pb.PointsTransaction, reason string) error {
+	_, err := server.grpc.CreatePointsTransaction(ctx, &pb.CreatePointsTransactionRequest{
+		UserId:         transaction.GetUserId(),
+		Type:           pb.PointsTransactionType_POINTS_TRANSACTION_TYPE_REVERSAL,
+		Points:         -transaction.GetPoints(),
+		EntryId:        transaction.GetEntryId(),
+		Reason:         reason,
+		ReversesId:     transaction.GetID(),
+		IdempotencyKey: fmt.Sprintf("reverse:%d", transaction.GetID()),
+	})
+	if status.Code(err) == codes.AlreadyExists {
+		return nil
+	}
+	return err
+}
+
+// ExpirePoints expires the loyalty points that are past POINTS_VALIDITY
+// every POINTS_EXPIRY_INTERVAL until ctx is done. Only the controller holding
+// the expiry lease expires points.
+func (server *Server) ExpirePoints(ctx context.Context) {
+	if !server.pointsEnabled() || server.config.PointsExpiryInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(server.config.PointsExpiryInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := server.expirePoints(ctx); err != nil {
+			log.Printf("points expiry failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (server *Server) expirePoints(ctx context.Context) error {
+	leaseResult, err := server.grpc.AcquireLease(ctx, &pb.AcquireLeaseRequest{
+		Name:       pointsExpiryLease,
+		Holder:     server.holder,
+		TtlSeconds: int32(2 * server.config.PointsExpiryInterval / time.Second),
+	})
+	if err != nil {
+		return err
+	}
+	if !leaseResult.GetAcquired() {
+		return nil
+	}
+
+	result, err := server.grpc.ExpirePoints(ctx, &pb.ExpirePointsRequest{Before: timestamppb.Now()})
+	if err != nil {
+		return err
+	}
+	if result.GetPoints() > 0 {
+		log.Printf("expired %d points of %d users", result.GetPoints(), result.GetUsers())
+	}
+	return nil
+}
+
+type GetPointsRequest struct {
+	Offset int32 `form:"offset" binding:"min=0"`
+	Limit  int32 `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+type PointsResponse struct {
+	Balance int64 `json:"balance"`
+	// Value is what the balance is worth in the member's currency.
+	Value money.Money `json:"value"`
+	// ExpiringPoints of the balance expire next, at ExpiringAt.
+	ExpiringPoints int64               `json:"expiring_points,omitempty"`
+	ExpiringAt     *time.Time          `json:"expiring_at,omitempty"`
+	Transactions   []PointsTransaction `json:"transactions"`
+}
+
+// GetPoints reports the authenticated user's loyalty points and the latest
+// transactions of their points ledger.
+func (server *Server) GetPoints(ctx *gin.Context) {
+	var req GetPointsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	if req.Limit == 0 {
+		req.Limit = 20
+	}
+
+	if !server.pointsEnabled() {
+		ctx.JSON(http.StatusNotFound, errorResponse(errPointsDisabled))
+		return
+	}
+
+	authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+	userResult, err := server.grpc.GetUser(ctx, &pb.GetUserRequest{ID: authPayload.UserID})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	balance, err := server.grpc.GetPointsBalance(ctx, &pb.GetPointsBalanceRequest{UserId: authPayload.UserID})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	value, err := server.pointsValue(balance.GetBalance(), server.userCurrency(userResult.GetUser()))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	result, err := server.grpc.ListPointsTransactions(ctx, &pb.ListPointsTransactionsRequest{
+		UserId: authPayload.UserID,
+		Offset: req.Offset,
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	res := PointsResponse{
+		Balance:        balance.GetBalance(),
+		Value:          value,
+		ExpiringPoints: balance.GetExpiringPoints(),
+		Transactions:   make([]PointsTransaction, len(result.GetTransactions())),
+	}
+	if balance.GetExpiringAt() != nil {
+		expiringAt := balance.GetExpiringAt().AsTime()
+		res.ExpiringAt = &expiringAt
+	}
+	for i, transaction := range result.GetTransactions() {
+		res.Transactions[i] = newPointsTransactionResponse(transaction)
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,460 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/machearn/galaxy_controller/money"
+	"github.com/machearn/galaxy_controller/payments"
+	"github.com/machearn/galaxy_controller/pb"
+	mockpb "github.com/machearn/galaxy_controller/pb/mock"
+	"github.com/machearn/galaxy_controller/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestEarnedPoints(t *testing.T) {
+	server := &Server{
+		config:   util.Config{PointsPerUnit: 2},
+		exchange: newTestExchange(t),
+	}
+
+	testCases := []struct {
+		name   string
+		total  money.Money
+		plan   *pb.Plan
+		points int64
+	}{
+		{name: "NoPlan", total: money.New(1999, "USD"), points: 39},
+		{name: "NoMultiplier", total: money.New(1999, "USD"), plan: &pb.Plan{}, points: 39},
+		{name: "Multiplier", total: money.New(1999, "USD"), plan: &pb.Plan{PointsMultiplierPercent: 150}, points: 59},
+		{name: "OtherCurrency", total: money.New(1500, "JPY"), points: 20},
+		{name: "LessThanAUnit", total: money.New(49, "USD"), points: 0},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			points, err := server.earnedPoints(tc.total, tc.plan)
+			require.NoError(t, err)
+			require.Equal(t, tc.points, points)
+		})
+	}
+}
+
+func TestPointsValue(t *testing.T) {
+	server := &Server{
+		config:   util.Config{PointsPerUnit: 1, PointValue: 2},
+		exchange: newTestExchange(t),
+	}
+
+	value, err := server.pointsValue(250, "USD")
+	require.NoError(t, err)
+	require.Equal(t, money.New(500, "USD"), value)
+
+	value, err = server.pointsValue(250, "EUR")
+	require.NoError(t, err)
+	require.Equal(t, money.New(250, "EUR"), value)
+}
+
+func TestGetPointsAPI(t *testing.T) {
+	expiringAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	testCases := []struct {
+		name          string
+		pointsPerUnit int64
+		buildStubs    func(grpc *mockpb.MockGalaxyClient)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:          "OK",
+			pointsPerUnit: 1,
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(&pb.GetUserResponse{User: &pb.User{ID: 1}}, nil)
+				grpc.EXPECT().GetPointsBalance(gomock.Any(), gomock.Eq(&pb.GetPointsBalanceRequest{UserId: 1})).Return(&pb.PointsBalanceResponse{
+					Balance:        700,
+					ExpiringPoints: 200,
+					ExpiringAt:     timestamppb.New(expiringAt),
+				}, nil)
+				grpc.EXPECT().ListPointsTransactions(gomock.Any(), gomock.Eq(&pb.ListPointsTransactionsRequest{UserId: 1, Limit: 20})).Return(&pb.ListPointsTransactionsResponse{
+					Transactions: []*pb.PointsTransaction{
+						{ID: 2, UserId: 1, Type: pb.PointsTransactionType_POINTS_TRANSACTION_TYPE_REDEEM, Points: -300, Balance: 700, EntryId: 5, CreatedAt: timestamppb.Now()},
+						{ID: 1, UserId: 1, Type: pb.PointsTransactionType_POINTS_TRANSACTION_TYPE_EARN, Points: 1000, Balance: 1000, EntryId: 4, ExpiresAt: timestamppb.New(expiringAt), CreatedAt: timestamppb.Now()},
+					},
+				}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var points PointsResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &points))
+				require.Equal(t, int64(700), points.Balance)
+				require.Equal(t, dollars(700), points.Value)
+				require.Equal(t, int64(200), points.ExpiringPoints)
+				require.Equal(t, expiringAt, points.ExpiringAt.UTC())
+				require.Len(t, points.Transactions, 2)
+				require.Equal(t, "redeem", points.Transactions[0].Type)
+				require.Equal(t, int64(-300), points.Transactions[0].Points)
+				require.Equal(t, "earn", points.Transactions[1].Type)
+				require.NotNil(t, points.Transactions[1].ExpiresAt)
+			},
+		},
+		{
+			name: "Disabled",
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetPointsBalance(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			grpc := mockpb.NewMockGalaxyClient(ctrl)
+			token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+			tc.buildStubs(grpc)
+
+			server := NewTestServer(t, grpc)
+			server.config.PointsPerUnit = tc.pointsPerUnit
+			server.config.PointValue = 1
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/user/points", nil)
+			require.NoError(t, err)
+
+			addAuthHeader(request, token)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestCreateEntryAPIWithPoints(t *testing.T) {
+	testCases := []struct {
+		name          string
+		points        int64
+		buildStubs    func(grpc *mockpb.MockGalaxyClient)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder, provider *payments.FakeProvider)
+	}{
+		{
+			name:   "OK",
+			points: 300,
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetPointsBalance(gomock.Any(), gomock.Any()).Return(&pb.PointsBalanceResponse{Balance: 500}, nil)
+				grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{Count: 1}}, nil)
+				grpc.EXPECT().CreateEntry(gomock.Any(), EqKeyedRequest(&pb.CreateEntryRequest{
+					UserId:         1,
+					ItemId:         3,
+					Quantity:       1,
+					Total:          usd(700),
+					Discount:       usd(0),
+					Tax:            usd(0),
+					GiftCardAmount: usd(0),
+					PointsRedeemed: 300,
+					PointsDiscount: usd(300),
+				})).Return(&pb.CreateEntryResponse{Entry: &pb.Entry{ID: 4, Total: usd(700), PointsRedeemed: 300, PointsDiscount: usd(300)}}, nil)
+				grpc.EXPECT().CreatePointsTransaction(gomock.Any(), gomock.Eq(&pb.CreatePointsTransactionRequest{
+					UserId:         1,
+					Type:           pb.PointsTransactionType_POINTS_TRANSACTION_TYPE_REDEEM,
+					Points:         -300,
+					EntryId:        4,
+					IdempotencyKey: "redeem:entry:4",
+				})).Return(&pb.PointsTransactionResponse{Transaction: &pb.PointsTransaction{ID: 8}}, nil)
+				grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Any()).Return(&pb.UpdateEntryResponse{
+					Entry: &pb.Entry{ID: 4, Total: usd(700), PointsRedeemed: 300, PointsDiscount: usd(300)},
+				}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, provider *payments.FakeProvider) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var entry CreateEntryResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &entry))
+				require.Equal(t, int64(300), entry.PointsRedeemed)
+				require.Equal(t, dollars(300), *entry.PointsDiscount)
+
+				intent, ok := provider.Intent("pi_fake_1")
+				require.True(t, ok)
+				require.Equal(t, int64(700), intent.Amount)
+			},
+		},
+		{
+			name:   "NotEnoughPoints",
+			points: 300,
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetPointsBalance(gomock.Any(), gomock.Any()).Return(&pb.PointsBalanceResponse{Balance: 299}, nil)
+				grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, provider *payments.FakeProvider) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "WorthMoreThanTotal",
+			points: 1001,
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetPointsBalance(gomock.Any(), gomock.Any()).Return(&pb.PointsBalanceResponse{Balance: 5000}, nil)
+				grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, provider *payments.FakeProvider) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "SpentMeanwhile",
+			points: 300,
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetPointsBalance(gomock.Any(), gomock.Any()).Return(&pb.PointsBalanceResponse{Balance: 500}, nil)
+				gomock.InOrder(
+					grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{Count: 1}}, nil),
+					grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{}}, nil),
+				)
+				grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Return(&pb.CreateEntryResponse{Entry: &pb.Entry{ID: 4}}, nil)
+				grpc.EXPECT().CreatePointsTransaction(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.FailedPrecondition, "negative balance"))
+				grpc.EXPECT().DeleteEntry(gomock.Any(), gomock.Eq(&pb.DeleteEntryRequest{Id: 4})).Return(&pb.Empty{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, provider *payments.FakeProvider) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+
+				_, ok := provider.Intent("pi_fake_1")
+				require.False(t, ok)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			grpc := mockpb.NewMockGalaxyClient(ctrl)
+			expectSagas(grpc)
+			token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+			grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(&pb.GetUserResponse{User: &pb.User{ID: 1}}, nil)
+			grpc.EXPECT().GetItem(gomock.Any(), gomock.Any()).Return(&pb.GetItemResponse{Item: &pb.Item{ID: 3, Price: usd(1000)}}, nil)
+			grpc.EXPECT().GetPlan(gomock.Any(), gomock.Any()).Return(&pb.GetPlanResponse{Plan: &pb.Plan{}}, nil)
+			tc.buildStubs(grpc)
+
+			server := NewTestServer(t, grpc)
+			server.config.PointsPerUnit = 1
+			server.config.PointValue = 1
+			provider := payments.NewFakeProvider()
+			server.payments = provider
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(gin.H{"member_id": 1, "item_id": 3, "quantity": 1, "total": 1000, "points": tc.points})
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/entry/create", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthHeader(request, token)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder, provider)
+		})
+	}
+}
+
+func TestCreateEntryAPIOtherMembersPoints(t *testing.T) {
+	for _, role := range []pb.Role{pb.Role_ROLE_MEMBER, pb.Role_ROLE_STAFF, pb.Role_ROLE_ADMIN} {
+		ctrl := gomock.NewController(t)
+
+		grpc := mockpb.NewMockGalaxyClient(ctrl)
+		token := expectAuthorize(grpc, 1, role)
+		// not even staff may read or spend another member's points
+		grpc.EXPECT().GetPointsBalance(gomock.Any(), gomock.Any()).Times(0)
+		grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Times(0)
+
+		server := NewTestServer(t, grpc)
+		server.config.PointsPerUnit = 1
+		server.config.PointValue = 1
+		recorder := httptest.NewRecorder()
+
+		data, err := json.Marshal(gin.H{"member_id": 2, "item_id": 3, "quantity": 1, "points": 300})
+		require.NoError(t, err)
+
+		request, err := http.NewRequest(http.MethodPost, "/entry/create", bytes.NewReader(data))
+		require.NoError(t, err)
+
+		addAuthHeader(request, token)
+
+		server.router.ServeHTTP(recorder, request)
+		require.Equal(t, http.StatusForbidden, recorder.Code)
+		ctrl.Finish()
+	}
+}
+
+func TestUpdateEntryStatusAPIPoints(t *testing.T) {
+	testCases := []struct {
+		name       string
+		from       pb.EntryStatus
+		to         string
+		buildStubs func(grpc *mockpb.MockGalaxyClient)
+	}{
+		{
+			name: "PaidAwards",
+			from: pb.EntryStatus_ENTRY_STATUS_PENDING,
+			to:   "paid",
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Any()).Return(&pb.UpdateEntryResponse{
+					Entry: &pb.Entry{ID: 4, UserId: 1, ItemId: 3, Total: usd(1000), GiftCardAmount: usd(400), Status: pb.EntryStatus_ENTRY_STATUS_PAID},
+				}, nil)
+				grpc.EXPECT().GetItem(gomock.Any(), gomock.Eq(&pb.GetItemRequest{Id: 3})).Return(&pb.GetItemResponse{Item: &pb.Item{ID: 3, Price: usd(1000)}}, nil)
+				grpc.EXPECT().GetUser(gomock.Any(), gomock.Eq(&pb.GetUserRequest{ID: 1})).Return(&pb.GetUserResponse{User: &pb.User{ID: 1, Plan: 2}}, nil)
+				grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 2})).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 2, PointsMultiplierPercent: 200}}, nil)
+				grpc.EXPECT().CreatePointsTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ any, req *pb.CreatePointsTransactionRequest, _ ...any) (*pb.PointsTransactionResponse, error) {
+						require.Equal(t, pb.PointsTransactionType_POINTS_TRANSACTION_TYPE_EARN, req.GetType())
+						// only the 6.00 not paid with the gift card earns points
+						require.Equal(t, int64(12), req.GetPoints())
+						require.Equal(t, int32(4), req.GetEntryId())
+						require.Equal(t, "earn:entry:4", req.GetIdempotencyKey())
+						require.WithinDuration(t, time.Now().Add(24*time.Hour), req.GetExpiresAt().AsTime(), time.Minute)
+						return nil, status.Error(codes.AlreadyExists, "already earned")
+					})
+			},
+		},
+		{
+			name: "PaidFromWallet",
+			from: pb.EntryStatus_ENTRY_STATUS_PENDING,
+			to:   "paid",
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Any()).Return(&pb.UpdateEntryResponse{
+					Entry: &pb.Entry{ID: 4, UserId: 1, ItemId: 3, Total: usd(1000), PaidFromWallet: true, Status: pb.EntryStatus_ENTRY_STATUS_PAID},
+				}, nil)
+				grpc.EXPECT().CreatePointsTransaction(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		{
+			name: "GiftCardItem",
+			from: pb.EntryStatus_ENTRY_STATUS_PENDING,
+			to:   "paid",
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Any()).Return(&pb.UpdateEntryResponse{
+					Entry: &pb.Entry{ID: 4, UserId: 1, ItemId: 3, Total: usd(1000), Status: pb.EntryStatus_ENTRY_STATUS_PAID},
+				}, nil)
+				grpc.EXPECT().GetItem(gomock.Any(), gomock.Eq(&pb.GetItemRequest{Id: 3})).Return(&pb.GetItemResponse{
+					Item: &pb.Item{ID: 3, Price: usd(1000), GiftCard: true},
+				}, nil)
+				grpc.EXPECT().CreatePointsTransaction(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		{
+			name: "RefundedReverses",
+			from: pb.EntryStatus_ENTRY_STATUS_PAID,
+			to:   "refunded",
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				expectNoIssuedGiftCard(grpc)
+				grpc.EXPECT().ListPointsTransactions(gomock.Any(), gomock.Eq(&pb.ListPointsTransactionsRequest{UserId: 1, EntryId: 4})).Return(&pb.ListPointsTransactionsResponse{
+					Transactions: []*pb.PointsTransaction{
+						{ID: 9, UserId: 1, Type: pb.PointsTransactionType_POINTS_TRANSACTION_TYPE_EARN, Points: 10, EntryId: 4},
+						{ID: 8, UserId: 1, Type: pb.PointsTransactionType_POINTS_TRANSACTION_TYPE_REDEEM, Points: -300, EntryId: 4},
+					},
+				}, nil)
+				gomock.InOrder(
+					grpc.EXPECT().CreatePointsTransaction(gomock.Any(), gomock.Eq(&pb.CreatePointsTransactionRequest{
+						UserId:         1,
+						Type:           pb.PointsTransactionType_POINTS_TRANSACTION_TYPE_REVERSAL,
+						Points:         -10,
+						EntryId:        4,
+						Reason:         "damaged",
+						ReversesId:     9,
+						IdempotencyKey: "reverse:9",
+					})).Return(&pb.PointsTransactionResponse{}, nil),
+					grpc.EXPECT().CreatePointsTransaction(gomock.Any(), gomock.Eq(&pb.CreatePointsTransactionRequest{
+						UserId:         1,
+						Type:           pb.PointsTransactionType_POINTS_TRANSACTION_TYPE_REVERSAL,
+						Points:         300,
+						EntryId:        4,
+						Reason:         "damaged",
+						ReversesId:     8,
+						IdempotencyKey: "reverse:8",
+					})).Return(nil, status.Error(codes.AlreadyExists, "already reversed")),
+					grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Any()).Return(&pb.UpdateEntryResponse{
+						Entry: &pb.Entry{ID: 4, UserId: 1, Total: usd(1000), Status: pb.EntryStatus_ENTRY_STATUS_REFUNDED},
+					}, nil),
+				)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			grpc := mockpb.NewMockGalaxyClient(ctrl)
+			token := expectAuthorize(grpc, 9, pb.Role_ROLE_STAFF)
+			grpc.EXPECT().GetEntry(gomock.Any(), gomock.Any()).Return(&pb.GetEntryResponse{
+				Entry: &pb.Entry{ID: 4, UserId: 1, Total: usd(1000), Status: tc.from},
+			}, nil)
+			tc.buildStubs(grpc)
+
+			server := NewTestServer(t, grpc)
+			server.config.PointsPerUnit = 1
+			server.config.PointsValidity = 24 * time.Hour
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(gin.H{"id": 4, "status": tc.to, "reason": "damaged"})
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/entry/status", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthHeader(request, token)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, http.StatusOK, recorder.Code)
+		})
+	}
+}
+
+func TestExpirePoints(t *testing.T) {
+	for _, acquired := range []bool{true, false} {
+		ctrl := gomock.NewController(t)
+
+		grpc := mockpb.NewMockGalaxyClient(ctrl)
+		server := NewTestServer(t, grpc)
+		server.config.PointsPerUnit = 1
+		server.config.PointsExpiryInterval = time.Hour
+
+		grpc.EXPECT().AcquireLease(gomock.Any(), gomock.Eq(&pb.AcquireLeaseRequest{
+			Name:       pointsExpiryLease,
+			Holder:     server.holder,
+			TtlSeconds: 7200,
+		})).Return(&pb.AcquireLeaseResponse{Acquired: acquired}, nil)
+		// points are left to the controller holding the lease
+		expiries := 0
+		if acquired {
+			expiries = 1
+		}
+		grpc.EXPECT().ExpirePoints(gomock.Any(), gomock.Any()).Return(&pb.ExpirePointsResponse{Points: 30, Users: 2}, nil).Times(expiries)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		server.ExpirePoints(ctx)
+		ctrl.Finish()
+	}
+}
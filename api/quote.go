@@ -0,0 +1,331 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/machearn/galaxy_controller/money"
+	"github.com/machearn/galaxy_controller/pb"
+	"github.com/machearn/galaxy_controller/tax"
+	"github.com/machearn/galaxy_controller/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	quotePurpose = "quote"
+	// quoteDuration is how long the prices of a quote are guaranteed.
+	quoteDuration = time.Minute * 10
+)
+
+type QuoteItem struct {
+	ItemID   int32 `json:"item_id" binding:"required,min=1"`
+	Quantity int32 `json:"quantity" binding:"required,min=1"`
+}
+
+type QuoteRequest struct {
+	// MemberID is the user the quote is for, the authenticated user if unset.
+	MemberID int32       `json:"member_id"`
+	Items    []QuoteItem `json:"items" binding:"required,min=1,dive"`
+	// Region is the tax region, the default region of the tax rules if unset.
+	Region string `json:"region"`
+	// Currency is the currency to price in, the member's currency if unset.
+	Currency string `json:"currency"`
+	// CouponCode takes the discount of a coupon off the lines it applies to.
+	// CreateEntry must be given the same code to buy at the quoted price.
+	CouponCode string `json:"coupon_code"`
+}
+
+type QuoteLine struct {
+	ItemID    int32       `json:"item_id"`
+	Name      string      `json:"name"`
+	Quantity  int32       `json:"quantity"`
+	UnitPrice money.Money `json:"unit_price"`
+	Subtotal  money.Money `json:"subtotal"`
+	Discount  money.Money `json:"discount"`
+	Tax       money.Money `json:"tax"`
+	Total     money.Money `json:"total"`
+}
+
+type QuoteDiscount struct {
+	Source      string      `json:"source"`
+	Description string      `json:"description"`
+	Amount      money.Money `json:"amount"`
+}
+
+type Quote struct {
+	// ID is a signed copy of the quote that CreateEntry accepts until
+	// ExpiredAt in place of a client computed total.
+	ID        string          `json:"id"`
+	MemberID  int32           `json:"member_id"`
+	Lines     []QuoteLine     `json:"lines"`
+	Subtotal  money.Money     `json:"subtotal"`
+	Discounts []QuoteDiscount `json:"discounts"`
+	Region    string          `json:"region,omitempty"`
+	Tax       money.Money     `json:"tax"`
+	Total     money.Money     `json:"total"`
+	ExpiredAt time.Time       `json:"expired_at"`
+}
+
+// quoteLineClaims carries the price of a line after the plan discount but
+// before the coupon and tax, which are worked out again when the quote is
+// redeemed.
+type quoteLineClaims struct {
+	ItemID   int32 `json:"item_id"`
+	Quantity int32 `json:"quantity"`
+	Total    int64 `json:"total"`
+}
+
+type quoteClaims struct {
+	Purpose  string            `json:"purpose"`
+	UserID   int32             `json:"user_id"`
+	TenantID int32             `json:"tenant_id"`
+	Lines    []quoteLineClaims `json:"lines"`
+	Region   string            `json:"region"`
+	Currency string            `json:"currency"`
+	// CouponCode is the normalized code of the coupon the quote was priced
+	// with, if any.
+	CouponCode string    `json:"coupon_code"`
+	ExpiredAt  time.Time `json:"expired_at"`
+}
+
+// percentOf returns percent percent of amount, rounded down.
+func percentOf(amount int64, percent int32) int64 {
+	return amount * int64(percent) / 100
+}
+
+// priceQuote prices quantities[i] of items[i] at unitPrices[i] for a
+// subscriber of plan buying in the tax region. Unit prices are all in the
+// same currency. The coupon, if not nil, is taken off every line it applies
+// to after the plan discount, as it would be off an entry for the line.
+func priceQuote(items []*pb.Item, unitPrices []money.Money, quantities []int32, plan *pb.Plan, coupon *pb.Coupon, exchange *money.Exchange, now time.Time, taxes *tax.Engine, region string) (Quote, error) {
+	currency := unitPrices[0].Currency
+	quote := Quote{
+		Lines:     make([]QuoteLine, len(items)),
+		Subtotal:  money.New(0, currency),
+		Discounts: []QuoteDiscount{},
+		Tax:       money.New(0, currency),
+		Total:     money.New(0, currency),
+	}
+
+	planDiscount := money.New(0, currency)
+	couponTotal := money.New(0, currency)
+	for i, item := range items {
+		subtotal := unitPrices[i].Amount * int64(quantities[i])
+		discount := percentOf(subtotal, plan.GetMemberDiscountPercent())
+		planDiscount.Amount += discount
+
+		if coupon != nil && (len(coupon.GetItemIds()) == 0 || containsID(coupon.GetItemIds(), item.GetID())) {
+			couponAmount, err := couponDiscount(coupon, item.GetID(), plan.GetID(), money.New(subtotal-discount, currency), exchange, now)
+			if err != nil {
+				return Quote{}, err
+			}
+			discount += couponAmount.Amount
+			couponTotal.Amount += couponAmount.Amount
+		}
+
+		taxResult, err := taxes.Calculate(region, item.GetID(), subtotal-discount)
+		if err != nil {
+			return Quote{}, err
+		}
+		quote.Lines[i] = QuoteLine{
+			ItemID:    item.GetID(),
+			Name:      item.GetName(),
+			Quantity:  quantities[i],
+			UnitPrice: unitPrices[i],
+			Subtotal:  money.New(subtotal, currency),
+			Discount:  money.New(discount, currency),
+			Tax:       money.New(taxResult.Tax, currency),
+			Total:     money.New(taxResult.Total, currency),
+		}
+		quote.Region = taxResult.Region
+		quote.Subtotal.Amount += subtotal
+		quote.Tax.Amount += taxResult.Tax
+		quote.Total.Amount += taxResult.Total
+	}
+
+	if planDiscount.Amount > 0 {
+		quote.Discounts = append(quote.Discounts, QuoteDiscount{
+			Source:      "plan",
+			Description: fmt.Sprintf("%d%% member discount on %s", plan.GetMemberDiscountPercent(), plan.GetName()),
+			Amount:      planDiscount,
+		})
+	}
+	if coupon != nil {
+		if couponTotal.Amount == 0 {
+			return Quote{}, errors.New("coupon does not apply to these items")
+		}
+		quote.Discounts = append(quote.Discounts, QuoteDiscount{
+			Source:      "coupon",
+			Description: fmt.Sprintf("coupon %s", coupon.GetCode()),
+			Amount:      couponTotal,
+		})
+	}
+	return quote, nil
+}
+
+// CreateQuote prices items for a user, including the discounts of their
+// plan and of the coupon given, and signs the result so the price can be guaranteed for a while.
+func (server *Server) CreateQuote(ctx *gin.Context) {
+	var req QuoteRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+	if req.MemberID == 0 {
+		req.MemberID = authPayload.UserID
+	}
+
+	userResult, err := server.grpc.GetUser(ctx, &pb.GetUserRequest{ID: req.MemberID})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.NotFound {
+				ctx.JSON(http.StatusBadRequest, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if !inTenant(ctx, userResult.GetUser().GetTeamId()) {
+		ctx.JSON(http.StatusNotFound, errorResponse(errors.New("user not found")))
+		return
+	}
+
+	currency := money.NormalizeCurrency(req.Currency)
+	if len(currency) == 0 {
+		currency = server.userCurrency(userResult.GetUser())
+	}
+	if err := server.checkCurrency(currency); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	items := make([]*pb.Item, len(req.Items))
+	unitPrices := make([]money.Money, len(req.Items))
+	quantities := make([]int32, len(req.Items))
+	for i, quoteItem := range req.Items {
+		itemResult, err := server.grpc.GetItem(tenantContext(ctx), &pb.GetItemRequest{Id: quoteItem.ItemID})
+		if err != nil {
+			if apiErr, ok := status.FromError(err); ok {
+				if apiErr.Code() == codes.NotFound {
+					ctx.JSON(http.StatusNotFound, errorResponse(apiErr.Err()))
+					return
+				}
+				ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		if !inTenant(ctx, itemResult.GetItem().GetTenantId()) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errors.New("item not found")))
+			return
+		}
+		items[i] = itemResult.GetItem()
+		quantities[i] = quoteItem.Quantity
+		unitPrices[i], err = server.itemPrice(items[i], currency)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+	}
+
+	planID, err := server.subscriptionPlan(ctx, userResult.GetUser())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	plan, err := server.lookupPlan(ctx, planID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	var coupon *pb.Coupon
+	if len(req.CouponCode) > 0 {
+		couponResult, err := server.grpc.GetCouponByCode(ctx, &pb.GetCouponByCodeRequest{Code: normalizeCouponCode(req.CouponCode)})
+		if err != nil {
+			if apiErr, ok := status.FromError(err); ok {
+				if apiErr.Code() == codes.NotFound {
+					ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("coupon is invalid")))
+					return
+				}
+				ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+		coupon = couponResult.GetCoupon()
+	}
+
+	quote, err := priceQuote(items, unitPrices, quantities, plan, coupon, server.exchange, time.Now(), server.tax, req.Region)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	quote.MemberID = req.MemberID
+	quote.ExpiredAt = time.Now().Add(quoteDuration)
+
+	claims := quoteClaims{
+		Purpose:   quotePurpose,
+		UserID:    req.MemberID,
+		TenantID:  authPayload.TenantID,
+		Lines:     make([]quoteLineClaims, len(quote.Lines)),
+		Region:    quote.Region,
+		Currency:  currency,
+		ExpiredAt: quote.ExpiredAt,
+	}
+	if coupon != nil {
+		claims.CouponCode = normalizeCouponCode(req.CouponCode)
+	}
+	for i, line := range quote.Lines {
+		claims.Lines[i] = quoteLineClaims{
+			ItemID:   line.ItemID,
+			Quantity: line.Quantity,
+			Total:    line.Subtotal.Amount - percentOf(line.Subtotal.Amount, plan.GetMemberDiscountPercent()),
+		}
+	}
+
+	quote.ID, err = util.SignToken(server.config.TokenSymmetricKey, claims)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, quote)
+}
+
+// verifyQuote checks that quoteID is a valid, unexpired quote for exactly
+// quantity of the item bought by the user in the tax region with the coupon
+// code and returns its total before the coupon and tax. An empty currency
+// accepts the currency of the quote.
+func (server *Server) verifyQuote(ctx *gin.Context, quoteID string, userID int32, itemID int32, quantity int32, region string, currency string, couponCode string) (money.Money, error) {
+	var claims quoteClaims
+	if err := util.VerifyToken(server.config.TokenSymmetricKey, quoteID, &claims); err != nil || claims.Purpose != quotePurpose {
+		return money.Money{}, errors.New("quote is invalid")
+	}
+	if time.Now().After(claims.ExpiredAt) {
+		return money.Money{}, errors.New("quote has expired")
+	}
+
+	currency = money.NormalizeCurrency(currency)
+	authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+	if claims.UserID != userID || claims.TenantID != authPayload.TenantID || len(claims.Lines) != 1 ||
+		claims.Lines[0].ItemID != itemID || claims.Lines[0].Quantity != quantity || claims.Region != region ||
+		(len(currency) > 0 && currency != claims.Currency) || normalizeCouponCode(couponCode) != claims.CouponCode {
+		return money.Money{}, errors.New("quote does not match the entry")
+	}
+	return money.New(claims.Lines[0].Total, claims.Currency), nil
+}
@@ -0,0 +1,305 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/machearn/galaxy_controller/money"
+	"github.com/machearn/galaxy_controller/pb"
+	mockpb "github.com/machearn/galaxy_controller/pb/mock"
+	"github.com/machearn/galaxy_controller/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriceQuote(t *testing.T) {
+	items := []*pb.Item{
+		{ID: 3, Name: "lamp"},
+		{ID: 5, Name: "desk"},
+	}
+	prices := []money.Money{dollars(999), dollars(15000)}
+	plan := &pb.Plan{ID: 2, Name: "pro", MemberDiscountPercent: 10}
+
+	quote, err := priceQuote(items, prices, []int32{3, 1}, plan, nil, nil, time.Now(), noTax(t), "")
+	require.NoError(t, err)
+	require.Equal(t, []QuoteLine{
+		{ItemID: 3, Name: "lamp", Quantity: 3, UnitPrice: dollars(999), Subtotal: dollars(2997), Discount: dollars(299), Tax: dollars(0), Total: dollars(2698)},
+		{ItemID: 5, Name: "desk", Quantity: 1, UnitPrice: dollars(15000), Subtotal: dollars(15000), Discount: dollars(1500), Tax: dollars(0), Total: dollars(13500)},
+	}, quote.Lines)
+	require.Equal(t, dollars(17997), quote.Subtotal)
+	require.Equal(t, dollars(16198), quote.Total)
+	require.Equal(t, []QuoteDiscount{{Source: "plan", Description: "10% member discount on pro", Amount: dollars(1799)}}, quote.Discounts)
+
+	quote, err = priceQuote(items, prices, []int32{3, 1}, &pb.Plan{}, nil, nil, time.Now(), noTax(t), "")
+	require.NoError(t, err)
+	require.Equal(t, quote.Subtotal, quote.Total)
+	require.Empty(t, quote.Discounts)
+}
+
+func TestPriceQuoteWithCoupon(t *testing.T) {
+	items := []*pb.Item{
+		{ID: 3, Name: "lamp"},
+		{ID: 5, Name: "desk"},
+	}
+	prices := []money.Money{dollars(1000), dollars(15000)}
+	plan := &pb.Plan{ID: 2, Name: "pro", MemberDiscountPercent: 10}
+	coupon := &pb.Coupon{Code: "LAMP15", Type: pb.CouponType_COUPON_TYPE_PERCENT, Value: 15, ItemIds: []int32{3}, Active: true}
+
+	quote, err := priceQuote(items, prices, []int32{1, 1}, plan, coupon, nil, time.Now(), noTax(t), "")
+	require.NoError(t, err)
+	// 15% off the lamp after the member discount, nothing off the desk
+	require.Equal(t, dollars(235), quote.Lines[0].Discount)
+	require.Equal(t, dollars(765), quote.Lines[0].Total)
+	require.Equal(t, dollars(1500), quote.Lines[1].Discount)
+	require.Equal(t, dollars(14265), quote.Total)
+	require.Equal(t, []QuoteDiscount{
+		{Source: "plan", Description: "10% member discount on pro", Amount: dollars(1600)},
+		{Source: "coupon", Description: "coupon LAMP15", Amount: dollars(135)},
+	}, quote.Discounts)
+
+	_, err = priceQuote(items[1:], prices[1:], []int32{1}, plan, coupon, nil, time.Now(), noTax(t), "")
+	require.Error(t, err)
+
+	coupon.Active = false
+	_, err = priceQuote(items, prices, []int32{1, 1}, plan, coupon, nil, time.Now(), noTax(t), "")
+	require.Error(t, err)
+}
+
+func TestPriceQuoteWithTax(t *testing.T) {
+	items := []*pb.Item{
+		{ID: 3, Name: "lamp"},
+		{ID: 5, Name: "bread"},
+	}
+	prices := []money.Money{dollars(1000), dollars(300)}
+	plan := &pb.Plan{ID: 2, Name: "pro", MemberDiscountPercent: 10}
+
+	quote, err := priceQuote(items, prices, []int32{1, 1}, plan, nil, nil, time.Now(), newTestTaxEngine(t), "us-ca")
+	require.NoError(t, err)
+	require.Equal(t, "US-CA", quote.Region)
+	// 7.25% on the discounted price of the lamp, the bread is exempt
+	require.Equal(t, []QuoteLine{
+		{ItemID: 3, Name: "lamp", Quantity: 1, UnitPrice: dollars(1000), Subtotal: dollars(1000), Discount: dollars(100), Tax: dollars(65), Total: dollars(965)},
+		{ItemID: 5, Name: "bread", Quantity: 1, UnitPrice: dollars(300), Subtotal: dollars(300), Discount: dollars(30), Tax: dollars(0), Total: dollars(270)},
+	}, quote.Lines)
+	require.Equal(t, dollars(65), quote.Tax)
+	require.Equal(t, dollars(1235), quote.Total)
+
+	quote, err = priceQuote(items, prices, []int32{1, 1}, plan, nil, nil, time.Now(), newTestTaxEngine(t), "DE")
+	require.NoError(t, err)
+	// German prices include VAT
+	require.Equal(t, dollars(187), quote.Tax)
+	require.Equal(t, dollars(1170), quote.Total)
+
+	_, err = priceQuote(items, prices, []int32{1, 1}, plan, nil, nil, time.Now(), newTestTaxEngine(t), "FR")
+	require.Error(t, err)
+}
+
+func TestCreateQuoteAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Eq(&pb.GetUserRequest{ID: 1})).Return(&pb.GetUserResponse{User: &pb.User{ID: 1, Plan: 2}}, nil)
+	grpc.EXPECT().GetItem(gomock.Any(), gomock.Eq(&pb.GetItemRequest{Id: 3})).Return(&pb.GetItemResponse{
+		Item: &pb.Item{ID: 3, Name: "lamp", Price: usd(1000)},
+	}, nil)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 2})).Return(&pb.GetPlanResponse{
+		Plan: &pb.Plan{ID: 2, Name: "pro", MemberDiscountPercent: 20},
+	}, nil)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	data, err := json.Marshal(gin.H{"items": []gin.H{{"item_id": 3, "quantity": 2}}})
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/quote", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var quote Quote
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &quote))
+	require.Equal(t, int32(1), quote.MemberID)
+	require.Equal(t, dollars(2000), quote.Subtotal)
+	require.Equal(t, dollars(1600), quote.Total)
+	require.WithinDuration(t, time.Now().Add(quoteDuration), quote.ExpiredAt, time.Second*5)
+
+	var claims quoteClaims
+	require.NoError(t, util.VerifyToken(server.config.TokenSymmetricKey, quote.ID, &claims))
+	require.Equal(t, quotePurpose, claims.Purpose)
+	require.Equal(t, "USD", claims.Currency)
+	require.Equal(t, []quoteLineClaims{{ItemID: 3, Quantity: 2, Total: 1600}}, claims.Lines)
+}
+
+func TestCreateEntryAPIWithQuote(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	expectSagas(grpc)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(&pb.GetUserResponse{User: &pb.User{ID: 1}}, nil)
+	grpc.EXPECT().GetItem(gomock.Any(), gomock.Any()).Return(&pb.GetItemResponse{Item: &pb.Item{ID: 3}}, nil)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Any()).Return(&pb.GetPlanResponse{Plan: &pb.Plan{}}, nil)
+	grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{Count: 1}}, nil)
+	grpc.EXPECT().CreateEntry(gomock.Any(), EqKeyedRequest(&pb.CreateEntryRequest{
+		UserId:         1,
+		ItemId:         3,
+		Quantity:       2,
+		Total:          usd(1600),
+		Discount:       usd(0),
+		Tax:            usd(0),
+		GiftCardAmount: usd(0),
+		PointsDiscount: usd(0),
+	})).Return(&pb.CreateEntryResponse{Entry: &pb.Entry{ID: 4, UserId: 1, ItemId: 3, Quantity: 2, Total: usd(1600)}}, nil)
+	grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Any()).Return(&pb.UpdateEntryResponse{
+		Entry: &pb.Entry{ID: 4, UserId: 1, ItemId: 3, Quantity: 2, Total: usd(1600)},
+	}, nil)
+
+	server := NewTestServer(t, grpc)
+
+	quoteID, err := util.SignToken(server.config.TokenSymmetricKey, quoteClaims{
+		Purpose:   quotePurpose,
+		UserID:    1,
+		Lines:     []quoteLineClaims{{ItemID: 3, Quantity: 2, Total: 1600}},
+		Currency:  "USD",
+		ExpiredAt: time.Now().Add(time.Minute),
+	})
+	require.NoError(t, err)
+
+	// the quoted total is charged rather than the price of the item
+	data, err := json.Marshal(gin.H{"member_id": 1, "item_id": 3, "quantity": 2, "quote_id": quoteID})
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodPost, "/entry/create", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestCreateEntryAPIInvalidQuote(t *testing.T) {
+	testCases := []struct {
+		name     string
+		claims   quoteClaims
+		currency string
+	}{
+		{
+			name: "Expired",
+			claims: quoteClaims{
+				Purpose:   quotePurpose,
+				UserID:    1,
+				Lines:     []quoteLineClaims{{ItemID: 3, Quantity: 2, Total: 1600}},
+				Currency:  "USD",
+				ExpiredAt: time.Now().Add(-time.Minute),
+			},
+		},
+		{
+			name: "OtherQuantity",
+			claims: quoteClaims{
+				Purpose:   quotePurpose,
+				UserID:    1,
+				Lines:     []quoteLineClaims{{ItemID: 3, Quantity: 1, Total: 800}},
+				Currency:  "USD",
+				ExpiredAt: time.Now().Add(time.Minute),
+			},
+		},
+		{
+			name: "OtherUser",
+			claims: quoteClaims{
+				Purpose:   quotePurpose,
+				UserID:    2,
+				Lines:     []quoteLineClaims{{ItemID: 3, Quantity: 2, Total: 1600}},
+				Currency:  "USD",
+				ExpiredAt: time.Now().Add(time.Minute),
+			},
+		},
+		{
+			name: "OtherRegion",
+			claims: quoteClaims{
+				Purpose:   quotePurpose,
+				UserID:    1,
+				Lines:     []quoteLineClaims{{ItemID: 3, Quantity: 2, Total: 1600}},
+				Region:    "DE",
+				Currency:  "USD",
+				ExpiredAt: time.Now().Add(time.Minute),
+			},
+		},
+		{
+			name: "OtherCurrency",
+			claims: quoteClaims{
+				Purpose:   quotePurpose,
+				UserID:    1,
+				Lines:     []quoteLineClaims{{ItemID: 3, Quantity: 2, Total: 1600}},
+				Currency:  "USD",
+				ExpiredAt: time.Now().Add(time.Minute),
+			},
+			currency: "eur",
+		},
+		{
+			// the entry is bought without the coupon the quote was priced with
+			name: "OtherCoupon",
+			claims: quoteClaims{
+				Purpose:    quotePurpose,
+				UserID:     1,
+				Lines:      []quoteLineClaims{{ItemID: 3, Quantity: 2, Total: 1600}},
+				Currency:   "USD",
+				CouponCode: "SAVE10",
+				ExpiredAt:  time.Now().Add(time.Minute),
+			},
+		},
+		{
+			name: "WrongPurpose",
+			claims: quoteClaims{
+				Purpose:   teamInvitationPurpose,
+				UserID:    1,
+				Lines:     []quoteLineClaims{{ItemID: 3, Quantity: 2, Total: 1600}},
+				Currency:  "USD",
+				ExpiredAt: time.Now().Add(time.Minute),
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			grpc := mockpb.NewMockGalaxyClient(ctrl)
+			token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+			grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Times(0)
+
+			server := NewTestServer(t, grpc)
+
+			quoteID, err := util.SignToken(server.config.TokenSymmetricKey, tc.claims)
+			require.NoError(t, err)
+
+			data, err := json.Marshal(gin.H{"member_id": 1, "item_id": 3, "quantity": 2, "quote_id": quoteID, "currency": tc.currency})
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodPost, "/entry/create", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthHeader(request, token)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, http.StatusBadRequest, recorder.Code)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/machearn/galaxy_controller/money"
+	"github.com/machearn/galaxy_controller/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Receipt breaks the total of an entry down into its price, discount and
+// tax.
+type Receipt struct {
+	EntryID  int32       `json:"entry_id"`
+	UserID   int32       `json:"member_id"`
+	ItemID   int32       `json:"item_id"`
+	ItemName string      `json:"item_name"`
+	Quantity int32       `json:"quantity"`
+	Subtotal money.Money `json:"subtotal"`
+	Discount money.Money `json:"discount"`
+	CouponID int32       `json:"coupon_id,omitempty"`
+	// PointsDiscount is what the loyalty points redeemed took off.
+	PointsRedeemed int64        `json:"points_redeemed,omitempty"`
+	PointsDiscount *money.Money `json:"points_discount,omitempty"`
+	Tax            money.Money  `json:"tax"`
+	Total          money.Money  `json:"total"`
+	// GiftCardAmount is the part of Total paid with a gift card.
+	GiftCardAmount *money.Money `json:"gift_card_amount,omitempty"`
+	Status         string       `json:"status"`
+	CreatedAt      time.Time    `json:"created_at"`
+
+	TaxRegion string `json:"tax_region,omitempty"`
+	// TaxRate is a percentage such as "7.25%".
+	TaxRate      string `json:"tax_rate"`
+	TaxInclusive bool   `json:"tax_inclusive"`
+}
+
+// formatBasisPoints renders a rate in basis points as a percentage.
+func formatBasisPoints(rate int32) string {
+	if rate%100 == 0 {
+		return fmt.Sprintf("%d%%", rate/100)
+	}
+	return fmt.Sprintf("%d.%02d%%", rate/100, rate%100)
+}
+
+func newReceiptResponse(entry *pb.Entry, item *pb.Item) Receipt {
+	// the subtotal is the price before the discounts and any tax added on top
+	total := money.FromProto(entry.GetTotal())
+	discount := money.New(entry.GetDiscount().GetAmount(), total.Currency)
+	tax := money.New(entry.GetTax().GetAmount(), total.Currency)
+	pointsDiscount := money.New(entry.GetPointsDiscount().GetAmount(), total.Currency)
+	subtotal := money.New(total.Amount+discount.Amount+pointsDiscount.Amount, total.Currency)
+	if !entry.GetTaxInclusive() {
+		subtotal.Amount -= tax.Amount
+	}
+
+	res := Receipt{
+		EntryID:   entry.GetID(),
+		UserID:    entry.GetUserId(),
+		ItemID:    entry.GetItemId(),
+		ItemName:  item.GetName(),
+		Quantity:  entry.GetQuantity(),
+		Subtotal:  subtotal,
+		Discount:  discount,
+		CouponID:  entry.GetCouponId(),
+		Tax:       tax,
+		Total:     total,
+		Status:    entryStatusNames[entry.GetStatus()],
+		CreatedAt: entry.GetCreatedAt().AsTime(),
+
+		TaxRegion:    entry.GetTaxRegion(),
+		TaxRate:      formatBasisPoints(entry.GetTaxRate()),
+		TaxInclusive: entry.GetTaxInclusive(),
+	}
+	if entry.GetGiftCardId() != 0 {
+		giftCardAmount := money.New(entry.GetGiftCardAmount().GetAmount(), total.Currency)
+		res.GiftCardAmount = &giftCardAmount
+	}
+	if entry.GetPointsRedeemed() != 0 {
+		res.PointsRedeemed = entry.GetPointsRedeemed()
+		res.PointsDiscount = &pointsDiscount
+	}
+	return res
+}
+
+type GetReceiptRequest struct {
+	ID int32 `uri:"id" binding:"required,min=1"`
+}
+
+func (server *Server) GetReceipt(ctx *gin.Context) {
+	var req GetReceiptRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	result, err := server.grpc.GetEntry(tenantContext(ctx), &pb.GetEntryRequest{Id: req.ID})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.NotFound {
+				ctx.JSON(http.StatusNotFound, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	entry := result.GetEntry()
+	if !inTenant(ctx, entry.GetTenantId()) {
+		ctx.JSON(http.StatusNotFound, errorResponse(errors.New("entry not found")))
+		return
+	}
+
+	// the receipt outlives the item, which may have been deleted since
+	itemResult, err := server.grpc.GetItem(tenantContext(ctx), &pb.GetItemRequest{Id: entry.GetItemId()})
+	if err != nil && status.Code(err) != codes.NotFound {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newReceiptResponse(entry, itemResult.GetItem()))
+}
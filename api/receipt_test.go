@@ -0,0 +1,217 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/machearn/galaxy_controller/pb"
+	mockpb "github.com/machearn/galaxy_controller/pb/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateEntryAPIWithTax(t *testing.T) {
+	testCases := []struct {
+		name          string
+		region        string
+		buildStubs    func(grpc *mockpb.MockGalaxyClient)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "Exclusive",
+			region: "us-ca",
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{Count: 1}}, nil)
+				grpc.EXPECT().CreateEntry(gomock.Any(), EqKeyedRequest(&pb.CreateEntryRequest{
+					UserId:         1,
+					ItemId:         3,
+					Quantity:       1,
+					Total:          usd(1073),
+					Discount:       usd(0),
+					Tax:            usd(73),
+					TaxRegion:      "US-CA",
+					TaxRate:        725,
+					GiftCardAmount: usd(0),
+					PointsDiscount: usd(0),
+				})).Return(&pb.CreateEntryResponse{Entry: &pb.Entry{ID: 4, Total: usd(1073), Tax: usd(73), TaxRegion: "US-CA"}}, nil)
+				grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Any()).Return(&pb.UpdateEntryResponse{
+					Entry: &pb.Entry{ID: 4, Total: usd(1073), Tax: usd(73), TaxRegion: "US-CA"},
+				}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var entry Entry
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &entry))
+				require.Equal(t, dollars(1073), entry.Total)
+				require.Equal(t, dollars(73), entry.Tax)
+				require.Equal(t, "US-CA", entry.TaxRegion)
+			},
+		},
+		{
+			name:   "Inclusive",
+			region: "DE",
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{Count: 1}}, nil)
+				grpc.EXPECT().CreateEntry(gomock.Any(), EqKeyedRequest(&pb.CreateEntryRequest{
+					UserId:         1,
+					ItemId:         3,
+					Quantity:       1,
+					Total:          usd(1000),
+					Discount:       usd(0),
+					Tax:            usd(160),
+					TaxRegion:      "DE",
+					TaxRate:        1900,
+					TaxInclusive:   true,
+					GiftCardAmount: usd(0),
+					PointsDiscount: usd(0),
+				})).Return(&pb.CreateEntryResponse{Entry: &pb.Entry{ID: 4, Total: usd(1000), Tax: usd(160)}}, nil)
+				grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Any()).Return(&pb.UpdateEntryResponse{
+					Entry: &pb.Entry{ID: 4, Total: usd(1000), Tax: usd(160)},
+				}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:   "UnknownRegion",
+			region: "FR",
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			grpc := mockpb.NewMockGalaxyClient(ctrl)
+			expectSagas(grpc)
+			token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+			grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(&pb.GetUserResponse{User: &pb.User{ID: 1}}, nil).AnyTimes()
+			grpc.EXPECT().GetItem(gomock.Any(), gomock.Any()).Return(&pb.GetItemResponse{Item: &pb.Item{ID: 3, Price: usd(1000)}}, nil).AnyTimes()
+			grpc.EXPECT().GetPlan(gomock.Any(), gomock.Any()).Return(&pb.GetPlanResponse{Plan: &pb.Plan{}}, nil).AnyTimes()
+			tc.buildStubs(grpc)
+
+			server := NewTestServer(t, grpc)
+			server.tax = newTestTaxEngine(t)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(gin.H{"member_id": 1, "item_id": 3, "quantity": 1, "total": 1000, "region": tc.region})
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/entry/create", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthHeader(request, token)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestGetReceiptAPI(t *testing.T) {
+	testCases := []struct {
+		name          string
+		entry         *pb.Entry
+		buildStubs    func(grpc *mockpb.MockGalaxyClient)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Exclusive",
+			entry: &pb.Entry{
+				ID: 4, UserId: 1, ItemId: 3, Quantity: 1, Total: usd(965), Discount: usd(100), CouponId: 7,
+				Tax: usd(65), TaxRegion: "US-CA", TaxRate: 725, Status: pb.EntryStatus_ENTRY_STATUS_PAID,
+			},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetItem(gomock.Any(), gomock.Eq(&pb.GetItemRequest{Id: 3})).Return(&pb.GetItemResponse{Item: &pb.Item{ID: 3, Name: "lamp"}}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var receipt Receipt
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &receipt))
+				require.Equal(t, "lamp", receipt.ItemName)
+				require.Equal(t, dollars(1000), receipt.Subtotal)
+				require.Equal(t, dollars(100), receipt.Discount)
+				require.Equal(t, dollars(65), receipt.Tax)
+				require.Equal(t, dollars(965), receipt.Total)
+				require.Equal(t, "7.25%", receipt.TaxRate)
+				require.Equal(t, "paid", receipt.Status)
+			},
+		},
+		{
+			name: "InclusiveItemDeleted",
+			entry: &pb.Entry{
+				ID: 4, UserId: 1, ItemId: 3, Quantity: 1, Total: usd(1000),
+				Tax: usd(160), TaxRegion: "DE", TaxRate: 1900, TaxInclusive: true,
+			},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetItem(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.NotFound, "item not found"))
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var receipt Receipt
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &receipt))
+				require.Empty(t, receipt.ItemName)
+				require.Equal(t, dollars(1000), receipt.Subtotal)
+				require.Equal(t, dollars(160), receipt.Tax)
+				require.Equal(t, "19%", receipt.TaxRate)
+				require.True(t, receipt.TaxInclusive)
+			},
+		},
+		{
+			name:  "OtherTenant",
+			entry: &pb.Entry{ID: 4, TenantId: 9},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetItem(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			grpc := mockpb.NewMockGalaxyClient(ctrl)
+			token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+			grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(&pb.GetUserResponse{User: &pb.User{ID: 1}}, nil).AnyTimes()
+			grpc.EXPECT().GetEntry(gomock.Any(), gomock.Eq(&pb.GetEntryRequest{Id: 4})).Return(&pb.GetEntryResponse{Entry: tc.entry}, nil)
+			tc.buildStubs(grpc)
+
+			server := NewTestServer(t, grpc)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/entry/receipt/%d", 4), nil)
+			require.NoError(t, err)
+
+			addAuthHeader(request, token)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
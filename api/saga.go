@@ -0,0 +1,888 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/machearn/galaxy_controller/money"
+	"github.com/machearn/galaxy_controller/payments"
+	"github.com/machearn/galaxy_controller/pb"
+	"github.com/machearn/galaxy_controller/saga"
+	"github.com/machearn/galaxy_controller/tax"
+	"github.com/machearn/galaxy_controller/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	sagaCreateEntry    = "create-entry"
+	sagaCheckout       = "checkout"
+	sagaRedeemGiftCard = "redeem-gift-card"
+	sagaChangePlan     = "change-plan"
+	sagaCreateTeam     = "create-team"
+)
+
+// registerSagas makes the purchase sagas known to the coordinator so that
+// sagas abandoned by a previous process can be rolled back.
+func (server *Server) registerSagas() {
+	server.sagas.Register(server.createEntrySaga(&entrySagaResult{}))
+	server.sagas.Register(server.checkoutSaga(&orderSagaResult{}))
+	server.sagas.Register(server.redeemGiftCardSaga(&giftCardSagaResult{}))
+	server.sagas.Register(server.changePlanSaga())
+	server.sagas.Register(server.createTeamSaga(&teamSagaResult{}))
+}
+
+type entrySagaResult struct {
+	entry *pb.Entry
+	// giftCard is the gift card bought by the entry, if any.
+	giftCard *pb.GiftCard
+}
+
+// createEntrySaga counts the entry against the quota and creates it. It then
+// issues the gift card the entry buys and redeems the coupon, loyalty points
+// and gift card it is paid with, each only if there is one. What is left of
+// the total is taken from the user's wallet or authorized by the payment
+// provider.
+func (server *Server) createEntrySaga(res *entrySagaResult) saga.Definition {
+	return saga.Definition{
+		Name: sagaCreateEntry,
+		Steps: []saga.Step{
+			server.meterUsageStep(),
+			{
+				Name: "create entry",
+				Do: func(ctx context.Context, data saga.Data) error {
+					result, err := server.grpc.CreateEntry(withTenant(ctx, data.Int32("tenant_id")), &pb.CreateEntryRequest{
+						UserId:   data.Int32("user_id"),
+						ItemId:   data.Int32("item_id"),
+						Quantity: data.Int32("quantity"),
+						Total:    purchaseMoney(data, "total"),
+						Status:   pb.EntryStatus(data.Int32("status")),
+						Discount: purchaseMoney(data, "discount"),
+						CouponId: data.Int32("coupon_id"),
+
+						Tax:          purchaseMoney(data, "tax"),
+						TaxRegion:    data["tax_region"],
+						TaxRate:      data.Int32("tax_rate"),
+						TaxInclusive: data["tax_inclusive"] == "true",
+
+						PaidFromWallet: data["paid_from_wallet"] == "true",
+						GiftCardId:     data.Int32("gift_card_id"),
+						GiftCardAmount: purchaseMoney(data, "gift_card_amount"),
+						PointsRedeemed: data.Int64("points"),
+						PointsDiscount: purchaseMoney(data, "points_discount"),
+						IdempotencyKey: purchaseKey(data, "entry"),
+					})
+					entry := result.GetEntry()
+					if status.Code(err) == codes.AlreadyExists {
+						var existing *pb.GetEntryResponse
+						existing, err = server.grpc.GetEntry(withTenant(ctx, data.Int32("tenant_id")), &pb.GetEntryRequest{
+							IdempotencyKey: purchaseKey(data, "entry"),
+						})
+						entry = existing.GetEntry()
+					}
+					if err != nil {
+						return err
+					}
+					res.entry = entry
+					data.SetInt32("entry_id", entry.GetID())
+					return nil
+				},
+				Compensate: func(ctx context.Context, data saga.Data) error {
+					_, err := server.grpc.DeleteEntry(withTenant(ctx, data.Int32("tenant_id")), &pb.DeleteEntryRequest{Id: data.Int32("entry_id")})
+					if status.Code(err) == codes.NotFound {
+						return nil
+					}
+					return err
+				},
+			},
+			server.issueGiftCardStep(res),
+			{
+				Name: "redeem coupon",
+				Do: func(ctx context.Context, data saga.Data) error {
+					couponID := data.Int32("coupon_id")
+					if couponID == 0 {
+						return nil
+					}
+					key := "coupon:entry:" + data["entry_id"]
+					result, err := server.grpc.RedeemCoupon(ctx, &pb.RedeemCouponRequest{
+						CouponId:       couponID,
+						UserId:         data.Int32("user_id"),
+						EntryId:        data.Int32("entry_id"),
+						Discount:       purchaseMoney(data, "discount"),
+						IdempotencyKey: key,
+					})
+					redemption := result.GetRedemption()
+					if status.Code(err) == codes.AlreadyExists {
+						var existing *pb.ListCouponRedemptionsResponse
+						existing, err = server.grpc.ListCouponRedemptions(ctx, &pb.ListCouponRedemptionsRequest{
+							CouponId:       couponID,
+							IdempotencyKey: key,
+							Limit:          1,
+						})
+						redemption, err = firstByKey(existing.GetRedemptions(), err)
+					}
+					if err != nil {
+						if status.Code(err) == codes.ResourceExhausted {
+							return errCouponExhausted
+						}
+						return err
+					}
+					data.SetInt32("redemption_id", redemption.GetID())
+					return nil
+				},
+				Compensate: func(ctx context.Context, data saga.Data) error {
+					redemptionID := data.Int32("redemption_id")
+					if redemptionID == 0 {
+						return nil
+					}
+					_, err := server.grpc.DeleteCouponRedemption(ctx, &pb.DeleteCouponRedemptionRequest{Id: redemptionID})
+					if status.Code(err) == codes.NotFound {
+						return nil
+					}
+					return err
+				},
+			},
+			server.redeemPointsStep(),
+			server.redeemGiftCardStep(),
+			server.chargeWalletStep(),
+			server.authorizePaymentStep("entry"),
+			{
+				Name: "attach payment",
+				Do: func(ctx context.Context, data saga.Data) error {
+					intentID, ok := data["intent_id"]
+					if !ok {
+						return nil
+					}
+					result, err := server.grpc.UpdateEntryStatus(withTenant(ctx, data.Int32("tenant_id")), &pb.UpdateEntryStatusRequest{
+						Id:              data.Int32("entry_id"),
+						Status:          pb.EntryStatus_ENTRY_STATUS_PENDING,
+						ExpectedStatus:  pb.EntryStatus_ENTRY_STATUS_PENDING,
+						PaymentIntentId: &intentID,
+					})
+					if err != nil {
+						return err
+					}
+					res.entry = result.GetEntry()
+					return nil
+				},
+			},
+		},
+	}
+}
+
+type orderSagaResult struct {
+	order *pb.Order
+}
+
+// checkoutSaga counts the order lines against the quota, creates the order,
+// which takes its items out of stock, and asks the payment provider to
+// authorize its total.
+func (server *Server) checkoutSaga(res *orderSagaResult) saga.Definition {
+	return saga.Definition{
+		Name: sagaCheckout,
+		Steps: []saga.Step{
+			server.meterUsageStep(),
+			{
+				Name: "create order",
+				Do: func(ctx context.Context, data saga.Data) error {
+					var lines []*pb.OrderLine
+					if err := json.Unmarshal([]byte(data["lines"]), &lines); err != nil {
+						return err
+					}
+					result, err := server.grpc.CreateOrder(withTenant(ctx, data.Int32("tenant_id")), &pb.CreateOrderRequest{
+						UserId:         data.Int32("user_id"),
+						Lines:          lines,
+						Total:          purchaseMoney(data, "total"),
+						Status:         pb.EntryStatus(data.Int32("status")),
+						IdempotencyKey: purchaseKey(data, "order"),
+					})
+					if status.Code(err) == codes.AlreadyExists {
+						result, err = server.grpc.GetOrder(withTenant(ctx, data.Int32("tenant_id")), &pb.GetOrderRequest{
+							IdempotencyKey: purchaseKey(data, "order"),
+						})
+					}
+					if err != nil {
+						return err
+					}
+					res.order = result.GetOrder()
+					data.SetInt32("order_id", res.order.GetID())
+					return nil
+				},
+				Compensate: func(ctx context.Context, data saga.Data) error {
+					// deleting the order puts its items back in stock
+					_, err := server.grpc.DeleteOrder(withTenant(ctx, data.Int32("tenant_id")), &pb.DeleteOrderRequest{Id: data.Int32("order_id")})
+					if status.Code(err) == codes.NotFound {
+						return nil
+					}
+					return err
+				},
+			},
+			server.authorizePaymentStep("order"),
+			{
+				Name: "attach payment",
+				Do: func(ctx context.Context, data saga.Data) error {
+					intentID, ok := data["intent_id"]
+					if !ok {
+						return nil
+					}
+					result, err := server.grpc.UpdateOrderStatus(withTenant(ctx, data.Int32("tenant_id")), &pb.UpdateOrderStatusRequest{
+						Id:              data.Int32("order_id"),
+						Status:          pb.EntryStatus_ENTRY_STATUS_PENDING,
+						ExpectedStatus:  pb.EntryStatus_ENTRY_STATUS_PENDING,
+						PaymentIntentId: &intentID,
+					})
+					if err != nil {
+						return err
+					}
+					res.order = result.GetOrder()
+					return nil
+				},
+			},
+		},
+	}
+}
+
+type giftCardSagaResult struct {
+	wallet *pb.Wallet
+}
+
+// redeemGiftCardSaga moves part of the balance of a gift card into the
+// user's wallet.
+func (server *Server) redeemGiftCardSaga(res *giftCardSagaResult) saga.Definition {
+	return saga.Definition{
+		Name: sagaRedeemGiftCard,
+		Steps: []saga.Step{
+			server.redeemGiftCardStep(),
+			{
+				Name: "credit wallet",
+				Do: func(ctx context.Context, data saga.Data) error {
+					result, err := server.grpc.CreateWalletTransaction(ctx, &pb.CreateWalletTransactionRequest{
+						UserId:         data.Int32("user_id"),
+						Type:           pb.WalletTransactionType_WALLET_TRANSACTION_TYPE_GIFT_CARD,
+						Amount:         purchaseMoney(data, "gift_card_amount"),
+						Reason:         "gift card redeemed",
+						IdempotencyKey: "gift-card:redemption:" + data["gift_card_redemption_id"],
+					})
+					wallet := result.GetWallet()
+					if status.Code(err) == codes.AlreadyExists {
+						var existing *pb.WalletResponse
+						existing, err = server.grpc.GetWallet(ctx, &pb.GetWalletRequest{UserId: data.Int32("user_id")})
+						wallet = existing.GetWallet()
+					}
+					if err != nil {
+						return err
+					}
+					res.wallet = wallet
+					return nil
+				},
+			},
+		},
+	}
+}
+
+// changePlanSaga moves a user to another plan and then charges them the
+// prorated price. The user is put back on their previous plan if the charge
+// fails, so nobody is charged for a plan they did not get.
+func (server *Server) changePlanSaga() saga.Definition {
+	return saga.Definition{
+		Name: sagaChangePlan,
+		Steps: []saga.Step{
+			{
+				Name: "change plan",
+				Do: func(ctx context.Context, data saga.Data) error {
+					_, err := server.grpc.ChangePlan(ctx, &pb.ChangePlanRequest{
+						UserId:    data.Int32("user_id"),
+						PlanId:    data.Int32("to_plan_id"),
+						ExpiredAt: sagaTime(data, "expired_at"),
+					})
+					return err
+				},
+				Compensate: func(ctx context.Context, data saga.Data) error {
+					_, err := server.grpc.ChangePlan(ctx, &pb.ChangePlanRequest{
+						UserId:    data.Int32("user_id"),
+						PlanId:    data.Int32("from_plan_id"),
+						ExpiredAt: sagaTime(data, "previous_expired_at"),
+					})
+					return err
+				},
+			},
+			{
+				Name: "charge",
+				Do: func(ctx context.Context, data saga.Data) error {
+					if data.Int64("charge") <= 0 {
+						return nil
+					}
+
+					charge, err := server.payments.Charge(ctx, payments.ChargeRequest{
+						UserID:         data.Int32("user_id"),
+						Amount:         data.Int64("charge"),
+						Currency:       server.storeCurrency(),
+						Description:    data["description"],
+						IdempotencyKey: data["idempotency_key"],
+					})
+					if err != nil {
+						return err
+					}
+					data["charge_id"] = charge.ID
+					return nil
+				},
+			},
+		},
+	}
+}
+
+type teamSagaResult struct {
+	team *pb.Team
+}
+
+// createTeamSaga charges the owner for the seats of a new team and then
+// creates it. The charge is refunded if the team cannot be created.
+func (server *Server) createTeamSaga(res *teamSagaResult) saga.Definition {
+	return saga.Definition{
+		Name: sagaCreateTeam,
+		Steps: []saga.Step{
+			{
+				Name: "charge",
+				Do: func(ctx context.Context, data saga.Data) error {
+					if data.Int64("charge") <= 0 {
+						return nil
+					}
+
+					charge, err := server.payments.Charge(ctx, payments.ChargeRequest{
+						UserID:         data.Int32("user_id"),
+						Amount:         data.Int64("charge"),
+						Currency:       server.storeCurrency(),
+						Description:    data["description"],
+						IdempotencyKey: data["idempotency_key"],
+					})
+					if err != nil {
+						return err
+					}
+					data["charge_id"] = charge.ID
+					return nil
+				},
+				Compensate: func(ctx context.Context, data saga.Data) error {
+					chargeID, ok := data["charge_id"]
+					if !ok {
+						return nil
+					}
+					_, err := server.payments.Refund(ctx, chargeID, 0)
+					if errors.Is(err, payments.ErrInvalidState) {
+						// refunded already
+						return nil
+					}
+					return err
+				},
+			},
+			{
+				Name: "create team",
+				Do: func(ctx context.Context, data saga.Data) error {
+					result, err := server.grpc.CreateTeam(ctx, &pb.CreateTeamRequest{
+						Name:      data["name"],
+						OwnerId:   data.Int32("user_id"),
+						Plan:      data.Int32("plan_id"),
+						Seats:     data.Int32("seats"),
+						ExpiredAt: sagaTime(data, "expired_at"),
+					})
+					if err != nil {
+						return err
+					}
+					res.team = result.GetTeam()
+					return nil
+				},
+			},
+		},
+	}
+}
+
+// setSagaTime stores t under key, or nothing if t is nil.
+func setSagaTime(data saga.Data, key string, t *time.Time) {
+	if t != nil {
+		data.SetInt64(key, t.Unix())
+	}
+}
+
+// sagaTime returns the time stored under key, or nil if there is none.
+func sagaTime(data saga.Data, key string) *timestamppb.Timestamp {
+	if _, ok := data[key]; !ok {
+		return nil
+	}
+	return timestamppb.New(time.Unix(data.Int64(key), 0))
+}
+
+// meterUsageStep counts data["count"] entries against the user's monthly
+// quota and gives them back when compensated.
+func (server *Server) meterUsageStep() saga.Step {
+	return saga.Step{
+		Name: "meter usage",
+		Do: func(ctx context.Context, data saga.Data) error {
+			result, err := server.grpc.IncrementUsage(ctx, &pb.IncrementUsageRequest{
+				UserId: data.Int32("user_id"),
+				Metric: usageMetricEntries,
+				Period: data["period"],
+				Amount: data.Int32("count"),
+				Limit:  data.Int32("quota"),
+
+				IdempotencyKey: purchaseKey(data, "usage"),
+			})
+			usage := result.GetUsage()
+			if status.Code(err) == codes.AlreadyExists {
+				var existing *pb.GetUsageResponse
+				existing, err = server.grpc.GetUsage(ctx, &pb.GetUsageRequest{
+					UserId: data.Int32("user_id"),
+					Metric: usageMetricEntries,
+					Period: data["period"],
+				})
+				usage = existing.GetUsage()
+			}
+			if err != nil {
+				return err
+			}
+			data.SetInt32("used", usage.GetCount())
+			return nil
+		},
+		Compensate: func(ctx context.Context, data saga.Data) error {
+			_, err := server.grpc.IncrementUsage(ctx, &pb.IncrementUsageRequest{
+				UserId: data.Int32("user_id"),
+				Metric: usageMetricEntries,
+				Period: data["period"],
+				Amount: -data.Int32("count"),
+
+				IdempotencyKey: purchaseKey(data, "usage-rollback"),
+			})
+			if status.Code(err) == codes.AlreadyExists {
+				return nil
+			}
+			return err
+		},
+	}
+}
+
+// chargeWalletStep takes what is due on a purchase paid from the wallet out
+// of it. Compensating puts the money back.
+func (server *Server) chargeWalletStep() saga.Step {
+	return saga.Step{
+		Name: "charge wallet",
+		Do: func(ctx context.Context, data saga.Data) error {
+			due := amountDue(data)
+			if data["paid_from_wallet"] != "true" || due == 0 {
+				return nil
+			}
+			key := "purchase:entry:" + data["entry_id"]
+			result, err := server.grpc.CreateWalletTransaction(ctx, &pb.CreateWalletTransactionRequest{
+				UserId:         data.Int32("user_id"),
+				Type:           pb.WalletTransactionType_WALLET_TRANSACTION_TYPE_PURCHASE,
+				Amount:         money.New(-due, data["currency"]).Proto(),
+				EntryId:        data.Int32("entry_id"),
+				IdempotencyKey: key,
+			})
+			transaction := result.GetTransaction()
+			if status.Code(err) == codes.AlreadyExists {
+				var existing *pb.ListWalletTransactionsResponse
+				existing, err = server.grpc.ListWalletTransactions(ctx, &pb.ListWalletTransactionsRequest{
+					UserId:         data.Int32("user_id"),
+					IdempotencyKey: key,
+					Limit:          1,
+				})
+				transaction, err = firstByKey(existing.GetTransactions(), err)
+			}
+			if err != nil {
+				if status.Code(err) == codes.FailedPrecondition {
+					return errInsufficientBalance
+				}
+				return err
+			}
+			data.SetInt32("wallet_transaction_id", transaction.GetID())
+			return nil
+		},
+		Compensate: func(ctx context.Context, data saga.Data) error {
+			if data.Int32("wallet_transaction_id") == 0 {
+				return nil
+			}
+			_, err := server.grpc.CreateWalletTransaction(ctx, &pb.CreateWalletTransactionRequest{
+				UserId:         data.Int32("user_id"),
+				Type:           pb.WalletTransactionType_WALLET_TRANSACTION_TYPE_REFUND,
+				Amount:         money.New(amountDue(data), data["currency"]).Proto(),
+				EntryId:        data.Int32("entry_id"),
+				Reason:         "purchase rolled back",
+				IdempotencyKey: "rollback:entry:" + data["entry_id"],
+			})
+			if status.Code(err) == codes.AlreadyExists {
+				return nil
+			}
+			return err
+		},
+	}
+}
+
+// issueGiftCardStep issues a gift card worth data["gift_card_value"] to the
+// buyer of a gift card item. Compensating deletes the card.
+func (server *Server) issueGiftCardStep(res *entrySagaResult) saga.Step {
+	return saga.Step{
+		Name: "issue gift card",
+		Do: func(ctx context.Context, data saga.Data) error {
+			value := data.Int64("gift_card_value")
+			if value == 0 {
+				return nil
+			}
+			req := &pb.CreateGiftCardRequest{
+				Value:          money.New(value, data["currency"]).Proto(),
+				PurchaserId:    data.Int32("user_id"),
+				EntryId:        data.Int32("entry_id"),
+				IdempotencyKey: "gift-card:entry:" + data["entry_id"],
+			}
+			if server.config.GiftCardValidity > 0 {
+				req.ExpiresAt = timestamppb.New(time.Now().Add(server.config.GiftCardValidity))
+			}
+			// a code that is taken is unlikely to be drawn again, so a few
+			// attempts are plenty
+			for attempt := 1; ; attempt++ {
+				code, err := newGiftCardCode()
+				if err != nil {
+					return err
+				}
+				req.Code = code
+				result, err := server.grpc.CreateGiftCard(ctx, req)
+				if status.Code(err) == codes.AlreadyExists {
+					// either the code is taken or the card was issued before
+					// the saga was interrupted
+					existing, getErr := server.grpc.GetGiftCardByEntry(ctx, &pb.GetGiftCardByEntryRequest{EntryId: req.EntryId})
+					if getErr == nil {
+						result, err = existing, nil
+					} else if status.Code(getErr) != codes.NotFound {
+						return getErr
+					} else if attempt < 3 {
+						continue
+					}
+				}
+				if err != nil {
+					return err
+				}
+				res.giftCard = result.GetGiftCard()
+				data.SetInt32("issued_gift_card_id", res.giftCard.GetID())
+				return nil
+			}
+		},
+		Compensate: func(ctx context.Context, data saga.Data) error {
+			giftCardID := data.Int32("issued_gift_card_id")
+			if giftCardID == 0 {
+				return nil
+			}
+			_, err := server.grpc.DeleteGiftCard(ctx, &pb.DeleteGiftCardRequest{Id: giftCardID})
+			if status.Code(err) == codes.NotFound {
+				return nil
+			}
+			return err
+		},
+	}
+}
+
+// redeemPointsStep spends the loyalty points redeemed on an entry.
+// Compensating gives them back.
+func (server *Server) redeemPointsStep() saga.Step {
+	return saga.Step{
+		Name: "redeem points",
+		Do: func(ctx context.Context, data saga.Data) error {
+			points := data.Int64("points")
+			if points == 0 {
+				return nil
+			}
+			key := "redeem:entry:" + data["entry_id"]
+			result, err := server.grpc.CreatePointsTransaction(ctx, &pb.CreatePointsTransactionRequest{
+				UserId:         data.Int32("user_id"),
+				Type:           pb.PointsTransactionType_POINTS_TRANSACTION_TYPE_REDEEM,
+				Points:         -points,
+				EntryId:        data.Int32("entry_id"),
+				IdempotencyKey: key,
+			})
+			transaction := result.GetTransaction()
+			if status.Code(err) == codes.AlreadyExists {
+				var existing *pb.ListPointsTransactionsResponse
+				existing, err = server.grpc.ListPointsTransactions(ctx, &pb.ListPointsTransactionsRequest{
+					UserId:         data.Int32("user_id"),
+					IdempotencyKey: key,
+					Limit:          1,
+				})
+				transaction, err = firstByKey(existing.GetTransactions(), err)
+			}
+			if err != nil {
+				if status.Code(err) == codes.FailedPrecondition {
+					return errInsufficientPoints
+				}
+				return err
+			}
+			data.SetInt32("points_transaction_id", transaction.GetID())
+			return nil
+		},
+		Compensate: func(ctx context.Context, data saga.Data) error {
+			transactionID := data.Int32("points_transaction_id")
+			if transactionID == 0 {
+				return nil
+			}
+			return server.reversePointsTransaction(ctx, &pb.PointsTransaction{
+				ID:      transactionID,
+				UserId:  data.Int32("user_id"),
+				Points:  -data.Int64("points"),
+				EntryId: data.Int32("entry_id"),
+			}, "purchase rolled back")
+		},
+	}
+}
+
+// redeemGiftCardStep takes data["gift_card_amount"] off the gift card
+// data["gift_card_id"], for the entry created before if there is one, and
+// records the balance left in data["gift_card_balance"]. Compensating puts
+// the amount back on the card.
+func (server *Server) redeemGiftCardStep() saga.Step {
+	return saga.Step{
+		Name: "redeem gift card",
+		Do: func(ctx context.Context, data saga.Data) error {
+			giftCardID := data.Int32("gift_card_id")
+			if giftCardID == 0 {
+				return nil
+			}
+			key, ok := data["gift_card_key"]
+			if !ok {
+				key = "entry:" + data["entry_id"]
+			}
+			result, err := server.grpc.RedeemGiftCard(ctx, &pb.RedeemGiftCardRequest{
+				GiftCardId:     giftCardID,
+				UserId:         data.Int32("user_id"),
+				Amount:         purchaseMoney(data, "gift_card_amount"),
+				EntryId:        data.Int32("entry_id"),
+				ToWallet:       data["to_wallet"] == "true",
+				IdempotencyKey: key,
+			})
+			if status.Code(err) == codes.AlreadyExists {
+				return server.loadGiftCardRedemption(ctx, data, key)
+			}
+			if err != nil {
+				if status.Code(err) == codes.FailedPrecondition {
+					return errGiftCardSpent
+				}
+				return err
+			}
+			data.SetInt32("gift_card_redemption_id", result.GetRedemption().GetID())
+			data.SetInt64("gift_card_balance", result.GetGiftCard().GetBalance().GetAmount())
+			return nil
+		},
+		Compensate: func(ctx context.Context, data saga.Data) error {
+			redemptionID := data.Int32("gift_card_redemption_id")
+			if redemptionID == 0 {
+				return nil
+			}
+			_, err := server.grpc.ReverseGiftCardRedemption(ctx, &pb.ReverseGiftCardRedemptionRequest{Id: redemptionID})
+			if status.Code(err) == codes.NotFound {
+				return nil
+			}
+			return err
+		},
+	}
+}
+
+// loadGiftCardRedemption records the redemption made with key before the saga
+// was interrupted, as redeemGiftCardStep does for a new one.
+func (server *Server) loadGiftCardRedemption(ctx context.Context, data saga.Data, key string) error {
+	result, err := server.grpc.ListGiftCardRedemptions(ctx, &pb.ListGiftCardRedemptionsRequest{
+		GiftCardId:     data.Int32("gift_card_id"),
+		IdempotencyKey: key,
+		Limit:          1,
+	})
+	redemption, err := firstByKey(result.GetRedemptions(), err)
+	if err != nil {
+		return err
+	}
+	data.SetInt32("gift_card_redemption_id", redemption.GetID())
+
+	code, ok := data["gift_card_code"]
+	if !ok {
+		return nil
+	}
+	card, err := server.grpc.GetGiftCardByCode(ctx, &pb.GetGiftCardByCodeRequest{Code: code})
+	if err != nil {
+		return err
+	}
+	data.SetInt64("gift_card_balance", card.GetGiftCard().GetBalance().GetAmount())
+	return nil
+}
+
+// authorizePaymentStep asks the payment provider to authorize what is due on
+// the entry or order created by the previous step. Purchases created as
+// paid need no payment. Compensating cancels the authorization, or refunds
+// the payment if it was captured in the meantime.
+func (server *Server) authorizePaymentStep(kind string) saga.Step {
+	return saga.Step{
+		Name: "authorize payment",
+		Do: func(ctx context.Context, data saga.Data) error {
+			if pb.EntryStatus(data.Int32("status")) == pb.EntryStatus_ENTRY_STATUS_PAID {
+				return nil
+			}
+
+			id := data[kind+"_id"]
+			intent, err := server.payments.CreateIntent(ctx, payments.CreateIntentRequest{
+				Amount:         amountDue(data),
+				Currency:       data["currency"],
+				Description:    fmt.Sprintf("%s %s", kind, id),
+				IdempotencyKey: fmt.Sprintf("%s:%s", kind, id),
+				Metadata: map[string]string{
+					kind + "_id": id,
+					"user_id":    data["user_id"],
+				},
+			})
+			if err != nil {
+				return err
+			}
+			data["intent_id"] = intent.ID
+			data["client_secret"] = intent.ClientSecret
+			return nil
+		},
+		Compensate: func(ctx context.Context, data saga.Data) error {
+			intentID, ok := data["intent_id"]
+			if !ok {
+				return nil
+			}
+
+			_, err := server.payments.Cancel(ctx, intentID)
+			if errors.Is(err, payments.ErrInvalidState) {
+				_, err = server.payments.Refund(ctx, intentID, 0)
+				if errors.Is(err, payments.ErrInvalidState) {
+					// already refunded
+					return nil
+				}
+			}
+			if errors.Is(err, payments.ErrNotFound) {
+				return nil
+			}
+			return err
+		},
+	}
+}
+
+// newPurchaseData returns the saga data shared by the purchase sagas.
+func newPurchaseData(tenantID int32, userID int32, count int32, quota int32, period string, total money.Money) saga.Data {
+	data := saga.Data{
+		"period":          period,
+		"currency":        total.Currency,
+		"idempotency_key": util.GetRandomString(32),
+	}
+	data.SetInt32("tenant_id", tenantID)
+	data.SetInt32("user_id", userID)
+	data.SetInt32("count", count)
+	data.SetInt32("quota", quota)
+	data.SetInt64("total", total.Amount)
+
+	purchaseStatus := pb.EntryStatus_ENTRY_STATUS_PENDING
+	if total.Amount == 0 {
+		// nothing to collect
+		purchaseStatus = pb.EntryStatus_ENTRY_STATUS_PAID
+	}
+	data.SetInt32("status", int32(purchaseStatus))
+	return data
+}
+
+// purchaseKey returns the idempotency key of the record a step of a purchase
+// saga creates, or none for sagas started without a key.
+func purchaseKey(data saga.Data, record string) string {
+	key, ok := data["idempotency_key"]
+	if !ok {
+		return ""
+	}
+	return record + ":" + key
+}
+
+// firstByKey returns the record a list filtered by idempotency key found,
+// for steps whose record was created before the saga was interrupted.
+func firstByKey[T any](records []T, err error) (T, error) {
+	var record T
+	if err != nil {
+		return record, err
+	}
+	if len(records) == 0 {
+		return record, status.Error(codes.NotFound, "no record with the idempotency key")
+	}
+	return records[0], nil
+}
+
+// payFromWallet makes a purchase paid out of the user's wallet, which needs
+// no payment from the provider.
+func payFromWallet(data saga.Data) {
+	data["paid_from_wallet"] = "true"
+	data.SetInt32("status", int32(pb.EntryStatus_ENTRY_STATUS_PAID))
+}
+
+// payWithGiftCard pays amount of a purchase with a gift card. Purchases the
+// gift card pays in full need no other payment.
+func payWithGiftCard(data saga.Data, giftCardID int32, amount int64) {
+	data.SetInt32("gift_card_id", giftCardID)
+	data.SetInt64("gift_card_amount", amount)
+	if amountDue(data) == 0 {
+		data.SetInt32("status", int32(pb.EntryStatus_ENTRY_STATUS_PAID))
+	}
+}
+
+// amountDue returns the part of the total of a purchase that is not paid with
+// a gift card.
+func amountDue(data saga.Data) int64 {
+	return data.Int64("total") - data.Int64("gift_card_amount")
+}
+
+// purchaseMoney returns the amount stored under key in the currency of the
+// purchase.
+func purchaseMoney(data saga.Data, key string) *pb.Money {
+	return money.New(data.Int64(key), data["currency"]).Proto()
+}
+
+// setTaxData records the tax on a purchase.
+func setTaxData(data saga.Data, result tax.Result) {
+	data.SetInt64("tax", result.Tax)
+	data["tax_region"] = result.Region
+	data.SetInt32("tax_rate", result.Rate)
+	data["tax_inclusive"] = strconv.FormatBool(result.Inclusive)
+}
+
+// writePurchaseError responds to a purchase saga that failed with err.
+func writePurchaseError(ctx *gin.Context, err error, quota int32, resetAt time.Time) {
+	if errors.Is(err, payments.ErrDeclined) {
+		ctx.JSON(http.StatusPaymentRequired, errorResponse(err))
+		return
+	}
+	if errors.Is(err, errInsufficientBalance) {
+		ctx.JSON(http.StatusPaymentRequired, errorResponse(err))
+		return
+	}
+	if errors.Is(err, errCouponExhausted) || errors.Is(err, errGiftCardSpent) || errors.Is(err, errInsufficientPoints) {
+		ctx.JSON(http.StatusConflict, errorResponse(err))
+		return
+	}
+
+	if apiErr, ok := status.FromError(err); ok {
+		switch apiErr.Code() {
+		case codes.ResourceExhausted:
+			writeQuotaExceeded(ctx, quota, resetAt)
+		case codes.InvalidArgument:
+			ctx.JSON(http.StatusBadRequest, errorResponse(apiErr.Err()))
+		case codes.FailedPrecondition:
+			ctx.JSON(http.StatusConflict, errorResponse(apiErr.Err()))
+		default:
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+		}
+		return
+	}
+	ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+}
+
+// setPurchaseQuotaHeaders reports the quota left after a purchase saga.
+func setPurchaseQuotaHeaders(ctx *gin.Context, data saga.Data, resetAt time.Time) {
+	quota := data.Int32("quota")
+	if quota <= 0 {
+		return
+	}
+	setQuotaHeaders(ctx, quota, data.Int32("used"), resetAt)
+}
@@ -0,0 +1,115 @@
+package api
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/machearn/galaxy_controller/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var securityEventNames = map[pb.SecurityEventType]string{
+	pb.SecurityEventType_SECURITY_EVENT_TYPE_LOGIN_SUCCESS:   "login_success",
+	pb.SecurityEventType_SECURITY_EVENT_TYPE_LOGIN_FAILURE:   "login_failure",
+	pb.SecurityEventType_SECURITY_EVENT_TYPE_TOKEN_RENEW:     "token_renew",
+	pb.SecurityEventType_SECURITY_EVENT_TYPE_PASSWORD_CHANGE: "password_change",
+	pb.SecurityEventType_SECURITY_EVENT_TYPE_SESSION_REVOKE:  "session_revoke",
+}
+
+// recordSecurityEvent stores an audit entry for the user with the caller's IP
+// and user agent. Failures are logged rather than returned so that auditing
+// never breaks the request being audited.
+func (server *Server) recordSecurityEvent(ctx *gin.Context, userID int32, eventType pb.SecurityEventType, detail string) {
+	grpcReq := pb.CreateSecurityEventRequest{
+		UserId:    userID,
+		Type:      eventType,
+		ClientIp:  ctx.ClientIP(),
+		UserAgent: ctx.Request.UserAgent(),
+		Detail:    detail,
+	}
+
+	if _, err := server.grpc.CreateSecurityEvent(ctx, &grpcReq); err != nil {
+		log.Printf("failed to record %s event for user %d: %v", securityEventNames[eventType], userID, err)
+	}
+}
+
+type SecurityEvent struct {
+	ID        int32     `json:"id"`
+	UserID    int32     `json:"user_id"`
+	Type      string    `json:"type"`
+	ClientIP  string    `json:"client_ip"`
+	UserAgent string    `json:"user_agent"`
+	Detail    string    `json:"detail"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ListSecurityEventsRequest struct {
+	UserID int32 `json:"user_id"`
+	Offset int32 `json:"offset"`
+	Limit  int32 `json:"limit"`
+}
+
+type ListSecurityEventsResponse struct {
+	Events []SecurityEvent `json:"events"`
+}
+
+// ListSecurityEvents returns the authenticated user's security events. Staff
+// and admins may pass another user's id to investigate on their behalf.
+func (server *Server) ListSecurityEvents(ctx *gin.Context) {
+	var req ListSecurityEventsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+	if req.UserID == 0 {
+		req.UserID = authPayload.UserID
+	}
+	if req.UserID != authPayload.UserID && authPayload.Role == pb.Role_ROLE_MEMBER {
+		ctx.JSON(http.StatusForbidden, errorResponse(errors.New("you are not allowed to access this resource")))
+		return
+	}
+
+	grpcReq := pb.ListSecurityEventsRequest{
+		UserId: req.UserID,
+		Offset: req.Offset,
+		Limit:  req.Limit,
+	}
+
+	result, err := server.grpc.ListSecurityEvents(ctx, &grpcReq)
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.NotFound {
+				ctx.JSON(http.StatusNotFound, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	rows := result.GetEvents()
+	events := make([]SecurityEvent, len(rows))
+	for i, row := range rows {
+		events[i] = SecurityEvent{
+			ID:        row.ID,
+			UserID:    row.UserId,
+			Type:      securityEventNames[row.Type],
+			ClientIP:  row.ClientIp,
+			UserAgent: row.UserAgent,
+			Detail:    row.Detail,
+			CreatedAt: row.CreatedAt.AsTime(),
+		}
+	}
+
+	ctx.JSON(http.StatusOK, ListSecurityEventsResponse{
+		Events: events,
+	})
+}
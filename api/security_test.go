@@ -0,0 +1,134 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/machearn/galaxy_controller/pb"
+	mockpb "github.com/machearn/galaxy_controller/pb/mock"
+	"github.com/machearn/galaxy_controller/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestListSecurityEventsAPI(t *testing.T) {
+	url := "/user/security-events"
+	created := time.Now().UTC().Truncate(time.Second)
+
+	data, err := json.Marshal(gin.H{
+		"offset": 0,
+		"limit":  5,
+	})
+	require.NoError(t, err)
+
+	grpcAuthReq := pb.AuthRequest{
+		Token: util.GetRandomString(32),
+	}
+	grpcAuthRes := pb.AuthResponse{
+		ID:        uuid.New().String(),
+		UserId:    1,
+		CreatedAt: timestamppb.New(created),
+		ExpiredAt: timestamppb.New(created),
+	}
+
+	grpcReq := pb.ListSecurityEventsRequest{
+		UserId: 1,
+		Offset: 0,
+		Limit:  5,
+	}
+	grpcRes := pb.ListSecurityEventsResponse{
+		Events: []*pb.SecurityEvent{
+			{
+				ID:        2,
+				UserId:    1,
+				Type:      pb.SecurityEventType_SECURITY_EVENT_TYPE_LOGIN_SUCCESS,
+				ClientIp:  "10.0.0.1",
+				UserAgent: "curl/8.0",
+				CreatedAt: timestamppb.New(created),
+			},
+			{
+				ID:        1,
+				UserId:    1,
+				Type:      pb.SecurityEventType_SECURITY_EVENT_TYPE_LOGIN_FAILURE,
+				ClientIp:  "10.0.0.2",
+				UserAgent: "curl/8.0",
+				Detail:    "incorrect password",
+				CreatedAt: timestamppb.New(created),
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().Authorize(gomock.Any(), gomock.Eq(&grpcAuthReq)).Return(&grpcAuthRes, nil)
+	grpc.EXPECT().ListSecurityEvents(gomock.Any(), gomock.Eq(&grpcReq)).Return(&grpcRes, nil)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, grpcAuthReq.Token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var res ListSecurityEventsResponse
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+	require.Len(t, res.Events, 2)
+	require.Equal(t, "login_success", res.Events[0].Type)
+	require.Equal(t, "login_failure", res.Events[1].Type)
+	require.Equal(t, "incorrect password", res.Events[1].Detail)
+	require.Equal(t, "10.0.0.2", res.Events[1].ClientIP)
+}
+
+func TestListSecurityEventsAPIOtherUser(t *testing.T) {
+	url := "/user/security-events"
+	created := time.Now().UTC().Truncate(time.Second)
+
+	data, err := json.Marshal(gin.H{
+		"user_id": 2,
+		"offset":  0,
+		"limit":   5,
+	})
+	require.NoError(t, err)
+
+	grpcAuthReq := pb.AuthRequest{
+		Token: util.GetRandomString(32),
+	}
+	grpcAuthRes := pb.AuthResponse{
+		ID:        uuid.New().String(),
+		UserId:    1,
+		CreatedAt: timestamppb.New(created),
+		ExpiredAt: timestamppb.New(created),
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().Authorize(gomock.Any(), gomock.Eq(&grpcAuthReq)).Return(&grpcAuthRes, nil)
+	grpc.EXPECT().ListSecurityEvents(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, grpcAuthReq.Token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusForbidden, recorder.Code)
+}
@@ -1,23 +1,85 @@
 package api
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
 	"github.com/gin-gonic/gin"
+	"github.com/machearn/galaxy_controller/money"
+	"github.com/machearn/galaxy_controller/notify"
+	"github.com/machearn/galaxy_controller/payments"
 	"github.com/machearn/galaxy_controller/pb"
+	"github.com/machearn/galaxy_controller/saga"
+	"github.com/machearn/galaxy_controller/tax"
 	"github.com/machearn/galaxy_controller/util"
 )
 
+// developmentWebhookSecret is the webhook secret of app.env.
+const developmentWebhookSecret = "whsec_local"
+
 type Server struct {
-	config util.Config
-	router *gin.Engine
-	grpc   pb.GalaxyClient
+	config   util.Config
+	router   *gin.Engine
+	grpc     pb.GalaxyClient
+	notifier notify.Notifier
+	payments payments.Provider
+	sagas    *saga.Coordinator
+	tax      *tax.Engine
+	exchange *money.Exchange
+	holder   string
 }
 
 func NewServer(config util.Config, grpc pb.GalaxyClient) (*Server, error) {
+	switch config.SessionPolicy {
+	case "":
+		config.SessionPolicy = sessionPolicyReject
+	case sessionPolicyReject, sessionPolicyEvictOldest:
+	default:
+		return nil, fmt.Errorf("unknown session limit policy %q", config.SessionPolicy)
+	}
+
+	// the secret in app.env is a placeholder anyone can sign webhooks with
+	if !config.Development() && (config.PaymentsWebhookSecret == "" || config.PaymentsWebhookSecret == developmentWebhookSecret) {
+		return nil, errors.New("set PAYMENTS_WEBHOOK_SECRET to the secret of the payments provider")
+	}
+
+	paymentsProvider, err := payments.NewProvider(config)
+	if err != nil {
+		return nil, err
+	}
+
+	taxEngine, err := tax.LoadRules(config.TaxRulesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	exchange, err := money.LoadRates(config.ExchangeRatesFile, config.PaymentsCurrency)
+	if err != nil {
+		return nil, err
+	}
+	if !exchange.Supports(config.PaymentsCurrency) {
+		return nil, fmt.Errorf("no exchange rate for the payments currency %s", config.PaymentsCurrency)
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "controller"
+	}
+
 	server := Server{
-		config: config,
-		grpc:   grpc,
+		config:   config,
+		grpc:     grpc,
+		notifier: notify.NewNotifier(config),
+		payments: paymentsProvider,
+		sagas:    saga.NewCoordinator(config, grpc),
+		tax:      taxEngine,
+		exchange: exchange,
+		holder:   fmt.Sprintf("%s-%s", hostname, util.GetRandomString(8)),
 	}
 
+	server.registerSagas()
 	server.SetupRouter()
 
 	return &server, nil
@@ -29,21 +91,78 @@ func (server *Server) SetupRouter() {
 	router.POST("/user/login", server.Login)
 	router.POST("/user/create", server.CreateUser)
 	router.POST("/token/renew", server.RenewAccessToken)
+	router.GET("/session/report", server.ReportSessionPage)
+	router.POST("/session/report", server.ReportSession)
+	router.GET("/user/password/reset", server.ResetPasswordPage)
+	router.POST("/user/password/reset", server.ResetPassword)
+	router.GET("/plan/list", server.ListPlans)
+	router.GET("/plan/get/:id", server.GetPlan)
+	router.POST("/payments/webhook", server.PaymentWebhook)
 
 	authRouter := router.Group("/").Use(authMiddleware(server))
 
 	authRouter.GET("/user/get/:id", server.GetUser)
 	authRouter.POST("/user/update", server.UpdateUser)
-	authRouter.POST("/item/create", server.CreateItem)
+	authRouter.GET("/user/export", server.ExportUser)
+	authRouter.POST("/user/delete", server.DeleteAccount)
+	authRouter.POST("/user/security-events", server.ListSecurityEvents)
+	authRouter.POST("/subscription/change", server.ChangeSubscription)
+	authRouter.POST("/subscription/history", server.ListPlanChanges)
+	authRouter.GET("/user/usage", server.GetUsage)
+	authRouter.GET("/user/points", server.GetPoints)
+	authRouter.GET("/wallet", server.GetWallet)
+	authRouter.POST("/wallet/topup", server.TopUpWallet)
+	authRouter.POST("/wallet/topup/capture", server.CaptureTopUp)
+	authRouter.POST("/giftcard/redeem", server.RedeemGiftCard)
+	authRouter.POST("/team/create", server.CreateTeam)
+	authRouter.GET("/team", server.GetTeam)
+	authRouter.POST("/team/invite", server.InviteTeamMember)
+	authRouter.POST("/team/join", server.JoinTeam)
+	authRouter.POST("/team/member/role", server.UpdateTeamMember)
+	authRouter.POST("/team/member/remove", server.RemoveTeamMember)
 	authRouter.GET("/item/get/:id", server.GetItem)
 	authRouter.POST("/item/list", server.ListItems)
-	authRouter.POST("/item/update", server.UpdateItem)
-	authRouter.DELETE("/item/delete/:id", server.DeleteItem)
-	authRouter.POST("/entry/create", server.CreateEntry)
 	authRouter.GET("/entry/get/:id", server.GetEntry)
+	authRouter.GET("/entry/receipt/:id", server.GetReceipt)
 	authRouter.POST("/entry/list", server.ListEntries)
 	authRouter.POST("/entry/list/user", server.ListEntriesByUser)
 	authRouter.POST("/entry/list/item", server.ListEntriesByItem)
+	authRouter.GET("/cart", server.GetCart)
+	authRouter.GET("/order/get/:id", server.GetOrder)
+	authRouter.POST("/order/list", server.ListOrders)
+
+	// lapsed subscribers can still browse, but not create or change anything
+	paidRouter := router.Group("/").Use(authMiddleware(server), subscriptionMiddleware(server))
+
+	paidRouter.POST("/quote", server.CreateQuote)
+	paidRouter.POST("/entry/create", server.CreateEntry)
+	paidRouter.POST("/entry/capture", server.CaptureEntry)
+	paidRouter.POST("/cart/add", server.AddCartLine)
+	paidRouter.POST("/cart/update", server.UpdateCartLine)
+	paidRouter.POST("/cart/remove", server.RemoveCartLine)
+	paidRouter.POST("/order/checkout", server.Checkout)
+	paidRouter.POST("/order/capture", server.CaptureOrder)
+
+	staffRouter := router.Group("/").Use(authMiddleware(server), roleMiddleware(pb.Role_ROLE_STAFF, pb.Role_ROLE_ADMIN))
+
+	// prices and gift card items decide what entries cost, so only staff
+	// manage the catalog
+	staffRouter.POST("/item/create", server.CreateItem)
+	staffRouter.POST("/item/update", server.UpdateItem)
+	staffRouter.DELETE("/item/delete/:id", server.DeleteItem)
+	staffRouter.POST("/entry/status", server.UpdateEntryStatus)
+
+	adminRouter := router.Group("/admin").Use(authMiddleware(server), roleMiddleware(pb.Role_ROLE_ADMIN))
+
+	adminRouter.POST("/users", server.ListUsers)
+	adminRouter.POST("/user/status", server.UpdateUserStatus)
+	adminRouter.POST("/user/plan", server.AdminChangePlan)
+	adminRouter.POST("/coupon/create", server.CreateCoupon)
+	adminRouter.POST("/coupons", server.ListCoupons)
+	adminRouter.POST("/coupon/update", server.UpdateCoupon)
+	adminRouter.POST("/coupon/redemptions", server.ListCouponRedemptions)
+	adminRouter.POST("/wallet/adjust", server.AdjustWallet)
+	adminRouter.GET("/giftcard/:code", server.GetGiftCard)
 
 	server.router = router
 }
@@ -57,3 +176,15 @@ func errorResponse(err error) *gin.H {
 		"error": err.Error(),
 	}
 }
+
+// Payments returns the payments provider of the server, for the billing
+// scheduler to charge through the same one.
+func (server *Server) Payments() payments.Provider {
+	return server.payments
+}
+
+// RecoverSagas rolls back purchases abandoned by a crashed controller until
+// ctx is cancelled.
+func (server *Server) RecoverSagas(ctx context.Context) {
+	server.sagas.RunRecovery(ctx)
+}
@@ -0,0 +1,79 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mockpb "github.com/machearn/galaxy_controller/pb/mock"
+	"github.com/machearn/galaxy_controller/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewServerOutsideDevelopment(t *testing.T) {
+	testCases := []struct {
+		name   string
+		update func(config *util.Config)
+		ok     bool
+	}{
+		{
+			name:   "Development",
+			update: func(config *util.Config) {},
+			ok:     true,
+		},
+		{
+			name: "Production",
+			update: func(config *util.Config) {
+				config.Environment = "production"
+				config.PaymentsProvider = "stripe"
+				config.StripeSecretKey = "sk_test"
+				config.PaymentsWebhookSecret = "whsec_production"
+			},
+			ok: true,
+		},
+		{
+			name: "PlaceholderWebhookSecret",
+			update: func(config *util.Config) {
+				config.Environment = "production"
+				config.PaymentsProvider = "stripe"
+				config.StripeSecretKey = "sk_test"
+			},
+		},
+		{
+			name: "NoWebhookSecret",
+			update: func(config *util.Config) {
+				config.Environment = "production"
+				config.PaymentsProvider = "stripe"
+				config.StripeSecretKey = "sk_test"
+				config.PaymentsWebhookSecret = ""
+			},
+		},
+		{
+			name: "FakeProvider",
+			update: func(config *util.Config) {
+				config.Environment = "production"
+				config.PaymentsWebhookSecret = "whsec_production"
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			config, err := util.LoadConfig("..")
+			require.NoError(t, err)
+			config.TaxRulesFile = ""
+			config.ExchangeRatesFile = ""
+			tc.update(&config)
+
+			server, err := NewServer(config, mockpb.NewMockGalaxyClient(ctrl))
+			if !tc.ok {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, server)
+		})
+	}
+}
@@ -0,0 +1,440 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"html/template"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/machearn/galaxy_controller/notify"
+	"github.com/machearn/galaxy_controller/pb"
+	"github.com/machearn/galaxy_controller/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	sessionPolicyReject      = "reject"
+	sessionPolicyEvictOldest = "evict_oldest"
+)
+
+const (
+	sessionReportPurpose  = "session_report"
+	passwordResetPurpose  = "password_reset"
+	sessionReportDuration = time.Hour * 24 * 7
+	passwordResetDuration = time.Hour
+)
+
+type Session struct {
+	ID        string    `json:"id"`
+	ClientIP  string    `json:"client_ip"`
+	UserAgent string    `json:"user_agent"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiredAt time.Time `json:"expired_at"`
+}
+
+func newSessionResponse(session *pb.Session) Session {
+	return Session{
+		ID:        session.GetID(),
+		ClientIP:  session.GetClientIp(),
+		UserAgent: session.GetUserAgent(),
+		CreatedAt: session.GetCreatedAt().AsTime(),
+		ExpiredAt: session.GetExpiredAt().AsTime(),
+	}
+}
+
+// deviceFingerprint identifies the device a session was opened from.
+func deviceFingerprint(clientIP string, userAgent string) string {
+	sum := sha256.Sum256([]byte(clientIP + "\x00" + userAgent))
+	return hex.EncodeToString(sum[:])
+}
+
+// isNewDevice reports whether the user already has sessions but none of them
+// was opened from the given device. A user's very first login is not treated
+// as a new device.
+func isNewDevice(sessions []*pb.Session, clientIP string, userAgent string) bool {
+	if len(sessions) == 0 {
+		return false
+	}
+
+	fingerprint := deviceFingerprint(clientIP, userAgent)
+	for _, session := range sessions {
+		if deviceFingerprint(session.GetClientIp(), session.GetUserAgent()) == fingerprint {
+			return false
+		}
+	}
+	return true
+}
+
+// activeSessions returns the sessions that have not expired yet, oldest first.
+func activeSessions(sessions []*pb.Session, now time.Time) []*pb.Session {
+	active := make([]*pb.Session, 0, len(sessions))
+	for _, session := range sessions {
+		if session.GetExpiredAt().AsTime().After(now) {
+			active = append(active, session)
+		}
+	}
+	sort.Slice(active, func(i, j int) bool {
+		return active[i].GetCreatedAt().AsTime().Before(active[j].GetCreatedAt().AsTime())
+	})
+	return active
+}
+
+// enforceSessionLimit makes room for one more session under the user's plan.
+// Depending on the configured policy it either rejects the login or revokes
+// the oldest sessions. It returns false if the request has been answered.
+func (server *Server) enforceSessionLimit(ctx *gin.Context, user *pb.User, plan *pb.Plan, sessions []*pb.Session) bool {
+	limit := int(plan.GetMaxSessions())
+	if limit <= 0 {
+		return true
+	}
+
+	active := activeSessions(sessions, time.Now())
+	if len(active) < limit {
+		return true
+	}
+
+	if server.config.SessionPolicy == sessionPolicyReject {
+		server.recordSecurityEvent(ctx, user.GetID(), pb.SecurityEventType_SECURITY_EVENT_TYPE_LOGIN_FAILURE, "session limit reached")
+		err := fmt.Errorf("your plan allows at most %d concurrent sessions", limit)
+		ctx.JSON(http.StatusConflict, errorResponse(err))
+		return false
+	}
+
+	for _, session := range active[:len(active)-limit+1] {
+		if _, err := server.grpc.RevokeSession(ctx, &pb.RevokeSessionRequest{ID: session.GetID()}); err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return false
+		}
+		server.recordSecurityEvent(ctx, user.GetID(), pb.SecurityEventType_SECURITY_EVENT_TYPE_SESSION_REVOKE, "session "+session.GetID()+" evicted by session limit")
+	}
+	return true
+}
+
+// sessionClaims are the claims of the single-use tokens sent by email. ID
+// identifies the token when it is consumed.
+type sessionClaims struct {
+	ID        string    `json:"id"`
+	Purpose   string    `json:"purpose"`
+	UserID    int32     `json:"user_id"`
+	SessionID string    `json:"session_id,omitempty"`
+	ExpiredAt time.Time `json:"expired_at"`
+}
+
+func (server *Server) signSessionClaims(purpose string, userID int32, sessionID string, duration time.Duration) (string, error) {
+	return util.SignToken(server.config.TokenSymmetricKey, sessionClaims{
+		ID:        util.GetRandomString(32),
+		Purpose:   purpose,
+		UserID:    userID,
+		SessionID: sessionID,
+		ExpiredAt: time.Now().Add(duration),
+	})
+}
+
+func (server *Server) verifySessionClaims(token string, purpose string) (*sessionClaims, error) {
+	var claims sessionClaims
+	if err := util.VerifyToken(server.config.TokenSymmetricKey, token, &claims); err != nil {
+		return nil, errors.New("token is invalid")
+	}
+	if claims.Purpose != purpose {
+		return nil, errors.New("token is invalid")
+	}
+	if time.Now().After(claims.ExpiredAt) {
+		return nil, errors.New("token has expired")
+	}
+	return &claims, nil
+}
+
+var errTokenUsed = errors.New("token has already been used")
+
+// consumeSessionClaims marks the token the claims came from as used, so it
+// cannot be used again.
+func (server *Server) consumeSessionClaims(ctx *gin.Context, claims *sessionClaims) error {
+	_, err := server.grpc.ConsumeToken(ctx, &pb.ConsumeTokenRequest{
+		ID:        claims.ID,
+		ExpiredAt: timestamppb.New(claims.ExpiredAt),
+	})
+	if status.Code(err) == codes.AlreadyExists {
+		return errTokenUsed
+	}
+	return err
+}
+
+// sendNewDeviceAlert emails the user a link to report a login they do not
+// recognise.
+func (server *Server) sendNewDeviceAlert(ctx *gin.Context, user *pb.User, session *pb.Session) {
+	token, err := server.signSessionClaims(sessionReportPurpose, user.GetID(), session.GetID(), sessionReportDuration)
+	if err != nil {
+		log.Printf("failed to sign session report token for user %d: %v", user.GetID(), err)
+		return
+	}
+
+	msg := notify.Message{
+		To:      user.GetEmail(),
+		Subject: "New sign-in to your Galaxy account",
+		Body: fmt.Sprintf(
+			"Your account was signed in to from a new device.\n\nIP address: %s\nDevice: %s\nTime: %s\n\n"+
+				"If this wasn't you, open the link below to sign the device out and reset your password:\n%s/session/report?token=%s\n",
+			session.GetClientIp(),
+			session.GetUserAgent(),
+			session.GetCreatedAt().AsTime().Format(time.RFC1123),
+			server.config.PublicBaseURL,
+			url.QueryEscape(token),
+		),
+	}
+
+	if err := server.notifier.Notify(ctx, msg); err != nil {
+		log.Printf("failed to send new device alert to user %d: %v", user.GetID(), err)
+	}
+}
+
+// confirmPage asks the user to confirm the action of a link sent by email.
+// Links only show the page, so mail scanners and link prefetchers that open
+// them do not trigger the action.
+var confirmPage = template.Must(template.New("confirm").Parse(`<!DOCTYPE html>
+<html>
+<head><title>{{.Title}}</title></head>
+<body>
+<h1>{{.Title}}</h1>
+<p>{{.Text}}</p>
+<form method="post" action="{{.Action}}">
+<input type="hidden" name="{{.TokenField}}" value="{{.Token}}">
+{{if .Password}}<input type="password" name="password" required>{{end}}
+<button type="submit">{{.Button}}</button>
+</form>
+</body>
+</html>
+`))
+
+type confirmPageData struct {
+	Title      string
+	Text       string
+	Action     string
+	TokenField string
+	Token      string
+	Password   bool
+	Button     string
+}
+
+func renderConfirmPage(ctx *gin.Context, data confirmPageData) {
+	ctx.Header("Cache-Control", "no-store")
+	ctx.Header("Referrer-Policy", "no-referrer")
+	ctx.Header("Content-Type", "text/html; charset=utf-8")
+	ctx.Status(http.StatusOK)
+	if err := confirmPage.Execute(ctx.Writer, data); err != nil {
+		log.Printf("failed to render %s page: %v", data.Action, err)
+	}
+}
+
+// bindPageForm binds the form posted by a confirmation page, or the JSON
+// body of API clients.
+func bindPageForm(ctx *gin.Context, req any) error {
+	if ctx.ContentType() == binding.MIMEPOSTForm {
+		return ctx.ShouldBindWith(req, binding.Form)
+	}
+	return ctx.ShouldBindJSON(req)
+}
+
+type ReportSessionRequest struct {
+	Token string `form:"token" json:"token" binding:"required"`
+}
+
+// ReportSessionPage is where the link of a new device alert leads. It only
+// asks the user to confirm the report.
+func (server *Server) ReportSessionPage(ctx *gin.Context) {
+	var req ReportSessionRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, err := server.verifySessionClaims(req.Token, sessionReportPurpose); err != nil {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+
+	renderConfirmPage(ctx, confirmPageData{
+		Title:      "Report a sign-in",
+		Text:       "Sign every device out of your account? You will have to reset your password before you can sign in again.",
+		Action:     "/session/report",
+		TokenField: "token",
+		Token:      req.Token,
+		Button:     "Sign out everywhere",
+	})
+}
+
+// ReportSession handles the confirmed report of a new device alert. Every
+// session of the user is revoked and a password reset is required before
+// they can log in again; the link to reset it is sent by email. Report
+// tokens can only be used once.
+func (server *Server) ReportSession(ctx *gin.Context) {
+	var req ReportSessionRequest
+	if err := bindPageForm(ctx, &req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	claims, err := server.verifySessionClaims(req.Token, sessionReportPurpose)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+	if err := server.consumeSessionClaims(ctx, claims); err != nil {
+		if errors.Is(err, errTokenUsed) {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	_, err = server.grpc.RevokeSessions(ctx, &pb.RevokeSessionsRequest{UserId: claims.UserID})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	server.recordSecurityEvent(ctx, claims.UserID, pb.SecurityEventType_SECURITY_EVENT_TYPE_SESSION_REVOKE, "session "+claims.SessionID+" reported by user")
+
+	resetRequired := true
+	userResult, err := server.grpc.UpdateUser(ctx, &pb.UpdateUserRequest{
+		ID:                    claims.UserID,
+		PasswordResetRequired: &resetRequired,
+	})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.NotFound {
+				ctx.JSON(http.StatusNotFound, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	resetToken, err := server.signSessionClaims(passwordResetPurpose, claims.UserID, "", passwordResetDuration)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	msg := notify.Message{
+		To:      userResult.GetUser().GetEmail(),
+		Subject: "Reset your Galaxy password",
+		Body: fmt.Sprintf(
+			"Every device has been signed out of your account.\n\n"+
+				"Open the link below within %s to choose a new password:\n%s/user/password/reset?reset_token=%s\n",
+			passwordResetDuration,
+			server.config.PublicBaseURL,
+			url.QueryEscape(resetToken),
+		),
+	}
+	if err := server.notifier.Notify(ctx, msg); err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
+
+type ResetPasswordPageRequest struct {
+	ResetToken string `form:"reset_token" binding:"required"`
+}
+
+// ResetPasswordPage is where the link of a password reset email leads. It
+// asks the user for the new password.
+func (server *Server) ResetPasswordPage(ctx *gin.Context) {
+	var req ResetPasswordPageRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, err := server.verifySessionClaims(req.ResetToken, passwordResetPurpose); err != nil {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+
+	renderConfirmPage(ctx, confirmPageData{
+		Title:      "Reset your password",
+		Text:       "Choose a new password for your account.",
+		Action:     "/user/password/reset",
+		TokenField: "reset_token",
+		Token:      req.ResetToken,
+		Password:   true,
+		Button:     "Reset password",
+	})
+}
+
+type ResetPasswordRequest struct {
+	ResetToken string `form:"reset_token" json:"reset_token" binding:"required"`
+	Password   string `form:"password" json:"password" binding:"required"`
+}
+
+// ResetPassword sets a new password with a reset token. Reset tokens can only
+// be used once.
+func (server *Server) ResetPassword(ctx *gin.Context) {
+	var req ResetPasswordRequest
+	if err := bindPageForm(ctx, &req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	claims, err := server.verifySessionClaims(req.ResetToken, passwordResetPurpose)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+	if err := server.consumeSessionClaims(ctx, claims); err != nil {
+		if errors.Is(err, errTokenUsed) {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	hashedPassword, err := util.HashPassword(req.Password)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	resetRequired := false
+	grpcReq := pb.UpdateUserRequest{
+		ID:                    claims.UserID,
+		Password:              &hashedPassword,
+		PasswordResetRequired: &resetRequired,
+	}
+
+	_, err = server.grpc.UpdateUser(ctx, &grpcReq)
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.NotFound {
+				ctx.JSON(http.StatusNotFound, errorResponse(apiErr.Err()))
+				return
+			}
+			if apiErr.Code() == codes.InvalidArgument {
+				ctx.JSON(http.StatusBadRequest, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	server.recordSecurityEvent(ctx, claims.UserID, pb.SecurityEventType_SECURITY_EVENT_TYPE_PASSWORD_CHANGE, "password reset")
+
+	ctx.JSON(http.StatusOK, nil)
+}
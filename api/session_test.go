@@ -0,0 +1,413 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/machearn/galaxy_controller/pb"
+	mockpb "github.com/machearn/galaxy_controller/pb/mock"
+	"github.com/machearn/galaxy_controller/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestLoginAPINewDevice(t *testing.T) {
+	data, err := json.Marshal(gin.H{
+		"username": "test",
+		"password": "test",
+	})
+	require.NoError(t, err)
+
+	created := time.Now().UTC().Truncate(time.Second)
+	hashedPassword, err := util.HashPassword("test")
+	require.NoError(t, err)
+
+	sessionID := uuid.New().String()
+	grpcGetUserRes := pb.GetUserResponse{
+		User: &pb.User{
+			ID:        1,
+			Username:  "test",
+			Email:     "test@example.com",
+			CreatedAt: timestamppb.New(created),
+			ExpiredAt: timestamppb.New(created),
+		},
+		Password: hashedPassword,
+	}
+	grpcListSessionsRes := pb.ListSessionsResponse{
+		Sessions: []*pb.Session{
+			{
+				ID:        uuid.New().String(),
+				UserId:    1,
+				ClientIp:  "10.0.0.1",
+				UserAgent: "known-device",
+				CreatedAt: timestamppb.New(created),
+				ExpiredAt: timestamppb.New(created),
+			},
+		},
+	}
+	grpcCreateSessionRes := pb.CreateSessionResponse{
+		AccessToken: util.GetRandomString(32),
+		ExpiredAt:   timestamppb.New(created),
+		Session: &pb.Session{
+			ID:        sessionID,
+			UserId:    1,
+			ClientIp:  "10.0.0.2",
+			UserAgent: "unknown-device",
+			CreatedAt: timestamppb.New(created),
+			ExpiredAt: timestamppb.New(created),
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().GetUserByUsername(gomock.Any(), gomock.Any()).Return(&grpcGetUserRes, nil)
+	grpc.EXPECT().ListSessions(gomock.Any(), gomock.Eq(&pb.ListSessionsRequest{UserId: 1})).Return(&grpcListSessionsRes, nil)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 0})).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 0, MaxSessions: 1}}, nil)
+	grpc.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Return(&grpcCreateSessionRes, nil)
+	grpc.EXPECT().CreateSecurityEvent(gomock.Any(), EqSecurityEvent(1, pb.SecurityEventType_SECURITY_EVENT_TYPE_LOGIN_SUCCESS)).Return(&pb.Empty{}, nil)
+
+	server := NewTestServer(t, grpc)
+	notifier := &recordingNotifier{}
+	server.notifier = notifier
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/user/login", bytes.NewReader(data))
+	require.NoError(t, err)
+	request.Header.Set("User-Agent", "unknown-device")
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	require.Len(t, notifier.messages, 1)
+	msg := notifier.messages[0]
+	require.Equal(t, "test@example.com", msg.To)
+	require.Contains(t, msg.Body, "unknown-device")
+	require.Contains(t, msg.Body, server.config.PublicBaseURL+"/session/report?token=")
+
+	link := msg.Body[strings.Index(msg.Body, "token=")+len("token="):]
+	claims, err := server.verifySessionClaims(strings.TrimSpace(link), sessionReportPurpose)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), claims.UserID)
+	require.Equal(t, sessionID, claims.SessionID)
+}
+
+func TestLoginAPIKnownDevice(t *testing.T) {
+	data, err := json.Marshal(gin.H{
+		"username": "test",
+		"password": "test",
+	})
+	require.NoError(t, err)
+
+	created := time.Now().UTC().Truncate(time.Second)
+	hashedPassword, err := util.HashPassword("test")
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/user/login", bytes.NewReader(data))
+	require.NoError(t, err)
+	request.Header.Set("User-Agent", "known-device")
+
+	grpcGetUserRes := pb.GetUserResponse{
+		User:     &pb.User{ID: 1, Email: "test@example.com"},
+		Password: hashedPassword,
+	}
+	grpcListSessionsRes := pb.ListSessionsResponse{
+		Sessions: []*pb.Session{
+			{
+				ID:        uuid.New().String(),
+				UserId:    1,
+				ClientIp:  request.RemoteAddr,
+				UserAgent: "known-device",
+				CreatedAt: timestamppb.New(created),
+				ExpiredAt: timestamppb.New(created),
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().GetUserByUsername(gomock.Any(), gomock.Any()).Return(&grpcGetUserRes, nil)
+	grpc.EXPECT().ListSessions(gomock.Any(), gomock.Any()).Return(&grpcListSessionsRes, nil)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.NotFound, "plan not found"))
+	grpc.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Return(&pb.CreateSessionResponse{Session: &pb.Session{}}, nil)
+	grpc.EXPECT().CreateSecurityEvent(gomock.Any(), gomock.Any()).Return(&pb.Empty{}, nil)
+
+	server := NewTestServer(t, grpc)
+	notifier := &recordingNotifier{}
+	server.notifier = notifier
+	recorder := httptest.NewRecorder()
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Empty(t, notifier.messages)
+}
+
+func TestReportSessionPageAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().ConsumeToken(gomock.Any(), gomock.Any()).Times(0)
+	grpc.EXPECT().RevokeSessions(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	token, err := server.signSessionClaims(sessionReportPurpose, 1, uuid.New().String(), time.Minute)
+	require.NoError(t, err)
+
+	// opening the link only asks for confirmation
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodGet, "/session/report?token="+url.QueryEscape(token), nil)
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Contains(t, recorder.Header().Get("Content-Type"), "text/html")
+	require.Contains(t, recorder.Body.String(), `method="post" action="/session/report"`)
+	require.Contains(t, recorder.Body.String(), token)
+}
+
+func TestReportSessionAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resetRequired := true
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	gomock.InOrder(
+		grpc.EXPECT().ConsumeToken(gomock.Any(), gomock.Any()).Return(&pb.Empty{}, nil),
+		grpc.EXPECT().ConsumeToken(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.AlreadyExists, "token used")),
+	)
+	grpc.EXPECT().RevokeSessions(gomock.Any(), gomock.Eq(&pb.RevokeSessionsRequest{UserId: 1})).Return(&pb.RevokeSessionsResponse{Count: 1}, nil)
+	grpc.EXPECT().CreateSecurityEvent(gomock.Any(), EqSecurityEvent(1, pb.SecurityEventType_SECURITY_EVENT_TYPE_SESSION_REVOKE)).Return(&pb.Empty{}, nil)
+	grpc.EXPECT().UpdateUser(gomock.Any(), gomock.Eq(&pb.UpdateUserRequest{
+		ID:                    1,
+		PasswordResetRequired: &resetRequired,
+	})).Return(&pb.UpdateUserResponse{User: &pb.User{ID: 1, Email: "test@example.com", PasswordResetRequired: true}}, nil)
+
+	server := NewTestServer(t, grpc)
+	notifier := &recordingNotifier{}
+	server.notifier = notifier
+	token, err := server.signSessionClaims(sessionReportPurpose, 1, uuid.New().String(), time.Minute)
+	require.NoError(t, err)
+
+	form := url.Values{"token": {token}}
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodPost, "/session/report", strings.NewReader(form.Encode()))
+	require.NoError(t, err)
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.NotContains(t, recorder.Body.String(), "reset_token")
+
+	// the reset link is sent by email instead
+	require.Len(t, notifier.messages, 1)
+	msg := notifier.messages[0]
+	require.Equal(t, "test@example.com", msg.To)
+	require.Contains(t, msg.Body, server.config.PublicBaseURL+"/user/password/reset?reset_token=")
+
+	link := msg.Body[strings.Index(msg.Body, "reset_token=")+len("reset_token="):]
+	claims, err := server.verifySessionClaims(strings.TrimSpace(link), passwordResetPurpose)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), claims.UserID)
+
+	// the report token cannot be used again
+	recorder = httptest.NewRecorder()
+	request, err = http.NewRequest(http.MethodPost, "/session/report", strings.NewReader(form.Encode()))
+	require.NoError(t, err)
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+	require.Len(t, notifier.messages, 1)
+}
+
+func TestReportSessionAPIInvalidToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().ConsumeToken(gomock.Any(), gomock.Any()).Times(0)
+	grpc.EXPECT().RevokeSessions(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+
+	// a password reset token must not be accepted as a report token
+	token, err := server.signSessionClaims(passwordResetPurpose, 1, "", time.Minute)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(gin.H{"token": token})
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodPost, "/session/report", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+}
+
+type eqPasswordResetMatcher struct {
+	userID   int32
+	password string
+}
+
+func (m eqPasswordResetMatcher) Matches(x interface{}) bool {
+	req, ok := x.(*pb.UpdateUserRequest)
+	if !ok {
+		return false
+	}
+	if req.GetID() != m.userID || req.PasswordResetRequired == nil || req.GetPasswordResetRequired() {
+		return false
+	}
+	return util.CheckPassword(m.password, req.GetPassword()) == nil
+}
+
+func (m eqPasswordResetMatcher) String() string {
+	return "resets the password"
+}
+
+func TestResetPasswordAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	gomock.InOrder(
+		grpc.EXPECT().ConsumeToken(gomock.Any(), gomock.Any()).Return(&pb.Empty{}, nil),
+		grpc.EXPECT().ConsumeToken(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.AlreadyExists, "token used")),
+	)
+	grpc.EXPECT().UpdateUser(gomock.Any(), eqPasswordResetMatcher{userID: 1, password: "new-password"}).Return(&pb.UpdateUserResponse{User: &pb.User{ID: 1}}, nil)
+	grpc.EXPECT().CreateSecurityEvent(gomock.Any(), EqSecurityEvent(1, pb.SecurityEventType_SECURITY_EVENT_TYPE_PASSWORD_CHANGE)).Return(&pb.Empty{}, nil)
+
+	server := NewTestServer(t, grpc)
+	token, err := server.signSessionClaims(passwordResetPurpose, 1, "", time.Minute)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(gin.H{
+		"reset_token": token,
+		"password":    "new-password",
+	})
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodPost, "/user/password/reset", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	// reset tokens can only be used once
+	recorder = httptest.NewRecorder()
+	request, err = http.NewRequest(http.MethodPost, "/user/password/reset", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+}
+
+func sessionLimitFixture(t *testing.T, request *http.Request) (*pb.GetUserResponse, *pb.ListSessionsResponse) {
+	hashedPassword, err := util.HashPassword("test")
+	require.NoError(t, err)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	user := pb.GetUserResponse{
+		User:     &pb.User{ID: 1, Plan: 1, Email: "test@example.com"},
+		Password: hashedPassword,
+	}
+
+	// the plan allows three sessions; the expired one does not count
+	sessions := pb.ListSessionsResponse{}
+	for i, id := range []string{"expired", "oldest", "older", "newest"} {
+		created := now.Add(time.Duration(i-4) * time.Hour)
+		expired := created.Add(time.Hour * 24)
+		if id == "expired" {
+			expired = now.Add(-time.Minute)
+		}
+		sessions.Sessions = append(sessions.Sessions, &pb.Session{
+			ID:        id,
+			UserId:    1,
+			ClientIp:  request.RemoteAddr,
+			UserAgent: request.UserAgent(),
+			CreatedAt: timestamppb.New(created),
+			ExpiredAt: timestamppb.New(expired),
+		})
+	}
+	// shuffle so that eviction cannot rely on the backend's ordering
+	sessions.Sessions[1], sessions.Sessions[3] = sessions.Sessions[3], sessions.Sessions[1]
+	return &user, &sessions
+}
+
+func TestLoginAPISessionLimitEvictOldest(t *testing.T) {
+	data, err := json.Marshal(gin.H{
+		"username": "test",
+		"password": "test",
+	})
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/user/login", bytes.NewReader(data))
+	require.NoError(t, err)
+	user, sessions := sessionLimitFixture(t, request)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().GetUserByUsername(gomock.Any(), gomock.Any()).Return(user, nil)
+	grpc.EXPECT().ListSessions(gomock.Any(), gomock.Any()).Return(sessions, nil)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 1})).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 1, MaxSessions: 3}}, nil)
+	grpc.EXPECT().RevokeSession(gomock.Any(), gomock.Eq(&pb.RevokeSessionRequest{ID: "oldest"})).Return(&pb.Empty{}, nil)
+	grpc.EXPECT().CreateSecurityEvent(gomock.Any(), EqSecurityEvent(1, pb.SecurityEventType_SECURITY_EVENT_TYPE_SESSION_REVOKE)).Return(&pb.Empty{}, nil)
+	grpc.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Return(&pb.CreateSessionResponse{Session: &pb.Session{}}, nil)
+	grpc.EXPECT().CreateSecurityEvent(gomock.Any(), EqSecurityEvent(1, pb.SecurityEventType_SECURITY_EVENT_TYPE_LOGIN_SUCCESS)).Return(&pb.Empty{}, nil)
+
+	server := NewTestServer(t, grpc)
+	server.config.SessionPolicy = sessionPolicyEvictOldest
+	recorder := httptest.NewRecorder()
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestLoginAPISessionLimitReject(t *testing.T) {
+	data, err := json.Marshal(gin.H{
+		"username": "test",
+		"password": "test",
+	})
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/user/login", bytes.NewReader(data))
+	require.NoError(t, err)
+	user, sessions := sessionLimitFixture(t, request)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().GetUserByUsername(gomock.Any(), gomock.Any()).Return(user, nil)
+	grpc.EXPECT().ListSessions(gomock.Any(), gomock.Any()).Return(sessions, nil)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 1})).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 1, MaxSessions: 3}}, nil)
+	grpc.EXPECT().CreateSecurityEvent(gomock.Any(), EqSecurityEvent(1, pb.SecurityEventType_SECURITY_EVENT_TYPE_LOGIN_FAILURE)).Return(&pb.Empty{}, nil)
+	grpc.EXPECT().RevokeSession(gomock.Any(), gomock.Any()).Times(0)
+	grpc.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	server.config.SessionPolicy = sessionPolicyReject
+	recorder := httptest.NewRecorder()
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusConflict, recorder.Code)
+	require.Contains(t, recorder.Body.String(), "at most 3 concurrent sessions")
+}
@@ -0,0 +1,68 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/machearn/galaxy_controller/pb"
+)
+
+var userStateNames = map[pb.UserState]string{
+	pb.UserState_USER_STATE_ACTIVE:    "active",
+	pb.UserState_USER_STATE_SUSPENDED: "suspended",
+	pb.UserState_USER_STATE_BANNED:    "banned",
+}
+
+var userStates = map[string]pb.UserState{
+	"active":    pb.UserState_USER_STATE_ACTIVE,
+	"suspended": pb.UserState_USER_STATE_SUSPENDED,
+	"banned":    pb.UserState_USER_STATE_BANNED,
+}
+
+var roleNames = map[pb.Role]string{
+	pb.Role_ROLE_MEMBER: "member",
+	pb.Role_ROLE_STAFF:  "staff",
+	pb.Role_ROLE_ADMIN:  "admin",
+}
+
+// isUserActive reports whether an account with the given status may use the
+// API. A suspension with an until-date lifts itself once that date has passed.
+func isUserActive(status *pb.UserStatus, now time.Time) bool {
+	switch status.GetState() {
+	case pb.UserState_USER_STATE_ACTIVE:
+		return true
+	case pb.UserState_USER_STATE_SUSPENDED:
+		return status.GetUntil() != nil && !status.GetUntil().AsTime().After(now)
+	default:
+		return false
+	}
+}
+
+func inactiveUserError(status *pb.UserStatus) error {
+	msg := fmt.Sprintf("account is %s", userStateNames[status.GetState()])
+	if status.GetUntil() != nil {
+		msg += " until " + status.GetUntil().AsTime().Format(time.RFC3339)
+	}
+	if len(status.GetReason()) > 0 {
+		msg += ": " + status.GetReason()
+	}
+	return errors.New(msg)
+}
+
+// rejectInactiveUser revokes every session of a suspended or banned user and
+// aborts the request with 403. The revocation is only recorded as a security
+// event if sessions were left, so a client that keeps retrying does not
+// flood the log.
+func (server *Server) rejectInactiveUser(ctx *gin.Context, userID int32, status *pb.UserStatus) {
+	result, err := server.grpc.RevokeSessions(ctx, &pb.RevokeSessionsRequest{UserId: userID})
+	if err != nil {
+		log.Printf("failed to revoke sessions of user %d: %v", userID, err)
+	} else if result.GetCount() > 0 {
+		server.recordSecurityEvent(ctx, userID, pb.SecurityEventType_SECURITY_EVENT_TYPE_SESSION_REVOKE, inactiveUserError(status).Error())
+	}
+	ctx.AbortWithStatusJSON(http.StatusForbidden, errorResponse(inactiveUserError(status)))
+}
@@ -0,0 +1,288 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/machearn/galaxy_controller/billing"
+	"github.com/machearn/galaxy_controller/payments"
+	"github.com/machearn/galaxy_controller/pb"
+	"github.com/machearn/galaxy_controller/saga"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ChangeSubscriptionRequest struct {
+	PlanID  int32 `json:"plan_id" binding:"min=0"`
+	Preview bool  `json:"preview"`
+}
+
+type PlanChange struct {
+	FromPlanID int32      `json:"from_plan_id"`
+	ToPlanID   int32      `json:"to_plan_id"`
+	Credit     int32      `json:"credit"`
+	Charge     int32      `json:"charge"`
+	ChargeID   string     `json:"charge_id,omitempty"`
+	ExpiredAt  *time.Time `json:"expired_at"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	Applied    bool       `json:"applied"`
+}
+
+// ChangeSubscription moves the authenticated user to another plan. This is
+// the only way for users to change their plan; UpdateUser rejects it. With
+// preview set the prorated cost is returned without charging or applying.
+// The new plan is applied before the user is charged and taken back if the
+// charge fails. Users whose subscription lapsed renew it by choosing the
+// same plan again, which starts a new period.
+func (server *Server) ChangeSubscription(ctx *gin.Context) {
+	var req ChangeSubscriptionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+
+	userResult, err := server.grpc.GetUser(ctx, &pb.GetUserRequest{ID: authPayload.UserID})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.NotFound {
+				ctx.JSON(http.StatusNotFound, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	user := userResult.GetUser()
+
+	if user.GetTeamId() != 0 {
+		ctx.JSON(http.StatusConflict, errorResponse(errors.New("your subscription is managed by your team")))
+		return
+	}
+
+	// a lapsed subscription is renewed by choosing its plan again
+	lapsed := user.GetExpiredAt() != nil && user.GetExpiredAt().AsTime().Before(time.Now())
+	if user.GetPlan() == req.PlanID && !user.GetTrial() && !lapsed {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("already subscribed to this plan")))
+		return
+	}
+
+	fromPlan, err := server.grpc.GetPlan(ctx, &pb.GetPlanRequest{Id: user.GetPlan()})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	toPlan, err := server.grpc.GetPlan(ctx, &pb.GetPlanRequest{Id: req.PlanID})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.NotFound {
+				ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("plan does not exist")))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	var expiredAt time.Time
+	if user.GetExpiredAt() != nil {
+		expiredAt = user.GetExpiredAt().AsTime()
+	}
+	from := fromPlan.GetPlan()
+	if user.GetTrial() {
+		// nothing was paid for the trial, so there is nothing to credit
+		from = nil
+	}
+	proration := billing.Prorate(from, toPlan.GetPlan(), expiredAt, time.Now())
+
+	res := PlanChange{
+		FromPlanID: user.GetPlan(),
+		ToPlanID:   req.PlanID,
+		Credit:     proration.Credit,
+		Charge:     proration.Charge,
+		ExpiredAt:  proration.ExpiredAt,
+	}
+	if req.Preview {
+		ctx.JSON(http.StatusOK, res)
+		return
+	}
+
+	data := saga.Data{
+		"description":     fmt.Sprintf("change to %s plan", toPlan.GetPlan().GetName()),
+		"idempotency_key": fmt.Sprintf("plan-change:%d:%d:%d", user.GetID(), req.PlanID, expiredAt.Unix()),
+	}
+	data.SetInt32("user_id", user.GetID())
+	data.SetInt32("from_plan_id", user.GetPlan())
+	data.SetInt32("to_plan_id", req.PlanID)
+	data.SetInt64("charge", int64(proration.Charge))
+	setSagaTime(data, "expired_at", proration.ExpiredAt)
+	if user.GetExpiredAt() != nil {
+		setSagaTime(data, "previous_expired_at", &expiredAt)
+	}
+
+	if err := server.sagas.Run(ctx, server.changePlanSaga(), data); err != nil {
+		if errors.Is(err, payments.ErrDeclined) {
+			ctx.JSON(http.StatusPaymentRequired, errorResponse(err))
+			return
+		}
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.InvalidArgument {
+				ctx.JSON(http.StatusBadRequest, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	res.ChargeID = data["charge_id"]
+	res.Applied = true
+
+	_, err = server.grpc.CreatePlanChange(ctx, &pb.CreatePlanChangeRequest{
+		UserId:     user.GetID(),
+		FromPlanId: user.GetPlan(),
+		ToPlanId:   req.PlanID,
+		Credit:     proration.Credit,
+		Charge:     proration.Charge,
+		ChargeId:   res.ChargeID,
+		ExpiredAt:  sagaTime(data, "expired_at"),
+	})
+	if err != nil {
+		log.Printf("failed to record plan change of user %d: %v", user.GetID(), err)
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+type ListPlanChangesRequest struct {
+	Offset int32 `json:"offset"`
+	Limit  int32 `json:"limit"`
+}
+
+type ListPlanChangesResponse struct {
+	Changes []PlanChange `json:"changes"`
+}
+
+func (server *Server) ListPlanChanges(ctx *gin.Context) {
+	var req ListPlanChangesRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+
+	grpcReq := pb.ListPlanChangesRequest{
+		UserId: authPayload.UserID,
+		Offset: req.Offset,
+		Limit:  req.Limit,
+	}
+
+	result, err := server.grpc.ListPlanChanges(ctx, &grpcReq)
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.NotFound {
+				ctx.JSON(http.StatusNotFound, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	rows := result.GetChanges()
+	changes := make([]PlanChange, len(rows))
+	for i, row := range rows {
+		createdAt := row.GetCreatedAt().AsTime()
+		changes[i] = PlanChange{
+			FromPlanID: row.GetFromPlanId(),
+			ToPlanID:   row.GetToPlanId(),
+			Credit:     row.GetCredit(),
+			Charge:     row.GetCharge(),
+			ChargeID:   row.GetChargeId(),
+			CreatedAt:  &createdAt,
+			Applied:    true,
+		}
+		if row.GetExpiredAt() != nil {
+			expiredAt := row.GetExpiredAt().AsTime()
+			changes[i].ExpiredAt = &expiredAt
+		}
+	}
+
+	ctx.JSON(http.StatusOK, ListPlanChangesResponse{
+		Changes: changes,
+	})
+}
+
+type AdminChangePlanRequest struct {
+	UserID    int32      `json:"user_id" binding:"required,min=1"`
+	PlanID    int32      `json:"plan_id" binding:"min=0"`
+	ExpiredAt *time.Time `json:"expired_at"`
+}
+
+// AdminChangePlan lets administrators put a user on any plan, e.g. for
+// support credits, without going through payment.
+func (server *Server) AdminChangePlan(ctx *gin.Context) {
+	var req AdminChangePlanRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	planResult, err := server.grpc.GetPlan(ctx, &pb.GetPlanRequest{Id: req.PlanID})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.NotFound {
+				ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("plan does not exist")))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	grpcReq := pb.ChangePlanRequest{
+		UserId:    req.UserID,
+		PlanId:    req.PlanID,
+		ExpiredAt: planExpiry(planResult.GetPlan(), time.Now()),
+	}
+	if req.ExpiredAt != nil {
+		grpcReq.ExpiredAt = timestamppb.New(*req.ExpiredAt)
+	}
+
+	result, err := server.grpc.ChangePlan(ctx, &grpcReq)
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.NotFound {
+				ctx.JSON(http.StatusNotFound, errorResponse(apiErr.Err()))
+				return
+			}
+			if apiErr.Code() == codes.InvalidArgument {
+				ctx.JSON(http.StatusBadRequest, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newUserResponse(result.GetUser()))
+}
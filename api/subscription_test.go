@@ -0,0 +1,394 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/machearn/galaxy_controller/payments"
+	"github.com/machearn/galaxy_controller/pb"
+	mockpb "github.com/machearn/galaxy_controller/pb/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestChangeSubscriptionAPIFreePlan(t *testing.T) {
+	data, err := json.Marshal(gin.H{
+		"plan_id": 0,
+	})
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Eq(&pb.GetUserRequest{ID: 1})).Return(&pb.GetUserResponse{User: &pb.User{ID: 1, Plan: 1}}, nil)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 1})).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 1, Name: "basic"}}, nil)
+	expectSagas(grpc)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 0})).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 0, Name: "free"}}, nil)
+	grpc.EXPECT().ChangePlan(gomock.Any(), gomock.Eq(&pb.ChangePlanRequest{UserId: 1, PlanId: 0})).Return(&pb.UpdateUserResponse{User: &pb.User{ID: 1, Plan: 0}}, nil)
+	grpc.EXPECT().CreatePlanChange(gomock.Any(), gomock.Eq(&pb.CreatePlanChangeRequest{UserId: 1, FromPlanId: 1, ToPlanId: 0})).Return(&pb.Empty{}, nil)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/subscription/change", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var res PlanChange
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+	require.True(t, res.Applied)
+	require.Zero(t, res.Charge)
+	require.Nil(t, res.ExpiredAt)
+}
+
+func TestChangeSubscriptionAPIPreview(t *testing.T) {
+	data, err := json.Marshal(gin.H{
+		"plan_id": 3,
+		"preview": true,
+	})
+	require.NoError(t, err)
+
+	// half of a 30 day basic period is left, worth half of its price
+	expired := time.Now().Add(time.Hour * 24 * 15)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Eq(&pb.GetUserRequest{ID: 1})).Return(&pb.GetUserResponse{User: &pb.User{ID: 1, Plan: 2, ExpiredAt: timestamppb.New(expired)}}, nil)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 2})).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 2, Name: "basic", Price: 400, DurationDays: 30}}, nil)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 3})).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 3, Name: "pro", Price: 1000, DurationDays: 30}}, nil)
+	grpc.EXPECT().ChangePlan(gomock.Any(), gomock.Any()).Times(0)
+	grpc.EXPECT().CreatePlanChange(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	provider := payments.NewFakeProvider()
+	server.payments = provider
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/subscription/change", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Empty(t, provider.Charges())
+
+	var res PlanChange
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+	require.False(t, res.Applied)
+	require.InDelta(t, 200, res.Credit, 1)
+	require.Equal(t, int32(1000)-res.Credit, res.Charge)
+	require.NotNil(t, res.ExpiredAt)
+	require.WithinDuration(t, time.Now().Add(time.Hour*24*30), *res.ExpiredAt, time.Minute)
+}
+
+func TestChangeSubscriptionAPIPaidPlan(t *testing.T) {
+	data, err := json.Marshal(gin.H{
+		"plan_id": 3,
+	})
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Eq(&pb.GetUserRequest{ID: 1})).Return(&pb.GetUserResponse{User: &pb.User{ID: 1, Plan: 0}}, nil)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 0})).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 0, Name: "free"}}, nil)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 3})).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 3, Name: "pro", Price: 999, DurationDays: 30}}, nil)
+	expectSagas(grpc)
+	grpc.EXPECT().ChangePlan(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, req *pb.ChangePlanRequest, _ ...any) (*pb.UpdateUserResponse, error) {
+			require.Equal(t, int32(1), req.GetUserId())
+			require.Equal(t, int32(3), req.GetPlanId())
+			require.WithinDuration(t, time.Now().Add(time.Hour*24*30), req.GetExpiredAt().AsTime(), time.Minute)
+			return &pb.UpdateUserResponse{User: &pb.User{ID: 1, Plan: 3, ExpiredAt: req.GetExpiredAt()}}, nil
+		})
+	grpc.EXPECT().CreatePlanChange(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, req *pb.CreatePlanChangeRequest, _ ...any) (*pb.Empty, error) {
+			require.Equal(t, int32(999), req.GetCharge())
+			require.Equal(t, "pi_fake_1", req.GetChargeId())
+			return &pb.Empty{}, nil
+		})
+
+	server := NewTestServer(t, grpc)
+	provider := payments.NewFakeProvider()
+	server.payments = provider
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/subscription/change", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	requests := provider.Charges()
+	require.Len(t, requests, 1)
+	require.Equal(t, int64(999), requests[0].Amount)
+
+	var res PlanChange
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+	require.True(t, res.Applied)
+	require.Equal(t, "pi_fake_1", res.ChargeID)
+}
+
+func TestChangeSubscriptionAPIRenewLapsed(t *testing.T) {
+	data, err := json.Marshal(gin.H{
+		"plan_id": 3,
+	})
+	require.NoError(t, err)
+
+	lapsed := time.Now().Add(-time.Hour * 24)
+	pro := &pb.Plan{ID: 3, Name: "pro", Price: 999, DurationDays: 30}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Eq(&pb.GetUserRequest{ID: 1})).Return(&pb.GetUserResponse{
+		User: &pb.User{ID: 1, Plan: 3, ExpiredAt: timestamppb.New(lapsed)},
+	}, nil)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 3})).Return(&pb.GetPlanResponse{Plan: pro}, nil).Times(2)
+	expectSagas(grpc)
+	grpc.EXPECT().ChangePlan(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, req *pb.ChangePlanRequest, _ ...any) (*pb.UpdateUserResponse, error) {
+			require.Equal(t, int32(3), req.GetPlanId())
+			require.WithinDuration(t, time.Now().Add(time.Hour*24*30), req.GetExpiredAt().AsTime(), time.Minute)
+			return &pb.UpdateUserResponse{User: &pb.User{ID: 1, Plan: 3, ExpiredAt: req.GetExpiredAt()}}, nil
+		})
+	grpc.EXPECT().CreatePlanChange(gomock.Any(), gomock.Any()).Return(&pb.Empty{}, nil)
+
+	server := NewTestServer(t, grpc)
+	provider := payments.NewFakeProvider()
+	server.payments = provider
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/subscription/change", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	// nothing is left of the lapsed period, so the whole price is charged
+	requests := provider.Charges()
+	require.Len(t, requests, 1)
+	require.Equal(t, int64(999), requests[0].Amount)
+}
+
+func TestChangeSubscriptionAPISamePlan(t *testing.T) {
+	data, err := json.Marshal(gin.H{
+		"plan_id": 3,
+	})
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Eq(&pb.GetUserRequest{ID: 1})).Return(&pb.GetUserResponse{
+		User: &pb.User{ID: 1, Plan: 3, ExpiredAt: timestamppb.New(time.Now().Add(time.Hour * 24))},
+	}, nil)
+	grpc.EXPECT().ChangePlan(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/subscription/change", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestChangeSubscriptionAPIDeclined(t *testing.T) {
+	data, err := json.Marshal(gin.H{
+		"plan_id": 3,
+	})
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Eq(&pb.GetUserRequest{ID: 1})).Return(&pb.GetUserResponse{User: &pb.User{ID: 1, Plan: 0}}, nil)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 0})).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 0, Name: "free"}}, nil)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 3})).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 3, Name: "pro", Price: 999, DurationDays: 30}}, nil)
+	expectSagas(grpc)
+	gomock.InOrder(
+		grpc.EXPECT().ChangePlan(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, req *pb.ChangePlanRequest, _ ...any) (*pb.UpdateUserResponse, error) {
+				require.Equal(t, int32(3), req.GetPlanId())
+				return &pb.UpdateUserResponse{User: &pb.User{ID: 1, Plan: 3, ExpiredAt: req.GetExpiredAt()}}, nil
+			}),
+		// the declined charge puts the user back on the free plan
+		grpc.EXPECT().ChangePlan(gomock.Any(), gomock.Eq(&pb.ChangePlanRequest{UserId: 1, PlanId: 0})).
+			Return(&pb.UpdateUserResponse{User: &pb.User{ID: 1, Plan: 0}}, nil),
+	)
+	grpc.EXPECT().CreatePlanChange(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	provider := payments.NewFakeProvider()
+	provider.DeclineUser(1)
+	server.payments = provider
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/subscription/change", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusPaymentRequired, recorder.Code)
+}
+
+func TestChangeSubscriptionAPIChangeFailed(t *testing.T) {
+	data, err := json.Marshal(gin.H{
+		"plan_id": 3,
+	})
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Eq(&pb.GetUserRequest{ID: 1})).Return(&pb.GetUserResponse{User: &pb.User{ID: 1, Plan: 0}}, nil)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 0})).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 0, Name: "free"}}, nil)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 3})).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 3, Name: "pro", Price: 999, DurationDays: 30}}, nil)
+	expectSagas(grpc)
+	grpc.EXPECT().ChangePlan(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Internal, "internal error"))
+	grpc.EXPECT().CreatePlanChange(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	provider := payments.NewFakeProvider()
+	server.payments = provider
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/subscription/change", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusInternalServerError, recorder.Code)
+	require.Empty(t, provider.Charges())
+}
+
+func TestListPlanChangesAPI(t *testing.T) {
+	data, err := json.Marshal(gin.H{
+		"offset": 0,
+		"limit":  10,
+	})
+	require.NoError(t, err)
+
+	created := time.Now().UTC().Truncate(time.Second)
+	expired := created.Add(time.Hour * 24 * 30)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().ListPlanChanges(gomock.Any(), gomock.Eq(&pb.ListPlanChangesRequest{UserId: 1, Offset: 0, Limit: 10})).
+		Return(&pb.ListPlanChangesResponse{Changes: []*pb.PlanChange{{
+			ID:         1,
+			UserId:     1,
+			FromPlanId: 0,
+			ToPlanId:   3,
+			Charge:     999,
+			ChargeId:   "pi_fake_1",
+			ExpiredAt:  timestamppb.New(expired),
+			CreatedAt:  timestamppb.New(created),
+		}}}, nil)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/subscription/history", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var res ListPlanChangesResponse
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+	require.Len(t, res.Changes, 1)
+	require.Equal(t, int32(3), res.Changes[0].ToPlanID)
+	require.Equal(t, expired, *res.Changes[0].ExpiredAt)
+}
+
+func TestAdminChangePlanAPI(t *testing.T) {
+	expired := time.Now().UTC().Truncate(time.Second).Add(time.Hour * 24 * 90)
+	data, err := json.Marshal(gin.H{
+		"user_id":    2,
+		"plan_id":    3,
+		"expired_at": expired,
+	})
+	require.NoError(t, err)
+
+	grpcReq := pb.ChangePlanRequest{
+		UserId:    2,
+		PlanId:    3,
+		ExpiredAt: timestamppb.New(expired),
+	}
+	grpcRes := pb.UpdateUserResponse{
+		User: &pb.User{ID: 2, Plan: 3, ExpiredAt: timestamppb.New(expired)},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_ADMIN)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 3})).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 3, Name: "pro", Price: 999, DurationDays: 30}}, nil)
+	grpc.EXPECT().ChangePlan(gomock.Any(), gomock.Eq(&grpcReq)).Return(&grpcRes, nil)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/admin/user/plan", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var res User
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), res.Plan)
+	require.Equal(t, expired, res.ExpiredAt)
+}
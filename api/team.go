@@ -0,0 +1,519 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/machearn/galaxy_controller/notify"
+	"github.com/machearn/galaxy_controller/payments"
+	"github.com/machearn/galaxy_controller/pb"
+	"github.com/machearn/galaxy_controller/saga"
+	"github.com/machearn/galaxy_controller/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	teamInvitationPurpose  = "team_invitation"
+	teamInvitationDuration = time.Hour * 24 * 7
+)
+
+var teamRoleNames = map[pb.TeamRole]string{
+	pb.TeamRole_TEAM_ROLE_MEMBER: "member",
+	pb.TeamRole_TEAM_ROLE_ADMIN:  "admin",
+	pb.TeamRole_TEAM_ROLE_OWNER:  "owner",
+}
+
+// teamRoles are the roles that can be given to members. There is exactly one
+// owner per team, the user who created it.
+var teamRoles = map[string]pb.TeamRole{
+	"member": pb.TeamRole_TEAM_ROLE_MEMBER,
+	"admin":  pb.TeamRole_TEAM_ROLE_ADMIN,
+}
+
+type Team struct {
+	ID        int32        `json:"id"`
+	Name      string       `json:"name"`
+	OwnerID   int32        `json:"owner_id"`
+	Plan      int32        `json:"plan"`
+	Seats     int32        `json:"seats"`
+	ExpiredAt *time.Time   `json:"expired_at"`
+	CreatedAt time.Time    `json:"created_at"`
+	Members   []TeamMember `json:"members,omitempty"`
+}
+
+type TeamMember struct {
+	UserID   int32     `json:"user_id"`
+	Username string    `json:"username"`
+	Email    string    `json:"email"`
+	Role     string    `json:"role"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+type TeamInvitation struct {
+	ID        int32     `json:"id"`
+	TeamID    int32     `json:"team_id"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	ExpiredAt time.Time `json:"expired_at"`
+}
+
+func newTeamResponse(team *pb.Team, members []*pb.TeamMember) Team {
+	res := Team{
+		ID:        team.GetID(),
+		Name:      team.GetName(),
+		OwnerID:   team.GetOwnerId(),
+		Plan:      team.GetPlan(),
+		Seats:     team.GetSeats(),
+		CreatedAt: team.GetCreatedAt().AsTime(),
+	}
+	if team.GetExpiredAt() != nil {
+		expiredAt := team.GetExpiredAt().AsTime()
+		res.ExpiredAt = &expiredAt
+	}
+	for _, member := range members {
+		res.Members = append(res.Members, newTeamMemberResponse(member))
+	}
+	return res
+}
+
+func newTeamMemberResponse(member *pb.TeamMember) TeamMember {
+	return TeamMember{
+		UserID:   member.GetUserId(),
+		Username: member.GetUsername(),
+		Email:    member.GetEmail(),
+		Role:     teamRoleNames[member.GetRole()],
+		JoinedAt: member.GetJoinedAt().AsTime(),
+	}
+}
+
+// subscriptionPlan returns the plan the user's subscription is on. Members
+// of a team share the team's plan instead of their own.
+func (server *Server) subscriptionPlan(ctx context.Context, user *pb.User) (int32, error) {
+	if user.GetTeamId() == 0 {
+		return user.GetPlan(), nil
+	}
+
+	result, err := server.grpc.GetTeam(ctx, &pb.GetTeamRequest{ID: user.GetTeamId()})
+	if err != nil {
+		return 0, err
+	}
+	return result.GetTeam().GetPlan(), nil
+}
+
+// loadTeam returns the authenticated user's team, its members and the
+// user's own membership. It writes the response and returns false if the
+// user does not belong to a team.
+func (server *Server) loadTeam(ctx *gin.Context) (*pb.Team, []*pb.TeamMember, *pb.TeamMember, bool) {
+	authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+
+	userResult, err := server.grpc.GetUser(ctx, &pb.GetUserRequest{ID: authPayload.UserID})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return nil, nil, nil, false
+	}
+	if userResult.GetUser().GetTeamId() == 0 {
+		ctx.JSON(http.StatusNotFound, errorResponse(errors.New("you do not belong to a team")))
+		return nil, nil, nil, false
+	}
+
+	teamResult, err := server.grpc.GetTeam(ctx, &pb.GetTeamRequest{ID: userResult.GetUser().GetTeamId()})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return nil, nil, nil, false
+	}
+
+	membersResult, err := server.grpc.ListTeamMembers(ctx, &pb.ListTeamMembersRequest{TeamId: teamResult.GetTeam().GetID()})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return nil, nil, nil, false
+	}
+
+	members := membersResult.GetMembers()
+	self := findTeamMember(members, authPayload.UserID)
+	if self == nil {
+		ctx.JSON(http.StatusNotFound, errorResponse(errors.New("you do not belong to a team")))
+		return nil, nil, nil, false
+	}
+	return teamResult.GetTeam(), members, self, true
+}
+
+func findTeamMember(members []*pb.TeamMember, userID int32) *pb.TeamMember {
+	for _, member := range members {
+		if member.GetUserId() == userID {
+			return member
+		}
+	}
+	return nil
+}
+
+func canManageTeam(member *pb.TeamMember) bool {
+	return member.GetRole() == pb.TeamRole_TEAM_ROLE_ADMIN || member.GetRole() == pb.TeamRole_TEAM_ROLE_OWNER
+}
+
+type CreateTeamRequest struct {
+	Name   string `json:"name" binding:"required"`
+	PlanID int32  `json:"plan_id" binding:"min=0"`
+	Seats  int32  `json:"seats" binding:"required,min=1,max=1000"`
+}
+
+// CreateTeam creates a team owned by the authenticated user. The owner pays
+// for every seat of the first period up front and is refunded if the team
+// cannot be created.
+func (server *Server) CreateTeam(ctx *gin.Context) {
+	var req CreateTeamRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+
+	userResult, err := server.grpc.GetUser(ctx, &pb.GetUserRequest{ID: authPayload.UserID})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if userResult.GetUser().GetTeamId() != 0 {
+		ctx.JSON(http.StatusConflict, errorResponse(errors.New("you already belong to a team")))
+		return
+	}
+
+	planResult, err := server.grpc.GetPlan(ctx, &pb.GetPlanRequest{Id: req.PlanID})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.NotFound {
+				ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("plan does not exist")))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	plan := planResult.GetPlan()
+
+	// a refunded charge must not be returned again when the owner retries,
+	// so every attempt gets its own key
+	data := saga.Data{
+		"name":            req.Name,
+		"description":     fmt.Sprintf("%s plan for %d seats", plan.GetName(), req.Seats),
+		"idempotency_key": fmt.Sprintf("team:%d:%s", authPayload.UserID, util.GetRandomString(16)),
+	}
+	data.SetInt32("user_id", authPayload.UserID)
+	data.SetInt32("plan_id", req.PlanID)
+	data.SetInt32("seats", req.Seats)
+	data.SetInt64("charge", int64(plan.GetPrice())*int64(req.Seats))
+	if expiredAt := planExpiry(plan, time.Now()); expiredAt != nil {
+		end := expiredAt.AsTime()
+		setSagaTime(data, "expired_at", &end)
+	}
+
+	var res teamSagaResult
+	if err := server.sagas.Run(ctx, server.createTeamSaga(&res), data); err != nil {
+		if errors.Is(err, payments.ErrDeclined) {
+			ctx.JSON(http.StatusPaymentRequired, errorResponse(err))
+			return
+		}
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.InvalidArgument {
+				ctx.JSON(http.StatusBadRequest, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newTeamResponse(res.team, nil))
+}
+
+func (server *Server) GetTeam(ctx *gin.Context) {
+	team, members, _, ok := server.loadTeam(ctx)
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newTeamResponse(team, members))
+}
+
+type InviteTeamMemberRequest struct {
+	Email string `json:"email" binding:"required,email"`
+	Role  string `json:"role"`
+}
+
+type invitationClaims struct {
+	Purpose      string    `json:"purpose"`
+	InvitationID int32     `json:"invitation_id"`
+	ExpiredAt    time.Time `json:"expired_at"`
+}
+
+// InviteTeamMember emails an invitation to join the team. Only team admins
+// and the owner can invite, and only while the team has a free seat.
+func (server *Server) InviteTeamMember(ctx *gin.Context) {
+	var req InviteTeamMemberRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if len(req.Role) == 0 {
+		req.Role = "member"
+	}
+	role, ok := teamRoles[req.Role]
+	if !ok {
+		ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("unknown team role %q", req.Role)))
+		return
+	}
+
+	team, members, self, ok := server.loadTeam(ctx)
+	if !ok {
+		return
+	}
+	if !canManageTeam(self) {
+		ctx.JSON(http.StatusForbidden, errorResponse(errors.New("only team admins can invite members")))
+		return
+	}
+	if int32(len(members)) >= team.GetSeats() {
+		ctx.JSON(http.StatusConflict, errorResponse(fmt.Errorf("all %d seats of the team are taken", team.GetSeats())))
+		return
+	}
+
+	expiredAt := time.Now().Add(teamInvitationDuration)
+	result, err := server.grpc.CreateTeamInvitation(ctx, &pb.CreateTeamInvitationRequest{
+		TeamId:    team.GetID(),
+		Email:     util.NormalizeEmail(req.Email),
+		Role:      role,
+		InvitedBy: self.GetUserId(),
+		ExpiredAt: timestamppb.New(expiredAt),
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	invitation := result.GetInvitation()
+
+	token, err := util.SignToken(server.config.TokenSymmetricKey, invitationClaims{
+		Purpose:      teamInvitationPurpose,
+		InvitationID: invitation.GetID(),
+		ExpiredAt:    expiredAt,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	msg := notify.Message{
+		To:      req.Email,
+		Subject: fmt.Sprintf("You have been invited to join %s on Galaxy", team.GetName()),
+		Body: fmt.Sprintf(
+			"%s invited you to join the team %s.\n\nSign in to Galaxy with this email address and use the code below to join before %s:\n%s\n",
+			self.GetUsername(),
+			team.GetName(),
+			expiredAt.Format(time.RFC1123),
+			token,
+		),
+	}
+	if err := server.notifier.Notify(ctx, msg); err != nil {
+		log.Printf("failed to send team invitation %d: %v", invitation.GetID(), err)
+	}
+
+	ctx.JSON(http.StatusOK, TeamInvitation{
+		ID:        invitation.GetID(),
+		TeamID:    invitation.GetTeamId(),
+		Email:     invitation.GetEmail(),
+		Role:      teamRoleNames[invitation.GetRole()],
+		ExpiredAt: invitation.GetExpiredAt().AsTime(),
+	})
+}
+
+type JoinTeamRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// JoinTeam accepts an invitation for the authenticated user, who must be
+// signed in with the email address the invitation was sent to.
+func (server *Server) JoinTeam(ctx *gin.Context) {
+	var req JoinTeamRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var claims invitationClaims
+	if err := util.VerifyToken(server.config.TokenSymmetricKey, req.Token, &claims); err != nil || claims.Purpose != teamInvitationPurpose {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(errors.New("invitation is invalid")))
+		return
+	}
+	if time.Now().After(claims.ExpiredAt) {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(errors.New("invitation has expired")))
+		return
+	}
+
+	authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+
+	invitationResult, err := server.grpc.GetTeamInvitation(ctx, &pb.GetTeamInvitationRequest{ID: claims.InvitationID})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.NotFound {
+				ctx.JSON(http.StatusNotFound, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	invitation := invitationResult.GetInvitation()
+	if invitation.GetAcceptedAt() != nil {
+		ctx.JSON(http.StatusConflict, errorResponse(errors.New("invitation has already been used")))
+		return
+	}
+
+	userResult, err := server.grpc.GetUser(ctx, &pb.GetUserRequest{ID: authPayload.UserID})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	user := userResult.GetUser()
+	if util.NormalizeEmail(user.GetEmail()) != invitation.GetEmail() {
+		ctx.JSON(http.StatusForbidden, errorResponse(errors.New("invitation was sent to a different email address")))
+		return
+	}
+	if user.GetTeamId() != 0 {
+		ctx.JSON(http.StatusConflict, errorResponse(errors.New("you already belong to a team")))
+		return
+	}
+
+	memberResult, err := server.grpc.AddTeamMember(ctx, &pb.AddTeamMemberRequest{
+		TeamId: invitation.GetTeamId(),
+		UserId: user.GetID(),
+		Role:   invitation.GetRole(),
+	})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.FailedPrecondition {
+				ctx.JSON(http.StatusConflict, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	_, err = server.grpc.AcceptTeamInvitation(ctx, &pb.AcceptTeamInvitationRequest{ID: invitation.GetID(), UserId: user.GetID()})
+	if err != nil {
+		log.Printf("failed to mark team invitation %d as accepted: %v", invitation.GetID(), err)
+	}
+
+	ctx.JSON(http.StatusOK, newTeamMemberResponse(memberResult.GetMember()))
+}
+
+type UpdateTeamMemberRequest struct {
+	UserID int32  `json:"user_id" binding:"required,min=1"`
+	Role   string `json:"role" binding:"required"`
+}
+
+// UpdateTeamMember changes the role of a member. Only the owner can promote
+// or demote members.
+func (server *Server) UpdateTeamMember(ctx *gin.Context) {
+	var req UpdateTeamMemberRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	role, ok := teamRoles[req.Role]
+	if !ok {
+		ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("unknown team role %q", req.Role)))
+		return
+	}
+
+	team, members, self, ok := server.loadTeam(ctx)
+	if !ok {
+		return
+	}
+	if self.GetRole() != pb.TeamRole_TEAM_ROLE_OWNER {
+		ctx.JSON(http.StatusForbidden, errorResponse(errors.New("only the team owner can change roles")))
+		return
+	}
+
+	member := findTeamMember(members, req.UserID)
+	if member == nil {
+		ctx.JSON(http.StatusNotFound, errorResponse(errors.New("user is not a member of the team")))
+		return
+	}
+	if member.GetRole() == pb.TeamRole_TEAM_ROLE_OWNER {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("the owner's role cannot be changed")))
+		return
+	}
+
+	result, err := server.grpc.UpdateTeamMember(ctx, &pb.UpdateTeamMemberRequest{
+		TeamId: team.GetID(),
+		UserId: req.UserID,
+		Role:   role,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newTeamMemberResponse(result.GetMember()))
+}
+
+type RemoveTeamMemberRequest struct {
+	UserID int32 `json:"user_id" binding:"required,min=1"`
+}
+
+// RemoveTeamMember removes a member from the team, freeing their seat.
+// Members can leave on their own, admins can remove members and the owner
+// can remove anyone but themselves.
+func (server *Server) RemoveTeamMember(ctx *gin.Context) {
+	var req RemoveTeamMemberRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	team, members, self, ok := server.loadTeam(ctx)
+	if !ok {
+		return
+	}
+
+	member := findTeamMember(members, req.UserID)
+	if member == nil {
+		ctx.JSON(http.StatusNotFound, errorResponse(errors.New("user is not a member of the team")))
+		return
+	}
+	if member.GetRole() == pb.TeamRole_TEAM_ROLE_OWNER {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("the team owner cannot be removed")))
+		return
+	}
+	if member.GetUserId() != self.GetUserId() && member.GetRole() >= self.GetRole() {
+		ctx.JSON(http.StatusForbidden, errorResponse(errors.New("you are not allowed to remove this member")))
+		return
+	}
+
+	_, err := server.grpc.RemoveTeamMember(ctx, &pb.RemoveTeamMemberRequest{
+		TeamId: team.GetID(),
+		UserId: req.UserID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
@@ -0,0 +1,391 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/machearn/galaxy_controller/payments"
+	"github.com/machearn/galaxy_controller/pb"
+	mockpb "github.com/machearn/galaxy_controller/pb/mock"
+	"github.com/machearn/galaxy_controller/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type teamFixture struct {
+	team    *pb.Team
+	members []*pb.TeamMember
+}
+
+func newTeamFixture() teamFixture {
+	return teamFixture{
+		team: &pb.Team{ID: 7, Name: "rockets", OwnerId: 1, Plan: 3, Seats: 3, CreatedAt: timestamppb.Now()},
+		members: []*pb.TeamMember{
+			{TeamId: 7, UserId: 1, Username: "owner", Role: pb.TeamRole_TEAM_ROLE_OWNER},
+			{TeamId: 7, UserId: 2, Username: "admin", Role: pb.TeamRole_TEAM_ROLE_ADMIN},
+		},
+	}
+}
+
+func (f teamFixture) expectLoad(grpc *mockpb.MockGalaxyClient, userID int32) {
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Eq(&pb.GetUserRequest{ID: userID})).Return(&pb.GetUserResponse{User: &pb.User{ID: userID, TeamId: 7}}, nil)
+	grpc.EXPECT().GetTeam(gomock.Any(), gomock.Eq(&pb.GetTeamRequest{ID: 7})).Return(&pb.TeamResponse{Team: f.team}, nil)
+	grpc.EXPECT().ListTeamMembers(gomock.Any(), gomock.Eq(&pb.ListTeamMembersRequest{TeamId: 7})).Return(&pb.ListTeamMembersResponse{Members: f.members}, nil)
+}
+
+func TestCreateTeamAPI(t *testing.T) {
+	data, err := json.Marshal(gin.H{
+		"name":    "rockets",
+		"plan_id": 3,
+		"seats":   5,
+	})
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Eq(&pb.GetUserRequest{ID: 1})).Return(&pb.GetUserResponse{User: &pb.User{ID: 1}}, nil)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 3})).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 3, Name: "pro", Price: 999, DurationDays: 30}}, nil)
+	expectSagas(grpc)
+	grpc.EXPECT().CreateTeam(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, req *pb.CreateTeamRequest, _ ...any) (*pb.TeamResponse, error) {
+			require.Equal(t, int32(1), req.GetOwnerId())
+			require.Equal(t, int32(5), req.GetSeats())
+			require.WithinDuration(t, time.Now().AddDate(0, 0, 30), req.GetExpiredAt().AsTime(), time.Minute)
+			return &pb.TeamResponse{Team: &pb.Team{ID: 7, Name: req.GetName(), OwnerId: 1, Plan: 3, Seats: 5, ExpiredAt: req.GetExpiredAt()}}, nil
+		})
+
+	server := NewTestServer(t, grpc)
+	provider := payments.NewFakeProvider()
+	server.payments = provider
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/team/create", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	requests := provider.Charges()
+	require.Len(t, requests, 1)
+	require.Equal(t, int64(999*5), requests[0].Amount)
+
+	var res Team
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+	require.Equal(t, int32(7), res.ID)
+	require.Equal(t, int32(5), res.Seats)
+}
+
+func TestCreateTeamAPITooManySeats(t *testing.T) {
+	data, err := json.Marshal(gin.H{
+		"name":    "rockets",
+		"plan_id": 3,
+		"seats":   1000000,
+	})
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().CreateTeam(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	provider := payments.NewFakeProvider()
+	server.payments = provider
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/team/create", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	require.Empty(t, provider.Charges())
+}
+
+func TestCreateTeamAPILargeTotal(t *testing.T) {
+	data, err := json.Marshal(gin.H{
+		"name":    "rockets",
+		"plan_id": 3,
+		"seats":   1000,
+	})
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Eq(&pb.GetUserRequest{ID: 1})).Return(&pb.GetUserResponse{User: &pb.User{ID: 1}}, nil)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 3})).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 3, Name: "enterprise", Price: 5000000, DurationDays: 30}}, nil)
+	expectSagas(grpc)
+	grpc.EXPECT().CreateTeam(gomock.Any(), gomock.Any()).Return(&pb.TeamResponse{Team: &pb.Team{ID: 7, Name: "rockets", OwnerId: 1, Plan: 3, Seats: 1000}}, nil)
+
+	server := NewTestServer(t, grpc)
+	provider := payments.NewFakeProvider()
+	server.payments = provider
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/team/create", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	// 5000000 * 1000 does not fit in an int32
+	requests := provider.Charges()
+	require.Len(t, requests, 1)
+	require.Equal(t, int64(5000000000), requests[0].Amount)
+}
+
+func TestCreateTeamAPICreateFailed(t *testing.T) {
+	data, err := json.Marshal(gin.H{
+		"name":    "rockets",
+		"plan_id": 3,
+		"seats":   5,
+	})
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Eq(&pb.GetUserRequest{ID: 1})).Return(&pb.GetUserResponse{User: &pb.User{ID: 1}}, nil)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 3})).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 3, Name: "pro", Price: 999, DurationDays: 30}}, nil)
+	expectSagas(grpc)
+	grpc.EXPECT().CreateTeam(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Unavailable, "backend unavailable"))
+
+	server := NewTestServer(t, grpc)
+	provider := payments.NewFakeProvider()
+	server.payments = provider
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/team/create", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusInternalServerError, recorder.Code)
+	require.Len(t, provider.Charges(), 1)
+
+	// the owner got the whole charge back
+	_, err = provider.Refund(context.Background(), "pi_fake_1", 0)
+	require.ErrorIs(t, err, payments.ErrInvalidState)
+}
+
+func TestInviteTeamMemberAPI(t *testing.T) {
+	data, err := json.Marshal(gin.H{
+		"email": "New@Example.com",
+	})
+	require.NoError(t, err)
+
+	fixture := newTeamFixture()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 2, pb.Role_ROLE_MEMBER)
+	fixture.expectLoad(grpc, 2)
+	grpc.EXPECT().CreateTeamInvitation(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, req *pb.CreateTeamInvitationRequest, _ ...any) (*pb.TeamInvitationResponse, error) {
+			require.Equal(t, "new@example.com", req.GetEmail())
+			require.Equal(t, pb.TeamRole_TEAM_ROLE_MEMBER, req.GetRole())
+			require.Equal(t, int32(2), req.GetInvitedBy())
+			return &pb.TeamInvitationResponse{Invitation: &pb.TeamInvitation{
+				ID:        5,
+				TeamId:    7,
+				Email:     req.GetEmail(),
+				Role:      req.GetRole(),
+				ExpiredAt: req.GetExpiredAt(),
+			}}, nil
+		})
+
+	server := NewTestServer(t, grpc)
+	notifier := &recordingNotifier{}
+	server.notifier = notifier
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/team/invite", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Len(t, notifier.messages, 1)
+	require.Equal(t, "New@Example.com", notifier.messages[0].To)
+	require.Contains(t, notifier.messages[0].Body, "rockets")
+}
+
+func TestInviteTeamMemberAPINoSeats(t *testing.T) {
+	data, err := json.Marshal(gin.H{
+		"email": "new@example.com",
+	})
+	require.NoError(t, err)
+
+	fixture := newTeamFixture()
+	fixture.team.Seats = 2
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	fixture.expectLoad(grpc, 1)
+	grpc.EXPECT().CreateTeamInvitation(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/team/invite", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusConflict, recorder.Code)
+}
+
+func signTestInvitation(t *testing.T, server *Server, invitationID int32) string {
+	token, err := util.SignToken(server.config.TokenSymmetricKey, invitationClaims{
+		Purpose:      teamInvitationPurpose,
+		InvitationID: invitationID,
+		ExpiredAt:    time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	return token
+}
+
+func TestJoinTeamAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 3, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().GetTeamInvitation(gomock.Any(), gomock.Eq(&pb.GetTeamInvitationRequest{ID: 5})).
+		Return(&pb.TeamInvitationResponse{Invitation: &pb.TeamInvitation{ID: 5, TeamId: 7, Email: "new@example.com"}}, nil)
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Eq(&pb.GetUserRequest{ID: 3})).Return(&pb.GetUserResponse{User: &pb.User{ID: 3, Email: "New@example.com"}}, nil)
+	grpc.EXPECT().AddTeamMember(gomock.Any(), gomock.Eq(&pb.AddTeamMemberRequest{TeamId: 7, UserId: 3})).
+		Return(&pb.TeamMemberResponse{Member: &pb.TeamMember{TeamId: 7, UserId: 3, JoinedAt: timestamppb.Now()}}, nil)
+	grpc.EXPECT().AcceptTeamInvitation(gomock.Any(), gomock.Eq(&pb.AcceptTeamInvitationRequest{ID: 5, UserId: 3})).
+		Return(&pb.TeamInvitationResponse{}, nil)
+
+	server := NewTestServer(t, grpc)
+	data, err := json.Marshal(gin.H{
+		"token": signTestInvitation(t, server, 5),
+	})
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/team/join", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var res TeamMember
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), res.UserID)
+	require.Equal(t, "member", res.Role)
+}
+
+func TestJoinTeamAPIWrongEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 3, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().GetTeamInvitation(gomock.Any(), gomock.Any()).
+		Return(&pb.TeamInvitationResponse{Invitation: &pb.TeamInvitation{ID: 5, TeamId: 7, Email: "new@example.com"}}, nil)
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(&pb.GetUserResponse{User: &pb.User{ID: 3, Email: "other@example.com"}}, nil)
+	grpc.EXPECT().AddTeamMember(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	data, err := json.Marshal(gin.H{
+		"token": signTestInvitation(t, server, 5),
+	})
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/team/join", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusForbidden, recorder.Code)
+}
+
+func TestRemoveTeamMemberAPI(t *testing.T) {
+	testCases := []struct {
+		name         string
+		callerID     int32
+		targetID     int32
+		expectRemove bool
+		status       int
+	}{
+		{name: "OwnerRemovesAdmin", callerID: 1, targetID: 2, expectRemove: true, status: http.StatusOK},
+		{name: "AdminLeaves", callerID: 2, targetID: 2, expectRemove: true, status: http.StatusOK},
+		{name: "AdminRemovesOwner", callerID: 2, targetID: 1, status: http.StatusBadRequest},
+		{name: "NotMember", callerID: 1, targetID: 9, status: http.StatusNotFound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(gin.H{
+				"user_id": tc.targetID,
+			})
+			require.NoError(t, err)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			grpc := mockpb.NewMockGalaxyClient(ctrl)
+			token := expectAuthorize(grpc, tc.callerID, pb.Role_ROLE_MEMBER)
+			newTeamFixture().expectLoad(grpc, tc.callerID)
+			if tc.expectRemove {
+				grpc.EXPECT().RemoveTeamMember(gomock.Any(), gomock.Eq(&pb.RemoveTeamMemberRequest{TeamId: 7, UserId: tc.targetID})).Return(&pb.Empty{}, nil)
+			} else {
+				grpc.EXPECT().RemoveTeamMember(gomock.Any(), gomock.Any()).Times(0)
+			}
+
+			server := NewTestServer(t, grpc)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodPost, "/team/member/remove", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthHeader(request, token)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.status, recorder.Code)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/machearn/galaxy_controller/pb"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	tenantHeader = "X-Tenant"
+	// tenantMetadataKey carries the tenant of a request to the backend, which
+	// scopes items and entries by it.
+	tenantMetadataKey = "x-tenant-id"
+)
+
+var (
+	errInvalidTenant = errors.New("X-Tenant header is invalid")
+	errNotInTenant   = errors.New("you are not a member of this tenant")
+)
+
+// resolveTenant picks the tenant a request acts in. Tenants are teams; users
+// outside a team work in the default tenant 0. The X-Tenant header may only
+// name the user's own team, except for admins who can act in any tenant.
+func resolveTenant(header string, auth *pb.AuthResponse) (int32, error) {
+	if len(header) == 0 {
+		return auth.GetTeamId(), nil
+	}
+
+	tenantID, err := strconv.ParseInt(header, 10, 32)
+	if err != nil || tenantID < 0 {
+		return 0, errInvalidTenant
+	}
+	if int32(tenantID) != auth.GetTeamId() && auth.GetRole() != pb.Role_ROLE_ADMIN {
+		return 0, errNotInTenant
+	}
+	return int32(tenantID), nil
+}
+
+// tenantContext returns a context for backend calls scoped to the tenant of
+// the authenticated request.
+func tenantContext(ctx *gin.Context) context.Context {
+	return withTenant(ctx, requestTenant(ctx))
+}
+
+// requestTenant returns the tenant the authenticated request acts in.
+func requestTenant(ctx *gin.Context) int32 {
+	authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+	return authPayload.TenantID
+}
+
+// withTenant scopes the backend calls made with ctx to tenantID. It is used
+// where no request is at hand, such as in sagas.
+func withTenant(ctx context.Context, tenantID int32) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, tenantMetadataKey, strconv.Itoa(int(tenantID)))
+}
+
+// inTenant reports whether a resource belonging to tenantID is visible to
+// the authenticated request. Resources of other tenants are reported as not
+// found rather than forbidden so their existence is not revealed.
+func inTenant(ctx *gin.Context, tenantID int32) bool {
+	return requestTenant(ctx) == tenantID
+}
@@ -0,0 +1,204 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/machearn/galaxy_controller/pb"
+	mockpb "github.com/machearn/galaxy_controller/pb/mock"
+	"github.com/machearn/galaxy_controller/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func expectAuthorizeTeam(grpc *mockpb.MockGalaxyClient, userID int32, teamID int32, role pb.Role) string {
+	token := util.GetRandomString(32)
+	now := time.Now().UTC().Truncate(time.Second)
+	grpc.EXPECT().Authorize(gomock.Any(), gomock.Eq(&pb.AuthRequest{Token: token})).Return(&pb.AuthResponse{
+		ID:        uuid.New().String(),
+		UserId:    userID,
+		CreatedAt: timestamppb.New(now),
+		ExpiredAt: timestamppb.New(now.Add(time.Minute * 15)),
+		Role:      role,
+		TeamId:    teamID,
+	}, nil)
+	return token
+}
+
+func requireTenant(t *testing.T, ctx context.Context, tenant string) {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	require.True(t, ok)
+	require.Equal(t, []string{tenant}, md.Get(tenantMetadataKey))
+}
+
+func TestResolveTenant(t *testing.T) {
+	testCases := []struct {
+		name   string
+		header string
+		auth   *pb.AuthResponse
+		tenant int32
+		err    error
+	}{
+		{name: "DefaultTenant", auth: &pb.AuthResponse{}, tenant: 0},
+		{name: "TeamFromToken", auth: &pb.AuthResponse{TeamId: 7}, tenant: 7},
+		{name: "HeaderMatchesTeam", header: "7", auth: &pb.AuthResponse{TeamId: 7}, tenant: 7},
+		{name: "HeaderOtherTeam", header: "3", auth: &pb.AuthResponse{TeamId: 7}, err: errNotInTenant},
+		{name: "AdminAnyTeam", header: "3", auth: &pb.AuthResponse{TeamId: 7, Role: pb.Role_ROLE_ADMIN}, tenant: 3},
+		{name: "InvalidHeader", header: "abc", auth: &pb.AuthResponse{}, err: errInvalidTenant},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tenant, err := resolveTenant(tc.header, tc.auth)
+			require.ErrorIs(t, err, tc.err)
+			require.Equal(t, tc.tenant, tenant)
+		})
+	}
+}
+
+func TestGetItemAPICrossTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpcClient := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorizeTeam(grpcClient, 1, 7, pb.Role_ROLE_MEMBER)
+	grpcClient.EXPECT().GetItem(gomock.Any(), gomock.Eq(&pb.GetItemRequest{Id: 1})).
+		DoAndReturn(func(ctx context.Context, _ *pb.GetItemRequest, _ ...grpc.CallOption) (*pb.GetItemResponse, error) {
+			requireTenant(t, ctx, "7")
+			return &pb.GetItemResponse{Item: &pb.Item{ID: 1, Name: "rocket", TenantId: 3}}, nil
+		})
+
+	server := NewTestServer(t, grpcClient)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodGet, "/item/get/1", nil)
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+func TestChangeItemAPICrossTenant(t *testing.T) {
+	testCases := []struct {
+		name    string
+		method  string
+		url     string
+		body    string
+		prepare func(grpc *mockpb.MockGalaxyClient)
+	}{
+		{
+			name:   "Update",
+			method: http.MethodPost,
+			url:    "/item/update",
+			body:   `{"id": 1, "name": "stolen"}`,
+			prepare: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().UpdateItem(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		{
+			name:   "Delete",
+			method: http.MethodDelete,
+			url:    "/item/delete/1",
+			prepare: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().DeleteItem(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			grpcClient := mockpb.NewMockGalaxyClient(ctrl)
+			token := expectAuthorizeTeam(grpcClient, 1, 7, pb.Role_ROLE_STAFF)
+			grpcClient.EXPECT().GetItem(gomock.Any(), gomock.Eq(&pb.GetItemRequest{Id: 1})).
+				Return(&pb.GetItemResponse{Item: &pb.Item{ID: 1, Name: "rocket", TenantId: 3}}, nil)
+			tc.prepare(grpcClient)
+
+			server := NewTestServer(t, grpcClient)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(tc.method, tc.url, strings.NewReader(tc.body))
+			require.NoError(t, err)
+
+			addAuthHeader(request, token)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, http.StatusNotFound, recorder.Code)
+		})
+	}
+}
+
+func TestListItemsAPITenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpcClient := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorizeTeam(grpcClient, 1, 7, pb.Role_ROLE_MEMBER)
+	grpcClient.EXPECT().ListItems(gomock.Any(), gomock.Eq(&pb.ListItemsRequest{Offset: 0, Limit: 5, TenantId: 7})).
+		Return(&pb.ListItemsResponse{Items: []*pb.Item{{ID: 1, Name: "rocket", TenantId: 7}}}, nil)
+
+	server := NewTestServer(t, grpcClient)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/item/list", strings.NewReader(`{"offset": 0, "limit": 5}`))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestGetEntryAPITenantHeader(t *testing.T) {
+	testCases := []struct {
+		name   string
+		role   pb.Role
+		status int
+	}{
+		{name: "Member", role: pb.Role_ROLE_MEMBER, status: http.StatusForbidden},
+		{name: "Admin", role: pb.Role_ROLE_ADMIN, status: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			grpcClient := mockpb.NewMockGalaxyClient(ctrl)
+			token := expectAuthorizeTeam(grpcClient, 1, 7, tc.role)
+			if tc.status == http.StatusOK {
+				grpcClient.EXPECT().GetEntry(gomock.Any(), gomock.Eq(&pb.GetEntryRequest{Id: 1})).
+					DoAndReturn(func(ctx context.Context, _ *pb.GetEntryRequest, _ ...grpc.CallOption) (*pb.GetEntryResponse, error) {
+						requireTenant(t, ctx, "3")
+						return &pb.GetEntryResponse{Entry: &pb.Entry{ID: 1, TenantId: 3, CreatedAt: timestamppb.Now()}}, nil
+					})
+			} else {
+				grpcClient.EXPECT().GetEntry(gomock.Any(), gomock.Any()).Times(0)
+			}
+
+			server := NewTestServer(t, grpcClient)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/entry/get/1", nil)
+			require.NoError(t, err)
+
+			addAuthHeader(request, token)
+			request.Header.Set(tenantHeader, "3")
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.status, recorder.Code)
+		})
+	}
+}
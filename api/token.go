@@ -44,6 +44,13 @@ func (server *Server) RenewAccessToken(ctx *gin.Context) {
 		return
 	}
 
+	if !isUserActive(result.GetUserStatus(), time.Now()) {
+		server.rejectInactiveUser(ctx, result.GetUserId(), result.GetUserStatus())
+		return
+	}
+
+	server.recordSecurityEvent(ctx, result.GetUserId(), pb.SecurityEventType_SECURITY_EVENT_TYPE_TOKEN_RENEW, "")
+
 	ctx.JSON(http.StatusOK, RenewAccessTokenResponse{
 		AccessToken:     result.AccessToken,
 		AccessExpiredAt: result.ExpiredAt.AsTime(),
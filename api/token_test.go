@@ -41,6 +41,7 @@ func TestRenewToken(t *testing.T) {
 
 	grpc := mockpb.NewMockGalaxyClient(ctrl)
 	grpc.EXPECT().RenewAccessToken(gomock.Any(), gomock.Eq(&grpcReq)).Return(&grpcRes, nil)
+	grpc.EXPECT().CreateSecurityEvent(gomock.Any(), EqSecurityEvent(0, pb.SecurityEventType_SECURITY_EVENT_TYPE_TOKEN_RENEW)).Return(&pb.Empty{}, nil)
 
 	server := NewTestServer(t, grpc)
 	recorder := httptest.NewRecorder()
@@ -57,3 +58,46 @@ func TestRenewToken(t *testing.T) {
 	require.Equal(t, newAccessToken, res.AccessToken)
 	require.Equal(t, expired, res.AccessExpiredAt)
 }
+
+func TestRenewTokenSuspendedUser(t *testing.T) {
+	url := "/token/renew"
+	refreshToken := util.GetRandomString(32)
+	expired := time.Now().UTC().Truncate(time.Second)
+
+	data, err := json.Marshal(gin.H{
+		"refresh_token": refreshToken,
+	})
+	require.NoError(t, err)
+
+	grpcReq := pb.RenewAccessTokenRequest{
+		RefreshToken: refreshToken,
+	}
+	grpcRes := pb.RenewAccessTokenResponse{
+		AccessToken: util.GetRandomString(32),
+		ExpiredAt:   timestamppb.New(expired),
+		UserId:      1,
+		UserStatus: &pb.UserStatus{
+			State:  pb.UserState_USER_STATE_SUSPENDED,
+			Reason: "chargeback",
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().RenewAccessToken(gomock.Any(), gomock.Eq(&grpcReq)).Return(&grpcRes, nil)
+	grpc.EXPECT().RevokeSessions(gomock.Any(), gomock.Eq(&pb.RevokeSessionsRequest{UserId: 1})).Return(&pb.RevokeSessionsResponse{Count: 1}, nil)
+	grpc.EXPECT().CreateSecurityEvent(gomock.Any(), EqSecurityEvent(1, pb.SecurityEventType_SECURITY_EVENT_TYPE_SESSION_REVOKE)).Return(&pb.Empty{}, nil)
+	grpc.EXPECT().CreateSecurityEvent(gomock.Any(), EqSecurityEvent(1, pb.SecurityEventType_SECURITY_EVENT_TYPE_TOKEN_RENEW)).Times(0)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusForbidden, recorder.Code)
+	require.NotContains(t, recorder.Body.String(), grpcRes.AccessToken)
+}
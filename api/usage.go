@@ -0,0 +1,138 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/machearn/galaxy_controller/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const usageMetricEntries = "entries"
+
+// usagePeriod returns the monthly metering period containing now and when
+// the next one starts. Periods follow calendar months in UTC.
+func usagePeriod(now time.Time) (string, time.Time) {
+	now = now.UTC()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return start.Format("2006-01"), start.AddDate(0, 1, 0)
+}
+
+// entryQuota returns how many entries a user on the plan may create per
+// month, or 0 if there is no limit.
+func (server *Server) entryQuota(ctx context.Context, planID int32) (int32, error) {
+	plan, err := server.lookupPlan(ctx, planID)
+	if err != nil {
+		return 0, err
+	}
+	return plan.GetMonthlyEntryQuota(), nil
+}
+
+// releaseUsage gives back the quota a cancelled entry took in the period it
+// was created in.
+func (server *Server) releaseUsage(ctx context.Context, entry *pb.Entry) error {
+	period, _ := usagePeriod(entry.GetCreatedAt().AsTime())
+	_, err := server.grpc.IncrementUsage(ctx, &pb.IncrementUsageRequest{
+		UserId:         entry.GetUserId(),
+		Metric:         usageMetricEntries,
+		Period:         period,
+		Amount:         -1,
+		IdempotencyKey: fmt.Sprintf("cancel:entry:%d", entry.GetID()),
+	})
+	if status.Code(err) == codes.AlreadyExists {
+		return nil
+	}
+	return err
+}
+
+// lookupPlan fetches the plan with planID, or a plan without quota or
+// discounts if there is no such plan.
+func (server *Server) lookupPlan(ctx context.Context, planID int32) (*pb.Plan, error) {
+	result, err := server.grpc.GetPlan(ctx, &pb.GetPlanRequest{Id: planID})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok && apiErr.Code() == codes.NotFound {
+			return &pb.Plan{ID: planID}, nil
+		}
+		return nil, err
+	}
+	return result.GetPlan(), nil
+}
+
+func setQuotaHeaders(ctx *gin.Context, limit int32, used int32, resetAt time.Time) {
+	remaining := limit - used
+	if remaining < 0 {
+		remaining = 0
+	}
+	ctx.Header("X-Quota-Limit", strconv.Itoa(int(limit)))
+	ctx.Header("X-Quota-Remaining", strconv.Itoa(int(remaining)))
+	ctx.Header("X-Quota-Reset", strconv.FormatInt(resetAt.Unix(), 10))
+}
+
+// writeQuotaExceeded responds with 429 to a request that would take the user
+// over their monthly entry quota.
+func writeQuotaExceeded(ctx *gin.Context, quota int32, resetAt time.Time) {
+	setQuotaHeaders(ctx, quota, quota, resetAt)
+	ctx.JSON(http.StatusTooManyRequests, errorResponse(fmt.Errorf(
+		"monthly quota of %d entries reached for your plan, it resets at %s", quota, resetAt.Format(time.RFC3339))))
+}
+
+type UsageMeter struct {
+	Used      int32  `json:"used"`
+	Limit     int32  `json:"limit"`
+	Remaining *int32 `json:"remaining"`
+}
+
+type UsageResponse struct {
+	Period  string     `json:"period"`
+	ResetAt time.Time  `json:"reset_at"`
+	Entries UsageMeter `json:"entries"`
+}
+
+// GetUsage reports the authenticated user's usage in the current period. A
+// limit of 0 means the plan does not limit the metric.
+func (server *Server) GetUsage(ctx *gin.Context) {
+	authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+
+	quota, err := server.entryQuota(ctx, authPayload.Plan)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	period, resetAt := usagePeriod(time.Now())
+	result, err := server.grpc.GetUsage(ctx, &pb.GetUsageRequest{
+		UserId: authPayload.UserID,
+		Metric: usageMetricEntries,
+		Period: period,
+	})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); !ok || apiErr.Code() != codes.NotFound {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+	}
+
+	entries := UsageMeter{
+		Used:  result.GetUsage().GetCount(),
+		Limit: quota,
+	}
+	if quota > 0 {
+		remaining := quota - entries.Used
+		if remaining < 0 {
+			remaining = 0
+		}
+		entries.Remaining = &remaining
+		setQuotaHeaders(ctx, quota, entries.Used, resetAt)
+	}
+
+	ctx.JSON(http.StatusOK, UsageResponse{
+		Period:  period,
+		ResetAt: resetAt,
+		Entries: entries,
+	})
+}
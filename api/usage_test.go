@@ -0,0 +1,189 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/machearn/galaxy_controller/pb"
+	mockpb "github.com/machearn/galaxy_controller/pb/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestUsagePeriod(t *testing.T) {
+	period, resetAt := usagePeriod(time.Date(2023, time.December, 31, 23, 59, 0, 0, time.UTC))
+	require.Equal(t, "2023-12", period)
+	require.Equal(t, time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), resetAt)
+}
+
+func TestCreateEntryAPIQuotaHeaders(t *testing.T) {
+	data, err := json.Marshal(gin.H{
+		"member_id": 1,
+		"item_id":   1,
+		"quantity":  1,
+		"total":     1,
+	})
+	require.NoError(t, err)
+
+	period, resetAt := usagePeriod(time.Now())
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	expectSagas(grpc)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Eq(&pb.GetUserRequest{ID: 1})).Return(&pb.GetUserResponse{User: &pb.User{ID: 1, Plan: 1}}, nil)
+	grpc.EXPECT().GetItem(gomock.Any(), gomock.Any()).Return(&pb.GetItemResponse{Item: &pb.Item{ID: 1, Price: usd(1)}}, nil)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 1})).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 1, Name: "basic", MonthlyEntryQuota: 10}}, nil)
+	grpc.EXPECT().IncrementUsage(gomock.Any(), EqKeyedRequest(&pb.IncrementUsageRequest{
+		UserId: 1,
+		Metric: usageMetricEntries,
+		Period: period,
+		Amount: 1,
+		Limit:  10,
+	})).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{UserId: 1, Count: 4}}, nil)
+	grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Return(&pb.CreateEntryResponse{
+		Entry: &pb.Entry{ID: 1, UserId: 1, ItemId: 1, Quantity: 1, Total: usd(1), CreatedAt: timestamppb.Now()},
+	}, nil)
+	grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Any()).Return(&pb.UpdateEntryResponse{
+		Entry: &pb.Entry{ID: 1, UserId: 1, ItemId: 1, Quantity: 1, Total: usd(1), CreatedAt: timestamppb.Now()},
+	}, nil)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/entry/create", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "10", recorder.Header().Get("X-Quota-Limit"))
+	require.Equal(t, "6", recorder.Header().Get("X-Quota-Remaining"))
+	require.Equal(t, strconv.FormatInt(resetAt.Unix(), 10), recorder.Header().Get("X-Quota-Reset"))
+}
+
+func TestCreateEntryAPIQuotaExceeded(t *testing.T) {
+	data, err := json.Marshal(gin.H{
+		"member_id": 1,
+		"item_id":   1,
+		"quantity":  1,
+		"total":     1,
+	})
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	expectSagas(grpc)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Eq(&pb.GetUserRequest{ID: 1})).Return(&pb.GetUserResponse{User: &pb.User{ID: 1, Plan: 1}}, nil)
+	grpc.EXPECT().GetItem(gomock.Any(), gomock.Any()).Return(&pb.GetItemResponse{Item: &pb.Item{ID: 1, Price: usd(1)}}, nil)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 1})).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 1, Name: "basic", MonthlyEntryQuota: 10}}, nil)
+	grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.ResourceExhausted, "limit reached"))
+	grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/entry/create", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+	require.Equal(t, "0", recorder.Header().Get("X-Quota-Remaining"))
+	require.Contains(t, recorder.Body.String(), "monthly quota of 10 entries")
+}
+
+func TestCreateEntryAPIReleasesUsageOnFailure(t *testing.T) {
+	data, err := json.Marshal(gin.H{
+		"member_id": 1,
+		"item_id":   1,
+		"quantity":  1,
+		"total":     1,
+	})
+	require.NoError(t, err)
+
+	period, _ := usagePeriod(time.Now())
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	expectSagas(grpc)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(&pb.GetUserResponse{User: &pb.User{ID: 1, Plan: 1}}, nil)
+	grpc.EXPECT().GetItem(gomock.Any(), gomock.Any()).Return(&pb.GetItemResponse{Item: &pb.Item{ID: 1, Price: usd(1)}}, nil)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Any()).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 1, MonthlyEntryQuota: 10}}, nil)
+	gomock.InOrder(
+		grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{Count: 4}}, nil),
+		grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Internal, "internal")),
+		grpc.EXPECT().IncrementUsage(gomock.Any(), EqKeyedRequest(&pb.IncrementUsageRequest{
+			UserId: 1,
+			Metric: usageMetricEntries,
+			Period: period,
+			Amount: -1,
+		})).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{Count: 3}}, nil),
+	)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/entry/create", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusInternalServerError, recorder.Code)
+}
+
+func TestGetUsageAPI(t *testing.T) {
+	period, resetAt := usagePeriod(time.Now())
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 0})).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 0, Name: "free", MonthlyEntryQuota: 5}}, nil)
+	grpc.EXPECT().GetUsage(gomock.Any(), gomock.Eq(&pb.GetUsageRequest{
+		UserId: 1,
+		Metric: usageMetricEntries,
+		Period: period,
+	})).Return(&pb.GetUsageResponse{Usage: &pb.Usage{UserId: 1, Count: 2}}, nil)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodGet, "/user/usage", nil)
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var res UsageResponse
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+	require.Equal(t, period, res.Period)
+	require.Equal(t, resetAt, res.ResetAt)
+	require.Equal(t, int32(2), res.Entries.Used)
+	require.Equal(t, int32(5), res.Entries.Limit)
+	require.Equal(t, int32(3), *res.Entries.Remaining)
+}
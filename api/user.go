@@ -6,10 +6,12 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/machearn/galaxy_controller/money"
 	"github.com/machearn/galaxy_controller/pb"
 	"github.com/machearn/galaxy_controller/util"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type LoginRequest struct {
@@ -18,14 +20,57 @@ type LoginRequest struct {
 }
 
 type User struct {
-	ID        int32     `json:"id"`
-	Username  string    `json:"username"`
-	Fullname  string    `json:"fullname"`
-	Email     string    `json:"email"`
-	Plan      int32     `json:"plan"`
-	CreatedAt time.Time `json:"created_at"`
-	ExpiredAt time.Time `json:"expired_at"`
-	AutoRenew bool      `json:"auto_renew"`
+	ID        int32      `json:"id"`
+	Username  string     `json:"username"`
+	Fullname  string     `json:"fullname"`
+	Email     string     `json:"email"`
+	Plan      int32      `json:"plan"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiredAt time.Time  `json:"expired_at"`
+	AutoRenew bool       `json:"auto_renew"`
+	Status    UserStatus `json:"status"`
+	Role      string     `json:"role"`
+	Trial     bool       `json:"trial"`
+	TeamID    int32      `json:"team_id,omitempty"`
+	// Currency is the currency prices are shown and charged in, the store
+	// currency if unset.
+	Currency string `json:"currency,omitempty"`
+}
+
+type UserStatus struct {
+	State  string     `json:"state"`
+	Reason string     `json:"reason,omitempty"`
+	Until  *time.Time `json:"until,omitempty"`
+}
+
+func newUserResponse(user *pb.User) User {
+	return User{
+		ID:        user.GetID(),
+		Username:  user.GetUsername(),
+		Fullname:  user.GetFullname(),
+		Email:     user.GetEmail(),
+		Plan:      user.GetPlan(),
+		CreatedAt: user.GetCreatedAt().AsTime(),
+		ExpiredAt: user.GetExpiredAt().AsTime(),
+		AutoRenew: user.GetAutoRenew(),
+		Status:    newUserStatusResponse(user.GetStatus()),
+		Role:      roleNames[user.GetRole()],
+		Trial:     user.GetTrial(),
+		TeamID:    user.GetTeamId(),
+		Currency:  user.GetCurrency(),
+	}
+}
+
+func newUserStatusResponse(status *pb.UserStatus) UserStatus {
+	res := UserStatus{
+		State:  userStateNames[status.GetState()],
+		Reason: status.GetReason(),
+	}
+	if status.GetUntil() != nil {
+		until := status.GetUntil().AsTime()
+		res.Until = &until
+	}
+	return res
 }
 
 type LoginResponse struct {
@@ -63,10 +108,48 @@ func (server *Server) Login(ctx *gin.Context) {
 
 	err = util.CheckPassword(req.Password, userResult.GetPassword())
 	if err != nil {
+		server.recordSecurityEvent(ctx, userResult.GetUser().GetID(), pb.SecurityEventType_SECURITY_EVENT_TYPE_LOGIN_FAILURE, "incorrect password")
 		ctx.JSON(http.StatusUnauthorized, errorResponse(errors.New("username or password is incorrect")))
 		return
 	}
 
+	if !isUserActive(userResult.GetUser().GetStatus(), time.Now()) {
+		server.recordSecurityEvent(ctx, userResult.GetUser().GetID(), pb.SecurityEventType_SECURITY_EVENT_TYPE_LOGIN_FAILURE, inactiveUserError(userResult.GetUser().GetStatus()).Error())
+		server.rejectInactiveUser(ctx, userResult.GetUser().GetID(), userResult.GetUser().GetStatus())
+		return
+	}
+
+	if userResult.GetUser().GetPasswordResetRequired() {
+		server.recordSecurityEvent(ctx, userResult.GetUser().GetID(), pb.SecurityEventType_SECURITY_EVENT_TYPE_LOGIN_FAILURE, "password reset required")
+		ctx.JSON(http.StatusForbidden, errorResponse(errors.New("password reset required")))
+		return
+	}
+
+	sessionResult, err := server.grpc.ListSessions(ctx, &pb.ListSessionsRequest{UserId: userResult.GetUser().GetID()})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	newDevice := isNewDevice(sessionResult.GetSessions(), ctx.ClientIP(), ctx.Request.UserAgent())
+
+	planID, err := server.subscriptionPlan(ctx, userResult.GetUser())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	planResult, err := server.grpc.GetPlan(ctx, &pb.GetPlanRequest{Id: planID})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); !ok || apiErr.Code() != codes.NotFound {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+	}
+
+	if !server.enforceSessionLimit(ctx, userResult.GetUser(), planResult.GetPlan(), sessionResult.GetSessions()) {
+		return
+	}
+
 	grpcCreateSessionReq := pb.CreateSessionRequest{
 		UserId:    userResult.GetUser().GetID(),
 		ClientIp:  ctx.ClientIP(),
@@ -87,18 +170,14 @@ func (server *Server) Login(ctx *gin.Context) {
 		return
 	}
 
+	server.recordSecurityEvent(ctx, userResult.GetUser().GetID(), pb.SecurityEventType_SECURITY_EVENT_TYPE_LOGIN_SUCCESS, "")
+	if newDevice {
+		server.sendNewDeviceAlert(ctx, userResult.GetUser(), result.GetSession())
+	}
+
 	user := userResult.GetUser()
 	res := LoginResponse{
-		User: User{
-			ID:        user.ID,
-			Username:  user.Username,
-			Fullname:  user.Fullname,
-			Email:     user.Email,
-			Plan:      user.Plan,
-			CreatedAt: user.CreatedAt.AsTime(),
-			ExpiredAt: user.ExpiredAt.AsTime(),
-			AutoRenew: user.AutoRenew,
-		},
+		User:             newUserResponse(user),
 		AccessToken:      result.GetAccessToken(),
 		AccessExpiredAt:  result.GetExpiredAt().AsTime(),
 		RefreshToken:     result.GetSession().GetRefreshToken(),
@@ -115,14 +194,19 @@ type CreateUserRequest struct {
 	Password  string `json:"password"`
 	Plan      int32  `json:"plan"`
 	AutoRenew bool   `json:"auto_renew"`
+	Trial     bool   `json:"trial"`
 }
 
+// CreateUser signs up a new user on a free plan, or on a time-limited trial
+// of a paid plan when trial is set. Each email address gets one trial, which
+// the backend enforces with a unique constraint on the normalized address.
 func (server *Server) CreateUser(ctx *gin.Context) {
 	var req CreateUserRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		ctx.JSON(http.StatusBadRequest, errorResponse(err))
 		return
 	}
+	req.Email = util.NormalizeEmail(req.Email)
 
 	_, err := server.grpc.GetUserByUsername(ctx, &pb.GetUserByUsernameRequest{Username: req.Username})
 	if err == nil {
@@ -140,6 +224,44 @@ func (server *Server) CreateUser(ctx *gin.Context) {
 		}
 	}
 
+	planResult, err := server.grpc.GetPlan(ctx, &pb.GetPlanRequest{Id: req.Plan})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.NotFound {
+				ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("plan does not exist")))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	plan := planResult.GetPlan()
+
+	var expiredAt *timestamppb.Timestamp
+	if req.Trial {
+		if plan.GetTrialDays() <= 0 {
+			ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("plan does not offer a trial")))
+			return
+		}
+
+		_, err = server.grpc.GetTrial(ctx, &pb.GetTrialRequest{Email: req.Email})
+		if err == nil {
+			ctx.JSON(http.StatusConflict, errorResponse(errors.New("a trial has already been used with this email")))
+			return
+		}
+		if apiErr, ok := status.FromError(err); !ok || apiErr.Code() != codes.NotFound {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		expiredAt = timestamppb.New(time.Now().AddDate(0, 0, int(plan.GetTrialDays())))
+	} else if plan.GetPrice() > 0 {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("paid plans must be purchased through /subscription/change")))
+		return
+	}
+
 	hashedPassword, err := util.HashPassword(req.Password)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
@@ -153,6 +275,8 @@ func (server *Server) CreateUser(ctx *gin.Context) {
 		Password:  hashedPassword,
 		Plan:      req.Plan,
 		AutoRenew: req.AutoRenew,
+		ExpiredAt: expiredAt,
+		Trial:     req.Trial,
 	}
 
 	result, err := server.grpc.CreateUser(ctx, &grpcReq)
@@ -162,6 +286,11 @@ func (server *Server) CreateUser(ctx *gin.Context) {
 				ctx.JSON(http.StatusBadRequest, errorResponse(apiErr.Err()))
 				return
 			}
+			if apiErr.Code() == codes.AlreadyExists {
+				// another sign up with the same email won the race
+				ctx.JSON(http.StatusConflict, errorResponse(apiErr.Err()))
+				return
+			}
 			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
 			return
 		}
@@ -169,17 +298,7 @@ func (server *Server) CreateUser(ctx *gin.Context) {
 		return
 	}
 
-	user := result.GetUser()
-	ctx.JSON(http.StatusOK, User{
-		ID:        user.ID,
-		Username:  user.Username,
-		Fullname:  user.Fullname,
-		Email:     user.Email,
-		Plan:      user.Plan,
-		CreatedAt: user.CreatedAt.AsTime(),
-		ExpiredAt: user.ExpiredAt.AsTime(),
-		AutoRenew: user.AutoRenew,
-	})
+	ctx.JSON(http.StatusOK, newUserResponse(result.GetUser()))
 }
 
 type UpdateUserRequest struct {
@@ -190,6 +309,7 @@ type UpdateUserRequest struct {
 	Password  *string `json:"password"`
 	Plan      *int32  `json:"plan"`
 	AutoRenew *bool   `json:"auto_renew"`
+	Currency  *string `json:"currency"`
 }
 
 func (server *Server) UpdateUser(ctx *gin.Context) {
@@ -205,6 +325,11 @@ func (server *Server) UpdateUser(ctx *gin.Context) {
 		return
 	}
 
+	if req.Plan != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("plan cannot be updated here, use /subscription/change")))
+		return
+	}
+
 	if req.Password != nil {
 		hashedPassword, err := util.HashPassword(*req.Password)
 		if err != nil {
@@ -214,14 +339,28 @@ func (server *Server) UpdateUser(ctx *gin.Context) {
 		req.Password = &hashedPassword
 	}
 
+	if req.Currency != nil {
+		currency := money.NormalizeCurrency(*req.Currency)
+		if err := server.checkCurrency(currency); err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+		req.Currency = &currency
+	}
+
+	if req.Email != nil {
+		email := util.NormalizeEmail(*req.Email)
+		req.Email = &email
+	}
+
 	grpcReq := pb.UpdateUserRequest{
 		ID:        req.ID,
 		Username:  req.Username,
 		Fullname:  req.Fullname,
 		Email:     req.Email,
 		Password:  req.Password,
-		Plan:      req.Plan,
 		AutoRenew: req.AutoRenew,
+		Currency:  req.Currency,
 	}
 
 	result, err := server.grpc.UpdateUser(ctx, &grpcReq)
@@ -238,17 +377,11 @@ func (server *Server) UpdateUser(ctx *gin.Context) {
 		return
 	}
 
-	user := result.GetUser()
-	ctx.JSON(http.StatusOK, User{
-		ID:        user.ID,
-		Username:  user.Username,
-		Fullname:  user.Fullname,
-		Email:     user.Email,
-		Plan:      user.Plan,
-		CreatedAt: user.CreatedAt.AsTime(),
-		ExpiredAt: user.ExpiredAt.AsTime(),
-		AutoRenew: user.AutoRenew,
-	})
+	if req.Password != nil {
+		server.recordSecurityEvent(ctx, req.ID, pb.SecurityEventType_SECURITY_EVENT_TYPE_PASSWORD_CHANGE, "")
+	}
+
+	ctx.JSON(http.StatusOK, newUserResponse(result.GetUser()))
 }
 
 type GetUserRequest struct {
@@ -286,15 +419,5 @@ func (server *Server) GetUser(ctx *gin.Context) {
 		return
 	}
 
-	user := result.GetUser()
-	ctx.JSON(http.StatusOK, User{
-		ID:        user.ID,
-		Username:  user.Username,
-		Fullname:  user.Fullname,
-		Email:     user.Email,
-		Plan:      user.Plan,
-		CreatedAt: user.CreatedAt.AsTime(),
-		ExpiredAt: user.ExpiredAt.AsTime(),
-		AutoRenew: user.AutoRenew,
-	})
+	ctx.JSON(http.StatusOK, newUserResponse(result.GetUser()))
 }
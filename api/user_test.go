@@ -81,7 +81,10 @@ func TestLoginAPI(t *testing.T) {
 
 	grpc := mockpb.NewMockGalaxyClient(ctrl)
 	grpc.EXPECT().GetUserByUsername(gomock.Any(), gomock.Eq(&grpcGetUserReq)).Return(&grpcGetUserRes, nil)
+	grpc.EXPECT().ListSessions(gomock.Any(), gomock.Eq(&pb.ListSessionsRequest{UserId: 1})).Return(&pb.ListSessionsResponse{}, nil)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 1})).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 1, MaxSessions: 3}}, nil)
 	grpc.EXPECT().CreateSession(gomock.Any(), gomock.Eq(&grpcCreateSessionReq)).Return(&grpcCreateSessionRes, nil)
+	grpc.EXPECT().CreateSecurityEvent(gomock.Any(), EqSecurityEvent(1, pb.SecurityEventType_SECURITY_EVENT_TYPE_LOGIN_SUCCESS)).Return(&pb.Empty{}, nil)
 
 	server := NewTestServer(t, grpc)
 	recorder := httptest.NewRecorder()
@@ -246,6 +249,7 @@ func TestCreateUserAPI(t *testing.T) {
 	grpc.EXPECT().GetUserByUsername(gomock.Any(), gomock.Eq(&pb.GetUserByUsernameRequest{Username: "test"})).Return(
 		nil, status.Error(codes.NotFound, "user not found"),
 	)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 1})).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 1, Name: "free"}}, nil)
 	grpc.EXPECT().CreateUser(gomock.Any(), EqCreateUserRequest(&grpcReq)).Return(&grpcRes, nil)
 
 	server := NewTestServer(t, grpc)
@@ -337,3 +341,211 @@ func TestUpdateUserAPI(t *testing.T) {
 	require.Equal(t, expired, res.ExpiredAt)
 	require.True(t, res.AutoRenew)
 }
+
+func TestLoginAPISuspendedUser(t *testing.T) {
+	url := "/user/login"
+	data, err := json.Marshal(gin.H{
+		"username": "test",
+		"password": "test",
+	})
+	require.NoError(t, err)
+
+	created := time.Now().UTC().Truncate(time.Second)
+	until := created.Add(time.Hour * 24)
+
+	hashedPassword, err := util.HashPassword("test")
+	require.NoError(t, err)
+
+	grpcGetUserRes := pb.GetUserResponse{
+		User: &pb.User{
+			ID:        1,
+			Username:  "test",
+			CreatedAt: timestamppb.New(created),
+			ExpiredAt: timestamppb.New(until),
+			Status: &pb.UserStatus{
+				State:  pb.UserState_USER_STATE_SUSPENDED,
+				Reason: "abuse",
+				Until:  timestamppb.New(until),
+			},
+		},
+		Password: hashedPassword,
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().GetUserByUsername(gomock.Any(), gomock.Any()).Return(&grpcGetUserRes, nil)
+	grpc.EXPECT().RevokeSessions(gomock.Any(), gomock.Eq(&pb.RevokeSessionsRequest{UserId: 1})).Return(&pb.RevokeSessionsResponse{Count: 1}, nil)
+	grpc.EXPECT().CreateSecurityEvent(gomock.Any(), EqSecurityEvent(1, pb.SecurityEventType_SECURITY_EVENT_TYPE_LOGIN_FAILURE)).Return(&pb.Empty{}, nil)
+	grpc.EXPECT().CreateSecurityEvent(gomock.Any(), EqSecurityEvent(1, pb.SecurityEventType_SECURITY_EVENT_TYPE_SESSION_REVOKE)).Return(&pb.Empty{}, nil)
+	grpc.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusForbidden, recorder.Code)
+	require.Contains(t, recorder.Body.String(), "account is suspended")
+}
+
+func TestCreateUserAPIPaidPlan(t *testing.T) {
+	data, err := json.Marshal(gin.H{
+		"username": "test",
+		"password": "test",
+		"fullname": "test",
+		"email":    "test",
+		"plan":     3,
+	})
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().GetUserByUsername(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.NotFound, "user not found"))
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 3})).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 3, Price: 999}}, nil)
+	grpc.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/user/create", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestCreateUserAPITrial(t *testing.T) {
+	data, err := json.Marshal(gin.H{
+		"username":   "test",
+		"password":   "test",
+		"fullname":   "test",
+		"email":      " Test@Example.com",
+		"plan":       3,
+		"auto_renew": true,
+		"trial":      true,
+	})
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().GetUserByUsername(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.NotFound, "user not found"))
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 3})).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 3, Price: 999, DurationDays: 30, TrialDays: 14}}, nil)
+	grpc.EXPECT().GetTrial(gomock.Any(), gomock.Eq(&pb.GetTrialRequest{Email: "test@example.com"})).Return(nil, status.Error(codes.NotFound, "trial not found"))
+	grpc.EXPECT().CreateUser(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, req *pb.CreateUserRequest, _ ...any) (*pb.CreateUserResponse, error) {
+			require.True(t, req.GetTrial())
+			require.Equal(t, "test@example.com", req.GetEmail())
+			require.Equal(t, int32(3), req.GetPlan())
+			require.WithinDuration(t, time.Now().AddDate(0, 0, 14), req.GetExpiredAt().AsTime(), time.Minute)
+			return &pb.CreateUserResponse{User: &pb.User{ID: 1, Plan: 3, Trial: true, ExpiredAt: req.GetExpiredAt()}}, nil
+		})
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/user/create", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var res User
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+	require.True(t, res.Trial)
+}
+
+func TestCreateUserAPITrialAlreadyUsed(t *testing.T) {
+	data, err := json.Marshal(gin.H{
+		"username": "test",
+		"password": "test",
+		"fullname": "test",
+		"email":    "test@example.com",
+		"plan":     3,
+		"trial":    true,
+	})
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().GetUserByUsername(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.NotFound, "user not found"))
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 3})).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 3, Price: 999, DurationDays: 30, TrialDays: 14}}, nil)
+	grpc.EXPECT().GetTrial(gomock.Any(), gomock.Eq(&pb.GetTrialRequest{Email: "test@example.com"})).Return(&pb.GetTrialResponse{Trial: &pb.Trial{ID: 1, Email: "test@example.com"}}, nil)
+	grpc.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/user/create", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusConflict, recorder.Code)
+}
+
+func TestCreateUserAPITrialRace(t *testing.T) {
+	data, err := json.Marshal(gin.H{
+		"username": "test",
+		"password": "test",
+		"fullname": "test",
+		"email":    "Test@Example.com",
+		"plan":     3,
+		"trial":    true,
+	})
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().GetUserByUsername(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.NotFound, "user not found"))
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 3})).Return(&pb.GetPlanResponse{Plan: &pb.Plan{ID: 3, Price: 999, DurationDays: 30, TrialDays: 14}}, nil)
+	grpc.EXPECT().GetTrial(gomock.Any(), gomock.Eq(&pb.GetTrialRequest{Email: "test@example.com"})).Return(nil, status.Error(codes.NotFound, "trial not found"))
+	// a concurrent sign up took the trial between the check and the insert
+	grpc.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.AlreadyExists, "trial already used"))
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/user/create", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusConflict, recorder.Code)
+}
+
+func TestUpdateUserAPIRejectsPlan(t *testing.T) {
+	data, err := json.Marshal(gin.H{
+		"id":   1,
+		"plan": 3,
+	})
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/user/update", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+}
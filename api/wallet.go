@@ -0,0 +1,340 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/machearn/galaxy_controller/money"
+	"github.com/machearn/galaxy_controller/payments"
+	"github.com/machearn/galaxy_controller/pb"
+	"github.com/machearn/galaxy_controller/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const topUpPurpose = "wallet_top_up"
+
+var walletTransactionTypeNames = map[pb.WalletTransactionType]string{
+	pb.WalletTransactionType_WALLET_TRANSACTION_TYPE_TOP_UP:     "top_up",
+	pb.WalletTransactionType_WALLET_TRANSACTION_TYPE_PURCHASE:   "purchase",
+	pb.WalletTransactionType_WALLET_TRANSACTION_TYPE_REFUND:     "refund",
+	pb.WalletTransactionType_WALLET_TRANSACTION_TYPE_ADJUSTMENT: "adjustment",
+	pb.WalletTransactionType_WALLET_TRANSACTION_TYPE_GIFT_CARD:  "gift_card",
+}
+
+// errInsufficientBalance is returned by the purchase sagas when the wallet
+// cannot cover a purchase paid from it.
+var errInsufficientBalance = errors.New("wallet balance is too low")
+
+type WalletTransaction struct {
+	ID     int32       `json:"id"`
+	Type   string      `json:"type"`
+	Amount money.Money `json:"amount"`
+	// Balance is the balance after the transaction.
+	Balance   money.Money `json:"balance"`
+	EntryID   int32       `json:"entry_id,omitempty"`
+	Reason    string      `json:"reason,omitempty"`
+	CreatedBy int32       `json:"created_by,omitempty"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+func newWalletTransactionResponse(transaction *pb.WalletTransaction) WalletTransaction {
+	return WalletTransaction{
+		ID:        transaction.GetID(),
+		Type:      walletTransactionTypeNames[transaction.GetType()],
+		Amount:    money.FromProto(transaction.GetAmount()),
+		Balance:   money.FromProto(transaction.GetBalance()),
+		EntryID:   transaction.GetEntryId(),
+		Reason:    transaction.GetReason(),
+		CreatedBy: transaction.GetCreatedBy(),
+		CreatedAt: transaction.GetCreatedAt().AsTime(),
+	}
+}
+
+// walletBalance returns the balance of the user's wallet. Users without a
+// wallet have nothing, in their own currency.
+func (server *Server) walletBalance(ctx *gin.Context, user *pb.User) (money.Money, error) {
+	result, err := server.grpc.GetWallet(ctx, &pb.GetWalletRequest{UserId: user.GetID()})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return money.New(0, server.userCurrency(user)), nil
+		}
+		return money.Money{}, err
+	}
+	return money.FromProto(result.GetWallet().GetBalance()), nil
+}
+
+type GetWalletRequest struct {
+	Offset int32 `form:"offset" binding:"min=0"`
+	Limit  int32 `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+type Wallet struct {
+	Balance      money.Money         `json:"balance"`
+	Transactions []WalletTransaction `json:"transactions"`
+}
+
+// GetWallet reports the authenticated user's balance and the latest
+// transactions of their wallet.
+func (server *Server) GetWallet(ctx *gin.Context) {
+	var req GetWalletRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	if req.Limit == 0 {
+		req.Limit = 20
+	}
+
+	authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+	userResult, err := server.grpc.GetUser(ctx, &pb.GetUserRequest{ID: authPayload.UserID})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	balance, err := server.walletBalance(ctx, userResult.GetUser())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	result, err := server.grpc.ListWalletTransactions(ctx, &pb.ListWalletTransactionsRequest{
+		UserId: authPayload.UserID,
+		Offset: req.Offset,
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	transactions := make([]WalletTransaction, len(result.GetTransactions()))
+	for i, transaction := range result.GetTransactions() {
+		transactions[i] = newWalletTransactionResponse(transaction)
+	}
+
+	ctx.JSON(http.StatusOK, Wallet{
+		Balance:      balance,
+		Transactions: transactions,
+	})
+}
+
+type TopUpWalletRequest struct {
+	// Amount is in the minor units of the wallet's currency.
+	Amount int64 `json:"amount" binding:"required,min=1"`
+}
+
+type TopUpWalletResponse struct {
+	// TopUpID is passed to /wallet/topup/capture once the payment is
+	// confirmed.
+	TopUpID             string      `json:"top_up_id"`
+	Amount              money.Money `json:"amount"`
+	PaymentClientSecret string      `json:"payment_client_secret"`
+}
+
+type topUpClaims struct {
+	Purpose  string `json:"purpose"`
+	UserID   int32  `json:"user_id"`
+	IntentID string `json:"intent_id"`
+}
+
+// TopUpWallet asks the payment provider to authorize adding amount to the
+// authenticated user's wallet. The wallet is credited once the payment is
+// captured.
+func (server *Server) TopUpWallet(ctx *gin.Context) {
+	var req TopUpWalletRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+	userResult, err := server.grpc.GetUser(ctx, &pb.GetUserRequest{ID: authPayload.UserID})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	balance, err := server.walletBalance(ctx, userResult.GetUser())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	userID := strconv.FormatInt(int64(authPayload.UserID), 10)
+	intent, err := server.payments.CreateIntent(ctx, payments.CreateIntentRequest{
+		Amount:         req.Amount,
+		Currency:       balance.Currency,
+		Description:    fmt.Sprintf("wallet top-up for user %s", userID),
+		IdempotencyKey: "wallet:" + util.GetRandomString(32),
+		Metadata: map[string]string{
+			"wallet_user_id": userID,
+			"user_id":        userID,
+		},
+	})
+	if err != nil {
+		if errors.Is(err, payments.ErrDeclined) {
+			ctx.JSON(http.StatusPaymentRequired, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	topUpID, err := util.SignToken(server.config.TokenSymmetricKey, topUpClaims{
+		Purpose:  topUpPurpose,
+		UserID:   authPayload.UserID,
+		IntentID: intent.ID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, TopUpWalletResponse{
+		TopUpID:             topUpID,
+		Amount:              money.New(req.Amount, balance.Currency),
+		PaymentClientSecret: intent.ClientSecret,
+	})
+}
+
+type CaptureTopUpRequest struct {
+	TopUpID string `json:"top_up_id" binding:"required"`
+}
+
+// CaptureTopUp takes the money authorized for a top-up and credits the
+// wallet. Captures the provider completes asynchronously are credited by the
+// payments webhook instead.
+func (server *Server) CaptureTopUp(ctx *gin.Context) {
+	var req CaptureTopUpRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+	var claims topUpClaims
+	if err := util.VerifyToken(server.config.TokenSymmetricKey, req.TopUpID, &claims); err != nil ||
+		claims.Purpose != topUpPurpose || claims.UserID != authPayload.UserID {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("top-up is invalid")))
+		return
+	}
+
+	intent, err := server.payments.Capture(ctx, claims.IntentID)
+	if err != nil {
+		switch {
+		case errors.Is(err, payments.ErrDeclined):
+			ctx.JSON(http.StatusPaymentRequired, errorResponse(err))
+		case errors.Is(err, payments.ErrInvalidState):
+			ctx.JSON(http.StatusConflict, errorResponse(errors.New("payment has not been authorized yet")))
+		default:
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		}
+		return
+	}
+
+	if intent.Status != payments.IntentSucceeded {
+		ctx.JSON(http.StatusAccepted, nil)
+		return
+	}
+
+	wallet, err := server.creditTopUp(ctx, authPayload.UserID, *intent)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, Wallet{
+		Balance:      money.FromProto(wallet.GetBalance()),
+		Transactions: []WalletTransaction{},
+	})
+}
+
+// creditTopUp adds the amount of a captured top-up to the user's wallet.
+// Each payment is credited once however often it is reported.
+func (server *Server) creditTopUp(ctx *gin.Context, userID int32, intent payments.Intent) (*pb.Wallet, error) {
+	result, err := server.grpc.CreateWalletTransaction(ctx, &pb.CreateWalletTransactionRequest{
+		UserId:          userID,
+		Type:            pb.WalletTransactionType_WALLET_TRANSACTION_TYPE_TOP_UP,
+		Amount:          money.New(intent.Amount, intent.Currency).Proto(),
+		PaymentIntentId: intent.ID,
+		IdempotencyKey:  "top-up:" + intent.ID,
+	})
+	if err != nil {
+		if status.Code(err) != codes.AlreadyExists {
+			return nil, err
+		}
+		walletResult, err := server.grpc.GetWallet(ctx, &pb.GetWalletRequest{UserId: userID})
+		if err != nil {
+			return nil, err
+		}
+		return walletResult.GetWallet(), nil
+	}
+	return result.GetWallet(), nil
+}
+
+type AdjustWalletRequest struct {
+	UserID int32 `json:"member_id" binding:"required,min=1"`
+	// Amount is added to the balance, negative amounts take money out.
+	Amount int64  `json:"amount" binding:"required"`
+	Reason string `json:"reason" binding:"required,max=256"`
+}
+
+// AdjustWallet lets admins correct a wallet balance. Every adjustment is
+// recorded in the ledger with the admin and the reason.
+func (server *Server) AdjustWallet(ctx *gin.Context) {
+	var req AdjustWalletRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	userResult, err := server.grpc.GetUser(ctx, &pb.GetUserRequest{ID: req.UserID})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok && apiErr.Code() == codes.NotFound {
+			ctx.JSON(http.StatusNotFound, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	balance, err := server.walletBalance(ctx, userResult.GetUser())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet("auth_payload").(*AuthPayload)
+	result, err := server.grpc.CreateWalletTransaction(ctx, &pb.CreateWalletTransactionRequest{
+		UserId:         req.UserID,
+		Type:           pb.WalletTransactionType_WALLET_TRANSACTION_TYPE_ADJUSTMENT,
+		Amount:         money.New(req.Amount, balance.Currency).Proto(),
+		Reason:         req.Reason,
+		CreatedBy:      authPayload.UserID,
+		IdempotencyKey: "adjustment:" + util.GetRandomString(32),
+	})
+	if err != nil {
+		if apiErr, ok := status.FromError(err); ok {
+			if apiErr.Code() == codes.FailedPrecondition {
+				ctx.JSON(http.StatusConflict, errorResponse(errInsufficientBalance))
+				return
+			}
+			if apiErr.Code() == codes.InvalidArgument {
+				ctx.JSON(http.StatusBadRequest, errorResponse(apiErr.Err()))
+				return
+			}
+			ctx.JSON(http.StatusInternalServerError, errorResponse(apiErr.Err()))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newWalletTransactionResponse(result.GetTransaction()))
+}
@@ -0,0 +1,507 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/machearn/galaxy_controller/money"
+	"github.com/machearn/galaxy_controller/payments"
+	"github.com/machearn/galaxy_controller/pb"
+	mockpb "github.com/machearn/galaxy_controller/pb/mock"
+	"github.com/machearn/galaxy_controller/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetWalletAPI(t *testing.T) {
+	testCases := []struct {
+		name          string
+		buildStubs    func(grpc *mockpb.MockGalaxyClient)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetWallet(gomock.Any(), gomock.Eq(&pb.GetWalletRequest{UserId: 1})).Return(&pb.WalletResponse{
+					Wallet: &pb.Wallet{UserId: 1, Balance: usd(700)},
+				}, nil)
+				grpc.EXPECT().ListWalletTransactions(gomock.Any(), gomock.Eq(&pb.ListWalletTransactionsRequest{UserId: 1, Limit: 20})).Return(&pb.ListWalletTransactionsResponse{
+					Transactions: []*pb.WalletTransaction{
+						{ID: 2, UserId: 1, Type: pb.WalletTransactionType_WALLET_TRANSACTION_TYPE_PURCHASE, Amount: usd(-300), Balance: usd(700), EntryId: 4, CreatedAt: timestamppb.Now()},
+						{ID: 1, UserId: 1, Type: pb.WalletTransactionType_WALLET_TRANSACTION_TYPE_TOP_UP, Amount: usd(1000), Balance: usd(1000), CreatedAt: timestamppb.Now()},
+					},
+				}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var wallet Wallet
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &wallet))
+				require.Equal(t, dollars(700), wallet.Balance)
+				require.Len(t, wallet.Transactions, 2)
+				require.Equal(t, "purchase", wallet.Transactions[0].Type)
+				require.Equal(t, dollars(-300), wallet.Transactions[0].Amount)
+				require.Equal(t, int32(4), wallet.Transactions[0].EntryID)
+				require.Equal(t, "top_up", wallet.Transactions[1].Type)
+			},
+		},
+		{
+			name: "NoWallet",
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetWallet(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.NotFound, "no wallet"))
+				grpc.EXPECT().ListWalletTransactions(gomock.Any(), gomock.Any()).Return(&pb.ListWalletTransactionsResponse{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var wallet Wallet
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &wallet))
+				require.Equal(t, money.New(0, "EUR"), wallet.Balance)
+				require.Empty(t, wallet.Transactions)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			grpc := mockpb.NewMockGalaxyClient(ctrl)
+			token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+			grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(&pb.GetUserResponse{User: &pb.User{ID: 1, Currency: "EUR"}}, nil)
+			tc.buildStubs(grpc)
+
+			server := NewTestServer(t, grpc)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/wallet", nil)
+			require.NoError(t, err)
+
+			addAuthHeader(request, token)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestTopUpWalletAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	topUpToken := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	captureToken := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(&pb.GetUserResponse{User: &pb.User{ID: 1}}, nil)
+	grpc.EXPECT().GetWallet(gomock.Any(), gomock.Any()).Return(&pb.WalletResponse{Wallet: &pb.Wallet{UserId: 1, Balance: usd(200)}}, nil)
+
+	server := NewTestServer(t, grpc)
+	provider := payments.NewFakeProvider()
+	server.payments = provider
+
+	data, err := json.Marshal(gin.H{"amount": 1000})
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodPost, "/wallet/topup", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, topUpToken)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var topUp TopUpWalletResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &topUp))
+	require.Equal(t, dollars(1000), topUp.Amount)
+	require.Equal(t, "pi_fake_1_secret", topUp.PaymentClientSecret)
+
+	grpc.EXPECT().CreateWalletTransaction(gomock.Any(), gomock.Eq(&pb.CreateWalletTransactionRequest{
+		UserId:          1,
+		Type:            pb.WalletTransactionType_WALLET_TRANSACTION_TYPE_TOP_UP,
+		Amount:          usd(1000),
+		PaymentIntentId: "pi_fake_1",
+		IdempotencyKey:  "top-up:pi_fake_1",
+	})).Return(&pb.WalletTransactionResponse{Wallet: &pb.Wallet{UserId: 1, Balance: usd(1200)}}, nil)
+
+	data, err = json.Marshal(gin.H{"top_up_id": topUp.TopUpID})
+	require.NoError(t, err)
+
+	recorder = httptest.NewRecorder()
+	request, err = http.NewRequest(http.MethodPost, "/wallet/topup/capture", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, captureToken)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var wallet Wallet
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &wallet))
+	require.Equal(t, dollars(1200), wallet.Balance)
+
+	intent, ok := provider.Intent("pi_fake_1")
+	require.True(t, ok)
+	require.Equal(t, payments.IntentSucceeded, intent.Status)
+}
+
+func TestCaptureTopUpAPIOtherUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 2, pb.Role_ROLE_MEMBER)
+	grpc.EXPECT().CreateWalletTransaction(gomock.Any(), gomock.Any()).Times(0)
+
+	server := NewTestServer(t, grpc)
+
+	topUpID, err := util.SignToken(server.config.TokenSymmetricKey, topUpClaims{Purpose: topUpPurpose, UserID: 1, IntentID: "pi_1"})
+	require.NoError(t, err)
+
+	data, err := json.Marshal(gin.H{"top_up_id": topUpID})
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodPost, "/wallet/topup/capture", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestPaymentWebhookAPITopUp(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	// the provider may deliver the same event more than once
+	gomock.InOrder(
+		grpc.EXPECT().CreateWalletTransaction(gomock.Any(), gomock.Eq(&pb.CreateWalletTransactionRequest{
+			UserId:          1,
+			Type:            pb.WalletTransactionType_WALLET_TRANSACTION_TYPE_TOP_UP,
+			Amount:          usd(1000),
+			PaymentIntentId: "pi_1",
+			IdempotencyKey:  "top-up:pi_1",
+		})).Return(&pb.WalletTransactionResponse{Wallet: &pb.Wallet{UserId: 1, Balance: usd(1000)}}, nil),
+		grpc.EXPECT().CreateWalletTransaction(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.AlreadyExists, "duplicate key")),
+	)
+	grpc.EXPECT().GetWallet(gomock.Any(), gomock.Eq(&pb.GetWalletRequest{UserId: 1})).Return(&pb.WalletResponse{Wallet: &pb.Wallet{UserId: 1, Balance: usd(1000)}}, nil)
+
+	server := NewTestServer(t, grpc)
+
+	payload, err := json.Marshal(gin.H{
+		"id":   "evt_1",
+		"type": payments.EventIntentSucceeded,
+		"data": gin.H{
+			"object": gin.H{
+				"id":       "pi_1",
+				"amount":   1000,
+				"currency": "usd",
+				"status":   "succeeded",
+				"metadata": gin.H{"wallet_user_id": "1", "user_id": "1"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		recorder := httptest.NewRecorder()
+		request, err := http.NewRequest(http.MethodPost, "/payments/webhook", bytes.NewReader(payload))
+		require.NoError(t, err)
+		request.Header.Set(payments.SignatureHeader, payments.SignWebhook(payload, server.config.PaymentsWebhookSecret, time.Now()))
+
+		server.router.ServeHTTP(recorder, request)
+		require.Equal(t, http.StatusOK, recorder.Code)
+	}
+}
+
+func TestAdjustWalletAPI(t *testing.T) {
+	testCases := []struct {
+		name          string
+		role          pb.Role
+		body          gin.H
+		buildStubs    func(grpc *mockpb.MockGalaxyClient)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			role: pb.Role_ROLE_ADMIN,
+			body: gin.H{"member_id": 2, "amount": -150, "reason": "duplicate top-up"},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetUser(gomock.Any(), gomock.Eq(&pb.GetUserRequest{ID: 2})).Return(&pb.GetUserResponse{User: &pb.User{ID: 2}}, nil)
+				grpc.EXPECT().GetWallet(gomock.Any(), gomock.Any()).Return(&pb.WalletResponse{Wallet: &pb.Wallet{UserId: 2, Balance: usd(500)}}, nil)
+				grpc.EXPECT().CreateWalletTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ any, req *pb.CreateWalletTransactionRequest, _ ...any) (*pb.WalletTransactionResponse, error) {
+						require.Equal(t, int32(2), req.GetUserId())
+						require.Equal(t, pb.WalletTransactionType_WALLET_TRANSACTION_TYPE_ADJUSTMENT, req.GetType())
+						require.Equal(t, int64(-150), req.GetAmount().GetAmount())
+						require.Equal(t, "USD", req.GetAmount().GetCurrency())
+						require.Equal(t, "duplicate top-up", req.GetReason())
+						require.Equal(t, int32(1), req.GetCreatedBy())
+						require.NotEmpty(t, req.GetIdempotencyKey())
+						return &pb.WalletTransactionResponse{Transaction: &pb.WalletTransaction{
+							ID:        9,
+							UserId:    2,
+							Type:      req.GetType(),
+							Amount:    req.GetAmount(),
+							Balance:   usd(350),
+							Reason:    req.GetReason(),
+							CreatedBy: req.GetCreatedBy(),
+						}}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var transaction WalletTransaction
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &transaction))
+				require.Equal(t, "adjustment", transaction.Type)
+				require.Equal(t, dollars(350), transaction.Balance)
+				require.Equal(t, int32(1), transaction.CreatedBy)
+			},
+		},
+		{
+			name: "NoReason",
+			role: pb.Role_ROLE_ADMIN,
+			body: gin.H{"member_id": 2, "amount": 150},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().CreateWalletTransaction(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "BelowZero",
+			role: pb.Role_ROLE_ADMIN,
+			body: gin.H{"member_id": 2, "amount": -900, "reason": "chargeback"},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(&pb.GetUserResponse{User: &pb.User{ID: 2}}, nil)
+				grpc.EXPECT().GetWallet(gomock.Any(), gomock.Any()).Return(&pb.WalletResponse{Wallet: &pb.Wallet{UserId: 2, Balance: usd(500)}}, nil)
+				grpc.EXPECT().CreateWalletTransaction(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.FailedPrecondition, "negative balance"))
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "NotAdmin",
+			role: pb.Role_ROLE_MEMBER,
+			body: gin.H{"member_id": 2, "amount": 150, "reason": "gift"},
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().CreateWalletTransaction(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			grpc := mockpb.NewMockGalaxyClient(ctrl)
+			token := expectAuthorize(grpc, 1, tc.role)
+			tc.buildStubs(grpc)
+
+			server := NewTestServer(t, grpc)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/admin/wallet/adjust", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthHeader(request, token)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestCreateEntryAPIPayWithWallet(t *testing.T) {
+	testCases := []struct {
+		name          string
+		buildStubs    func(grpc *mockpb.MockGalaxyClient)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetWallet(gomock.Any(), gomock.Any()).Return(&pb.WalletResponse{Wallet: &pb.Wallet{UserId: 1, Balance: usd(1500)}}, nil)
+				grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{Count: 1}}, nil)
+				grpc.EXPECT().CreateEntry(gomock.Any(), EqKeyedRequest(&pb.CreateEntryRequest{
+					UserId:         1,
+					ItemId:         3,
+					Quantity:       1,
+					Total:          usd(1000),
+					Discount:       usd(0),
+					Tax:            usd(0),
+					Status:         pb.EntryStatus_ENTRY_STATUS_PAID,
+					PaidFromWallet: true,
+					GiftCardAmount: usd(0),
+					PointsDiscount: usd(0),
+				})).Return(&pb.CreateEntryResponse{Entry: &pb.Entry{ID: 4, Total: usd(1000), Status: pb.EntryStatus_ENTRY_STATUS_PAID, PaidFromWallet: true}}, nil)
+				grpc.EXPECT().CreateWalletTransaction(gomock.Any(), gomock.Eq(&pb.CreateWalletTransactionRequest{
+					UserId:         1,
+					Type:           pb.WalletTransactionType_WALLET_TRANSACTION_TYPE_PURCHASE,
+					Amount:         usd(-1000),
+					EntryId:        4,
+					IdempotencyKey: "purchase:entry:4",
+				})).Return(&pb.WalletTransactionResponse{Transaction: &pb.WalletTransaction{ID: 8}}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var entry CreateEntryResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &entry))
+				require.Equal(t, "paid", entry.Status)
+				require.True(t, entry.PaidFromWallet)
+				require.Empty(t, entry.PaymentClientSecret)
+			},
+		},
+		{
+			name: "ChargedBefore",
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetWallet(gomock.Any(), gomock.Any()).Return(&pb.WalletResponse{Wallet: &pb.Wallet{UserId: 1, Balance: usd(1500)}}, nil)
+				grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{Count: 1}}, nil)
+				grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Return(&pb.CreateEntryResponse{Entry: &pb.Entry{ID: 4, Total: usd(1000), Status: pb.EntryStatus_ENTRY_STATUS_PAID, PaidFromWallet: true}}, nil)
+				grpc.EXPECT().CreateWalletTransaction(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.AlreadyExists, "key used"))
+				// the transaction made with the key is taken as the step's result
+				grpc.EXPECT().ListWalletTransactions(gomock.Any(), gomock.Eq(&pb.ListWalletTransactionsRequest{
+					UserId:         1,
+					Limit:          1,
+					IdempotencyKey: "purchase:entry:4",
+				})).Return(&pb.ListWalletTransactionsResponse{Transactions: []*pb.WalletTransaction{{ID: 8}}}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "InsufficientBalance",
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetWallet(gomock.Any(), gomock.Any()).Return(&pb.WalletResponse{Wallet: &pb.Wallet{UserId: 1, Balance: usd(999)}}, nil)
+				grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusPaymentRequired, recorder.Code)
+			},
+		},
+		{
+			name: "OtherCurrency",
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetWallet(gomock.Any(), gomock.Any()).Return(&pb.WalletResponse{Wallet: &pb.Wallet{UserId: 1, Balance: &pb.Money{Amount: 5000, Currency: "EUR"}}}, nil)
+				grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "SpentMeanwhile",
+			buildStubs: func(grpc *mockpb.MockGalaxyClient) {
+				grpc.EXPECT().GetWallet(gomock.Any(), gomock.Any()).Return(&pb.WalletResponse{Wallet: &pb.Wallet{UserId: 1, Balance: usd(1500)}}, nil)
+				gomock.InOrder(
+					grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{Count: 1}}, nil),
+					grpc.EXPECT().IncrementUsage(gomock.Any(), gomock.Any()).Return(&pb.IncrementUsageResponse{Usage: &pb.Usage{}}, nil),
+				)
+				grpc.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Return(&pb.CreateEntryResponse{Entry: &pb.Entry{ID: 4}}, nil)
+				grpc.EXPECT().CreateWalletTransaction(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.FailedPrecondition, "negative balance"))
+				grpc.EXPECT().DeleteEntry(gomock.Any(), gomock.Eq(&pb.DeleteEntryRequest{Id: 4})).Return(&pb.Empty{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusPaymentRequired, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			grpc := mockpb.NewMockGalaxyClient(ctrl)
+			expectSagas(grpc)
+			token := expectAuthorize(grpc, 1, pb.Role_ROLE_MEMBER)
+			grpc.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(&pb.GetUserResponse{User: &pb.User{ID: 1}}, nil)
+			grpc.EXPECT().GetItem(gomock.Any(), gomock.Any()).Return(&pb.GetItemResponse{Item: &pb.Item{ID: 3, Price: usd(1000)}}, nil)
+			grpc.EXPECT().GetPlan(gomock.Any(), gomock.Any()).Return(&pb.GetPlanResponse{Plan: &pb.Plan{}}, nil)
+			tc.buildStubs(grpc)
+
+			server := NewTestServer(t, grpc)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(gin.H{"member_id": 1, "item_id": 3, "quantity": 1, "total": 1000, "pay_with_wallet": true})
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/entry/create", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthHeader(request, token)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestUpdateEntryStatusAPIWalletRefund(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	token := expectAuthorize(grpc, 9, pb.Role_ROLE_STAFF)
+	entry := &pb.Entry{ID: 4, UserId: 1, Total: usd(1000), Status: pb.EntryStatus_ENTRY_STATUS_PAID, PaidFromWallet: true}
+	grpc.EXPECT().GetEntry(gomock.Any(), gomock.Any()).Return(&pb.GetEntryResponse{Entry: entry}, nil)
+	expectNoIssuedGiftCard(grpc)
+	gomock.InOrder(
+		grpc.EXPECT().CreateWalletTransaction(gomock.Any(), gomock.Eq(&pb.CreateWalletTransactionRequest{
+			UserId:         1,
+			Type:           pb.WalletTransactionType_WALLET_TRANSACTION_TYPE_REFUND,
+			Amount:         usd(1000),
+			EntryId:        4,
+			Reason:         "damaged",
+			CreatedBy:      9,
+			IdempotencyKey: "refund:entry:4",
+		})).Return(&pb.WalletTransactionResponse{}, nil),
+		grpc.EXPECT().UpdateEntryStatus(gomock.Any(), gomock.Any()).Return(&pb.UpdateEntryResponse{
+			Entry: &pb.Entry{ID: 4, UserId: 1, Total: usd(1000), Status: pb.EntryStatus_ENTRY_STATUS_REFUNDED, PaidFromWallet: true},
+		}, nil),
+	)
+
+	server := NewTestServer(t, grpc)
+	recorder := httptest.NewRecorder()
+
+	data, err := json.Marshal(gin.H{"id": 4, "status": "refunded", "reason": "damaged"})
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/entry/status", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	addAuthHeader(request, token)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+}
@@ -0,0 +1,54 @@
+package billing
+
+import (
+	"time"
+
+	"github.com/machearn/galaxy_controller/pb"
+)
+
+// Proration describes the money owed when switching plans mid-period.
+type Proration struct {
+	// Credit is the value of the unused part of the current period.
+	Credit int32
+	// Charge is what the user pays now, after the credit is applied.
+	Charge int32
+	// ExpiredAt is when the new subscription ends, or nil if it never does.
+	ExpiredAt *time.Time
+}
+
+// Prorate computes the cost of moving from one plan to another at now, given
+// the current subscription ends at expiredAt. The new plan starts a fresh
+// period immediately. Credit exceeding the price of the new plan is turned
+// into extra time on the new plan rather than refunded.
+func Prorate(from *pb.Plan, to *pb.Plan, expiredAt time.Time, now time.Time) Proration {
+	var proration Proration
+
+	if from.GetPrice() > 0 && from.GetDurationDays() > 0 && expiredAt.After(now) {
+		period := time.Duration(from.GetDurationDays()) * time.Hour * 24
+		remaining := expiredAt.Sub(now)
+		if remaining > period {
+			remaining = period
+		}
+		proration.Credit = int32(int64(from.GetPrice()) * int64(remaining) / int64(period))
+	}
+
+	if to.GetDurationDays() <= 0 {
+		// plans without an expiry date are free; leftover credit is forfeited
+		return proration
+	}
+
+	period := time.Duration(to.GetDurationDays()) * time.Hour * 24
+	end := now.Add(period)
+	if proration.Credit >= to.GetPrice() {
+		if to.GetPrice() > 0 {
+			extra := time.Duration(int64(period) * int64(proration.Credit-to.GetPrice()) / int64(to.GetPrice()))
+			end = end.Add(extra)
+		}
+	} else {
+		proration.Charge = to.GetPrice() - proration.Credit
+	}
+	end = end.Truncate(time.Second)
+	proration.ExpiredAt = &end
+
+	return proration
+}
@@ -0,0 +1,85 @@
+package billing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/machearn/galaxy_controller/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProrate(t *testing.T) {
+	now := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	free := &pb.Plan{ID: 0, Name: "free"}
+	basic := &pb.Plan{ID: 1, Name: "basic", Price: 1000, DurationDays: 30}
+	pro := &pb.Plan{ID: 2, Name: "pro", Price: 3000, DurationDays: 30}
+
+	testCases := []struct {
+		name      string
+		from      *pb.Plan
+		to        *pb.Plan
+		expiredAt time.Time
+		credit    int32
+		charge    int32
+		expiry    *time.Time
+	}{
+		{
+			name:      "upgrade from free",
+			from:      free,
+			to:        pro,
+			expiredAt: time.Time{},
+			credit:    0,
+			charge:    3000,
+			expiry:    timePtr(now.AddDate(0, 0, 30)),
+		},
+		{
+			name:      "upgrade half way through",
+			from:      basic,
+			to:        pro,
+			expiredAt: now.AddDate(0, 0, 15),
+			credit:    500,
+			charge:    2500,
+			expiry:    timePtr(now.AddDate(0, 0, 30)),
+		},
+		{
+			name:      "downgrade turns credit into time",
+			from:      pro,
+			to:        basic,
+			expiredAt: now.AddDate(0, 0, 15),
+			credit:    1500,
+			charge:    0,
+			expiry:    timePtr(now.AddDate(0, 0, 45)),
+		},
+		{
+			name:      "downgrade to free",
+			from:      pro,
+			to:        free,
+			expiredAt: now.AddDate(0, 0, 15),
+			credit:    1500,
+			charge:    0,
+			expiry:    nil,
+		},
+		{
+			name:      "expired subscription",
+			from:      basic,
+			to:        pro,
+			expiredAt: now.AddDate(0, 0, -3),
+			credit:    0,
+			charge:    3000,
+			expiry:    timePtr(now.AddDate(0, 0, 30)),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			proration := Prorate(tc.from, tc.to, tc.expiredAt, now)
+			require.Equal(t, tc.credit, proration.Credit)
+			require.Equal(t, tc.charge, proration.Charge)
+			require.Equal(t, tc.expiry, proration.ExpiredAt)
+		})
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,476 @@
+package billing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/machearn/galaxy_controller/notify"
+	"github.com/machearn/galaxy_controller/payments"
+	"github.com/machearn/galaxy_controller/pb"
+	"github.com/machearn/galaxy_controller/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	leaseName    = "billing-scheduler"
+	userPageSize = 100
+)
+
+// Scheduler renews subscriptions that are about to expire for users and
+// teams with auto-renew enabled and reminds trial users before their trial
+// ends.
+// Several controller replicas may run a scheduler; a lease held in the
+// backend makes sure only one of them charges at a time.
+type Scheduler struct {
+	grpc     pb.GalaxyClient
+	provider payments.Provider
+	notifier notify.Notifier
+	config   util.Config
+	holder   string
+	now      func() time.Time
+}
+
+func NewScheduler(config util.Config, grpc pb.GalaxyClient, provider payments.Provider, notifier notify.Notifier) *Scheduler {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "controller"
+	}
+
+	return &Scheduler{
+		grpc:     grpc,
+		provider: provider,
+		notifier: notifier,
+		config:   config,
+		holder:   fmt.Sprintf("%s-%s", hostname, util.GetRandomString(8)),
+		now:      time.Now,
+	}
+}
+
+// Run renews subscriptions every BILLING_INTERVAL until ctx is cancelled.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.config.BillingInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.RunOnce(ctx); err != nil {
+			log.Printf("billing run failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce performs a single billing pass if this replica holds the lease.
+func (s *Scheduler) RunOnce(ctx context.Context) error {
+	leader, err := s.acquireLease(ctx)
+	if err != nil {
+		return err
+	}
+	if !leader {
+		return nil
+	}
+
+	plans := make(map[int32]*pb.Plan)
+
+	trialUsers, err := s.listEndingTrials(ctx)
+	if err != nil {
+		return err
+	}
+	for _, user := range trialUsers {
+		plan, err := s.plan(ctx, plans, user.GetPlan())
+		if err != nil {
+			log.Printf("failed to load plan %d for user %d: %v", user.GetPlan(), user.GetID(), err)
+			continue
+		}
+
+		if err := s.remindTrial(ctx, user, plan); err != nil {
+			log.Printf("failed to send trial reminder to user %d: %v", user.GetID(), err)
+		}
+	}
+
+	users, err := s.listExpiringUsers(ctx)
+	if err != nil {
+		return err
+	}
+	for _, user := range users {
+		plan, err := s.plan(ctx, plans, user.GetPlan())
+		if err != nil {
+			log.Printf("failed to load plan %d for user %d: %v", user.GetPlan(), user.GetID(), err)
+			continue
+		}
+
+		if err := s.renew(ctx, user, plan); err != nil {
+			log.Printf("failed to renew subscription of user %d: %v", user.GetID(), err)
+		}
+	}
+
+	teams, err := s.listExpiringTeams(ctx)
+	if err != nil {
+		return err
+	}
+	for _, team := range teams {
+		plan, err := s.plan(ctx, plans, team.GetPlan())
+		if err != nil {
+			log.Printf("failed to load plan %d for team %d: %v", team.GetPlan(), team.GetID(), err)
+			continue
+		}
+
+		if err := s.renewTeam(ctx, team, plan); err != nil {
+			log.Printf("failed to renew subscription of team %d: %v", team.GetID(), err)
+		}
+	}
+	return nil
+}
+
+// plan returns the plan with the given id, loading it at most once per run.
+func (s *Scheduler) plan(ctx context.Context, plans map[int32]*pb.Plan, id int32) (*pb.Plan, error) {
+	if plan, ok := plans[id]; ok {
+		return plan, nil
+	}
+
+	result, err := s.grpc.GetPlan(ctx, &pb.GetPlanRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	plans[id] = result.GetPlan()
+	return result.GetPlan(), nil
+}
+
+func (s *Scheduler) acquireLease(ctx context.Context) (bool, error) {
+	grpcReq := pb.AcquireLeaseRequest{
+		Name:       leaseName,
+		Holder:     s.holder,
+		TtlSeconds: int32(2 * s.config.BillingInterval / time.Second),
+	}
+
+	result, err := s.grpc.AcquireLease(ctx, &grpcReq)
+	if err != nil {
+		return false, err
+	}
+	return result.GetAcquired(), nil
+}
+
+// listExpiringUsers collects every auto-renewing user whose subscription ends
+// within the renew window. The list is collected up front because renewing a
+// user moves them out of the filter and would shift later pages.
+func (s *Scheduler) listExpiringUsers(ctx context.Context) ([]*pb.User, error) {
+	autoRenew := true
+	var users []*pb.User
+
+	for offset := int32(0); ; offset += userPageSize {
+		grpcReq := pb.ListUsersRequest{
+			AutoRenew:     &autoRenew,
+			ExpiredBefore: timestamppb.New(s.now().Add(s.config.BillingRenewWindow)),
+			Offset:        offset,
+			Limit:         userPageSize,
+		}
+
+		result, err := s.grpc.ListUsers(ctx, &grpcReq)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, result.GetUsers()...)
+		if len(result.GetUsers()) < userPageSize {
+			return users, nil
+		}
+	}
+}
+
+// listExpiringTeams collects every team whose subscription ends within the
+// renew window, up front for the same reason as listExpiringUsers.
+func (s *Scheduler) listExpiringTeams(ctx context.Context) ([]*pb.Team, error) {
+	var teams []*pb.Team
+
+	for offset := int32(0); ; offset += userPageSize {
+		grpcReq := pb.ListTeamsRequest{
+			ExpiredBefore: timestamppb.New(s.now().Add(s.config.BillingRenewWindow)),
+			Offset:        offset,
+			Limit:         userPageSize,
+		}
+
+		result, err := s.grpc.ListTeams(ctx, &grpcReq)
+		if err != nil {
+			return nil, err
+		}
+
+		teams = append(teams, result.GetTeams()...)
+		if len(result.GetTeams()) < userPageSize {
+			return teams, nil
+		}
+	}
+}
+
+// listEndingTrials collects every trial user whose trial ends within
+// TRIAL_REMINDER_BEFORE, regardless of auto-renew.
+func (s *Scheduler) listEndingTrials(ctx context.Context) ([]*pb.User, error) {
+	trial := true
+	now := s.now()
+	var users []*pb.User
+
+	for offset := int32(0); ; offset += userPageSize {
+		grpcReq := pb.ListUsersRequest{
+			Trial:         &trial,
+			ExpiredAfter:  timestamppb.New(now),
+			ExpiredBefore: timestamppb.New(now.Add(s.config.TrialReminderBefore)),
+			Offset:        offset,
+			Limit:         userPageSize,
+		}
+
+		result, err := s.grpc.ListUsers(ctx, &grpcReq)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, result.GetUsers()...)
+		if len(result.GetUsers()) < userPageSize {
+			return users, nil
+		}
+	}
+}
+
+// remindTrial emails the user once that their trial is about to end and
+// whether it will convert to a paid subscription.
+func (s *Scheduler) remindTrial(ctx context.Context, user *pb.User, plan *pb.Plan) error {
+	email := util.NormalizeEmail(user.GetEmail())
+
+	result, err := s.grpc.GetTrial(ctx, &pb.GetTrialRequest{Email: email})
+	if err != nil {
+		return err
+	}
+	if result.GetTrial().GetRemindedAt() != nil {
+		return nil
+	}
+
+	converts := false
+	if user.GetAutoRenew() {
+		converts, err = s.provider.HasPaymentMethod(ctx, user.GetID())
+		if err != nil {
+			return err
+		}
+	}
+
+	var next string
+	switch {
+	case converts:
+		next = fmt.Sprintf("Your subscription will then renew automatically and your payment method will be charged %d.", plan.GetPrice())
+	case user.GetAutoRenew():
+		next = "Add a payment method before then to keep your subscription; without one it will not be renewed."
+	default:
+		next = "Auto-renew is off, so your subscription will end with the trial unless you subscribe."
+	}
+
+	msg := notify.Message{
+		To:      user.GetEmail(),
+		Subject: fmt.Sprintf("Your %s trial is ending soon", plan.GetName()),
+		Body: fmt.Sprintf("Hi %s,\n\nyour free trial of the %s plan ends on %s.\n%s\n",
+			user.GetFullname(), plan.GetName(), user.GetExpiredAt().AsTime().Format(time.RFC1123), next),
+	}
+	if err := s.notifier.Notify(ctx, msg); err != nil {
+		return err
+	}
+
+	_, err = s.grpc.UpdateTrial(ctx, &pb.UpdateTrialRequest{
+		Email:      email,
+		RemindedAt: timestamppb.New(s.now()),
+	})
+	return err
+}
+
+// renew charges the user for the next period of their plan and extends their
+// subscription. Failed charges are recorded and retried with exponential
+// backoff until BILLING_MAX_ATTEMPTS is reached. Trials are only converted to
+// a paid subscription when the user has a payment method on file. The charge
+// is refunded if the user changed their subscription while it was renewed.
+func (s *Scheduler) renew(ctx context.Context, user *pb.User, plan *pb.Plan) error {
+	if user.GetStatus().GetState() != pb.UserState_USER_STATE_ACTIVE || plan.GetDurationDays() <= 0 {
+		return nil
+	}
+	if user.GetTeamId() != 0 {
+		// the team pays for its members, see renewTeam
+		return nil
+	}
+
+	if user.GetTrial() {
+		ok, err := s.provider.HasPaymentMethod(ctx, user.GetID())
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+	}
+
+	attempt := &pb.CreateBillingAttemptRequest{
+		UserId:    user.GetID(),
+		PlanId:    plan.GetID(),
+		Amount:    int64(plan.GetPrice()),
+		PeriodEnd: user.GetExpiredAt(),
+	}
+	charged, err := s.charge(ctx, attempt, fmt.Sprintf("%s subscription renewal", plan.GetName()), fmt.Sprintf("renewal:%d:%d", user.GetID(), user.GetExpiredAt().AsTime().Unix()))
+	if err != nil || !charged {
+		return err
+	}
+
+	err = s.extend(ctx, user, plan)
+	if status.Code(err) != codes.FailedPrecondition {
+		return err
+	}
+	// the user changed their subscription since it was listed, so the
+	// renewal they were charged for no longer applies
+	log.Printf("subscription of user %d changed during its renewal", user.GetID())
+	if attempt.GetChargeId() == "" {
+		return nil
+	}
+	_, err = s.provider.Refund(ctx, attempt.GetChargeId(), 0)
+	if errors.Is(err, payments.ErrInvalidState) {
+		// refunded already
+		return nil
+	}
+	return err
+}
+
+// renewTeam charges the team's owner for every seat of the next period of
+// the team's plan and extends the team's subscription, which is also the
+// subscription of its members. Teams are renewed while their owner has
+// auto-renew enabled, with the same retries as renew.
+func (s *Scheduler) renewTeam(ctx context.Context, team *pb.Team, plan *pb.Plan) error {
+	if plan.GetDurationDays() <= 0 {
+		return nil
+	}
+
+	owner, err := s.grpc.GetUser(ctx, &pb.GetUserRequest{ID: team.GetOwnerId()})
+	if err != nil {
+		return err
+	}
+	if owner.GetUser().GetStatus().GetState() != pb.UserState_USER_STATE_ACTIVE || !owner.GetUser().GetAutoRenew() {
+		return nil
+	}
+
+	charged, err := s.charge(ctx, &pb.CreateBillingAttemptRequest{
+		UserId:    team.GetOwnerId(),
+		TeamId:    team.GetID(),
+		PlanId:    plan.GetID(),
+		Amount:    int64(plan.GetPrice()) * int64(team.GetSeats()),
+		PeriodEnd: team.GetExpiredAt(),
+	}, fmt.Sprintf("%s plan renewal for %d seats", plan.GetName(), team.GetSeats()), fmt.Sprintf("team-renewal:%d:%d", team.GetID(), team.GetExpiredAt().AsTime().Unix()))
+	if err != nil || !charged {
+		return err
+	}
+
+	_, err = s.grpc.RenewTeam(ctx, &pb.RenewTeamRequest{
+		ID:        team.GetID(),
+		ExpiredAt: s.nextPeriodEnd(team.GetExpiredAt(), plan),
+	})
+	return err
+}
+
+// charge collects attempt.Amount from attempt.UserId for the period ending
+// at attempt.PeriodEnd and records the attempt. It reports true if the
+// period is paid for, including by an earlier attempt whose subscription was
+// not extended, and false if the charge failed too often or too recently to
+// be tried now.
+func (s *Scheduler) charge(ctx context.Context, attempt *pb.CreateBillingAttemptRequest, description string, key string) (bool, error) {
+	attempts, err := s.grpc.ListBillingAttempts(ctx, &pb.ListBillingAttemptsRequest{
+		UserId:    attempt.GetUserId(),
+		TeamId:    attempt.GetTeamId(),
+		PeriodEnd: attempt.GetPeriodEnd(),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	failures := 0
+	var lastAttempt time.Time
+	for _, previous := range attempts.GetAttempts() {
+		if previous.GetSuccess() {
+			// charged already but the subscription was not extended
+			attempt.ChargeId = previous.GetChargeId()
+			return true, nil
+		}
+		failures++
+		if previous.GetCreatedAt().AsTime().After(lastAttempt) {
+			lastAttempt = previous.GetCreatedAt().AsTime()
+		}
+	}
+	if failures >= s.config.BillingMaxAttempts {
+		return false, nil
+	}
+	if failures > 0 && s.now().Before(lastAttempt.Add(s.backoff(failures))) {
+		return false, nil
+	}
+
+	if attempt.GetAmount() > 0 {
+		charge, err := s.provider.Charge(ctx, payments.ChargeRequest{
+			UserID:         attempt.GetUserId(),
+			Amount:         attempt.GetAmount(),
+			Currency:       s.config.PaymentsCurrency,
+			Description:    description,
+			IdempotencyKey: fmt.Sprintf("%s:%d", key, failures),
+		})
+		if err != nil {
+			attempt.Error = err.Error()
+			if _, recordErr := s.grpc.CreateBillingAttempt(ctx, attempt); recordErr != nil {
+				return false, errors.Join(err, recordErr)
+			}
+			return false, err
+		}
+		attempt.ChargeId = charge.ID
+	}
+
+	attempt.Success = true
+	if _, err := s.grpc.CreateBillingAttempt(ctx, attempt); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// backoff returns how long to wait after the given number of failed attempts.
+func (s *Scheduler) backoff(failures int) time.Duration {
+	return s.config.BillingRetryBackoff * time.Duration(1<<(failures-1))
+}
+
+// extend moves the subscription end forward by one period. It fails with
+// FAILED_PRECONDITION if the user is no longer on plan or their subscription
+// was extended since user was read, so a change made in the meantime is not
+// undone. ChangePlan also ends a trial, so converted trials are marked as
+// such.
+func (s *Scheduler) extend(ctx context.Context, user *pb.User, plan *pb.Plan) error {
+	planID := plan.GetID()
+	_, err := s.grpc.ChangePlan(ctx, &pb.ChangePlanRequest{
+		UserId:            user.GetID(),
+		PlanId:            planID,
+		ExpiredAt:         s.nextPeriodEnd(user.GetExpiredAt(), plan),
+		ExpectedPlanId:    &planID,
+		ExpectedExpiredAt: user.GetExpiredAt(),
+	})
+	if err != nil || !user.GetTrial() {
+		return err
+	}
+
+	_, err = s.grpc.UpdateTrial(ctx, &pb.UpdateTrialRequest{
+		Email:       util.NormalizeEmail(user.GetEmail()),
+		ConvertedAt: timestamppb.New(s.now()),
+	})
+	return err
+}
+
+// nextPeriodEnd returns the end of the period of plan that follows the one
+// ending at expiredAt, or that starts now if that one has already ended.
+func (s *Scheduler) nextPeriodEnd(expiredAt *timestamppb.Timestamp, plan *pb.Plan) *timestamppb.Timestamp {
+	start := expiredAt.AsTime()
+	if now := s.now(); start.Before(now) {
+		start = now
+	}
+	return timestamppb.New(start.AddDate(0, 0, int(plan.GetDurationDays())))
+}
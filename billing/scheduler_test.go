@@ -0,0 +1,327 @@
+package billing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/machearn/galaxy_controller/notify"
+	"github.com/machearn/galaxy_controller/payments"
+	"github.com/machearn/galaxy_controller/pb"
+	mockpb "github.com/machearn/galaxy_controller/pb/mock"
+	"github.com/machearn/galaxy_controller/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newTestScheduler(grpc pb.GalaxyClient, provider payments.Provider, now time.Time) *Scheduler {
+	config := util.Config{
+		BillingInterval:     time.Minute * 10,
+		BillingRenewWindow:  time.Hour * 72,
+		BillingRetryBackoff: time.Hour * 6,
+		BillingMaxAttempts:  3,
+		TrialReminderBefore: time.Hour * 72,
+	}
+
+	scheduler := NewScheduler(config, grpc, provider, notify.LogNotifier{})
+	scheduler.now = func() time.Time { return now }
+	return scheduler
+}
+
+type recordingNotifier struct {
+	messages []notify.Message
+}
+
+func (n *recordingNotifier) Notify(ctx context.Context, msg notify.Message) error {
+	n.messages = append(n.messages, msg)
+	return nil
+}
+
+// expectUsers expects one billing pass listing trialUsers for reminders and
+// then users for renewal.
+func expectUsers(grpc *mockpb.MockGalaxyClient, trialUsers []*pb.User, users []*pb.User) {
+	expectPass(grpc, trialUsers, users, nil)
+}
+
+// expectPass expects one billing pass listing trialUsers for reminders and
+// then users and teams for renewal.
+func expectPass(grpc *mockpb.MockGalaxyClient, trialUsers []*pb.User, users []*pb.User, teams []*pb.Team) {
+	grpc.EXPECT().AcquireLease(gomock.Any(), gomock.Any()).Return(&pb.AcquireLeaseResponse{Acquired: true}, nil)
+	gomock.InOrder(
+		grpc.EXPECT().ListUsers(gomock.Any(), gomock.Any()).Return(&pb.ListUsersResponse{Users: trialUsers}, nil),
+		grpc.EXPECT().ListUsers(gomock.Any(), gomock.Any()).Return(&pb.ListUsersResponse{Users: users}, nil),
+		grpc.EXPECT().ListTeams(gomock.Any(), gomock.Any()).Return(&pb.ListTeamsResponse{Teams: teams}, nil),
+	)
+	grpc.EXPECT().GetPlan(gomock.Any(), gomock.Eq(&pb.GetPlanRequest{Id: 2})).Return(&pb.GetPlanResponse{
+		Plan: &pb.Plan{ID: 2, Name: "pro", Price: 999, DurationDays: 30, TrialDays: 14},
+	}, nil)
+}
+
+// planID is the plan of the users in these tests.
+var planID int32 = 2
+
+func expectExpiringUsers(grpc *mockpb.MockGalaxyClient, users ...*pb.User) {
+	expectUsers(grpc, nil, users)
+}
+
+func TestRunOnceRenewsSubscription(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	expired := now.Add(time.Hour * 24)
+	user := &pb.User{ID: 1, Plan: 2, AutoRenew: true, ExpiredAt: timestamppb.New(expired)}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	expectExpiringUsers(grpc, user)
+	grpc.EXPECT().ListBillingAttempts(gomock.Any(), gomock.Any()).Return(&pb.ListBillingAttemptsResponse{}, nil)
+	grpc.EXPECT().CreateBillingAttempt(gomock.Any(), gomock.Eq(&pb.CreateBillingAttemptRequest{
+		UserId:    1,
+		PlanId:    2,
+		Amount:    999,
+		PeriodEnd: timestamppb.New(expired),
+		Success:   true,
+		ChargeId:  "pi_fake_1",
+	})).Return(&pb.CreateBillingAttemptResponse{}, nil)
+	grpc.EXPECT().ChangePlan(gomock.Any(), gomock.Eq(&pb.ChangePlanRequest{
+		UserId:            1,
+		PlanId:            2,
+		ExpiredAt:         timestamppb.New(expired.AddDate(0, 0, 30)),
+		ExpectedPlanId:    &planID,
+		ExpectedExpiredAt: timestamppb.New(expired),
+	})).Return(&pb.UpdateUserResponse{}, nil)
+
+	provider := payments.NewFakeProvider()
+	err := newTestScheduler(grpc, provider, now).RunOnce(context.Background())
+	require.NoError(t, err)
+	require.Len(t, provider.Charges(), 1)
+	require.Equal(t, int64(999), provider.Charges()[0].Amount)
+}
+
+func TestRunOnceRefundsChangedSubscription(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	expired := now.Add(time.Hour * 24)
+	user := &pb.User{ID: 1, Plan: 2, AutoRenew: true, ExpiredAt: timestamppb.New(expired)}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	expectExpiringUsers(grpc, user)
+	grpc.EXPECT().ListBillingAttempts(gomock.Any(), gomock.Any()).Return(&pb.ListBillingAttemptsResponse{}, nil)
+	grpc.EXPECT().CreateBillingAttempt(gomock.Any(), gomock.Any()).Return(&pb.CreateBillingAttemptResponse{}, nil)
+	// the user moved to another plan after the users were listed
+	grpc.EXPECT().ChangePlan(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.FailedPrecondition, "plan changed"))
+
+	provider := payments.NewFakeProvider()
+	err := newTestScheduler(grpc, provider, now).RunOnce(context.Background())
+	require.NoError(t, err)
+	require.Len(t, provider.Charges(), 1)
+
+	// the whole charge went back, so nothing is left to refund
+	_, err = provider.Refund(context.Background(), "pi_fake_1", 0)
+	require.ErrorIs(t, err, payments.ErrInvalidState)
+}
+
+func TestRunOnceRecordsDeclinedCharge(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	expired := now.Add(time.Hour * 24)
+	user := &pb.User{ID: 1, Plan: 2, AutoRenew: true, ExpiredAt: timestamppb.New(expired)}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	expectExpiringUsers(grpc, user)
+	grpc.EXPECT().ListBillingAttempts(gomock.Any(), gomock.Any()).Return(&pb.ListBillingAttemptsResponse{}, nil)
+	grpc.EXPECT().CreateBillingAttempt(gomock.Any(), gomock.Eq(&pb.CreateBillingAttemptRequest{
+		UserId:    1,
+		PlanId:    2,
+		Amount:    999,
+		PeriodEnd: timestamppb.New(expired),
+		Error:     payments.ErrDeclined.Error(),
+	})).Return(&pb.CreateBillingAttemptResponse{}, nil)
+	grpc.EXPECT().ChangePlan(gomock.Any(), gomock.Any()).Times(0)
+
+	provider := payments.NewFakeProvider()
+	provider.DeclineUser(1)
+	err := newTestScheduler(grpc, provider, now).RunOnce(context.Background())
+	require.NoError(t, err)
+}
+
+func TestRunOnceBacksOffAfterFailures(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	expired := now.Add(time.Hour * 24)
+	user := &pb.User{ID: 1, Plan: 2, AutoRenew: true, ExpiredAt: timestamppb.New(expired)}
+
+	// two failures mean the next attempt waits 12 hours after the last one
+	attempts := &pb.ListBillingAttemptsResponse{
+		Attempts: []*pb.BillingAttempt{
+			{UserId: 1, Error: "declined", CreatedAt: timestamppb.New(now.Add(-time.Hour * 20))},
+			{UserId: 1, Error: "declined", CreatedAt: timestamppb.New(now.Add(-time.Hour * 11))},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	expectExpiringUsers(grpc, user)
+	grpc.EXPECT().ListBillingAttempts(gomock.Any(), gomock.Eq(&pb.ListBillingAttemptsRequest{
+		UserId:    1,
+		PeriodEnd: timestamppb.New(expired),
+	})).Return(attempts, nil)
+	grpc.EXPECT().CreateBillingAttempt(gomock.Any(), gomock.Any()).Times(0)
+
+	provider := payments.NewFakeProvider()
+	err := newTestScheduler(grpc, provider, now).RunOnce(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, provider.Charges())
+}
+
+func TestRunOnceSkipsWithoutLease(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().AcquireLease(gomock.Any(), gomock.Any()).Return(&pb.AcquireLeaseResponse{Acquired: false, Holder: "other"}, nil)
+	grpc.EXPECT().ListUsers(gomock.Any(), gomock.Any()).Times(0)
+
+	err := newTestScheduler(grpc, payments.NewFakeProvider(), time.Now()).RunOnce(context.Background())
+	require.NoError(t, err)
+}
+
+func TestRunOnceConvertsTrial(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	expired := now.Add(time.Hour * 24)
+	user := &pb.User{ID: 1, Email: "Alice@Example.com", Plan: 2, AutoRenew: true, Trial: true, ExpiredAt: timestamppb.New(expired)}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	expectUsers(grpc, []*pb.User{user}, []*pb.User{user})
+	grpc.EXPECT().GetTrial(gomock.Any(), gomock.Eq(&pb.GetTrialRequest{Email: "alice@example.com"})).
+		Return(&pb.GetTrialResponse{Trial: &pb.Trial{ID: 1, Email: "alice@example.com", UserId: 1, PlanId: 2}}, nil)
+	grpc.EXPECT().UpdateTrial(gomock.Any(), gomock.Eq(&pb.UpdateTrialRequest{
+		Email:      "alice@example.com",
+		RemindedAt: timestamppb.New(now),
+	})).Return(&pb.UpdateTrialResponse{}, nil)
+	grpc.EXPECT().ListBillingAttempts(gomock.Any(), gomock.Any()).Return(&pb.ListBillingAttemptsResponse{}, nil)
+	grpc.EXPECT().CreateBillingAttempt(gomock.Any(), gomock.Any()).Return(&pb.CreateBillingAttemptResponse{}, nil)
+	grpc.EXPECT().ChangePlan(gomock.Any(), gomock.Eq(&pb.ChangePlanRequest{
+		UserId:            1,
+		PlanId:            2,
+		ExpiredAt:         timestamppb.New(expired.AddDate(0, 0, 30)),
+		ExpectedPlanId:    &planID,
+		ExpectedExpiredAt: timestamppb.New(expired),
+	})).Return(&pb.UpdateUserResponse{}, nil)
+	grpc.EXPECT().UpdateTrial(gomock.Any(), gomock.Eq(&pb.UpdateTrialRequest{
+		Email:       "alice@example.com",
+		ConvertedAt: timestamppb.New(now),
+	})).Return(&pb.UpdateTrialResponse{}, nil)
+
+	provider := payments.NewFakeProvider()
+	provider.AddPaymentMethod(1)
+	notifier := &recordingNotifier{}
+	scheduler := newTestScheduler(grpc, provider, now)
+	scheduler.notifier = notifier
+
+	err := scheduler.RunOnce(context.Background())
+	require.NoError(t, err)
+	require.Len(t, provider.Charges(), 1)
+	require.Len(t, notifier.messages, 1)
+	require.Equal(t, "Alice@Example.com", notifier.messages[0].To)
+	require.Contains(t, notifier.messages[0].Body, "renew automatically")
+}
+
+func TestRunOnceSkipsTrialWithoutPaymentMethod(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	expired := now.Add(time.Hour * 24)
+	user := &pb.User{ID: 1, Email: "alice@example.com", Plan: 2, AutoRenew: true, Trial: true, ExpiredAt: timestamppb.New(expired)}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	expectUsers(grpc, []*pb.User{user}, []*pb.User{user})
+	grpc.EXPECT().GetTrial(gomock.Any(), gomock.Any()).
+		Return(&pb.GetTrialResponse{Trial: &pb.Trial{ID: 1, Email: "alice@example.com", RemindedAt: timestamppb.New(now.Add(-time.Hour))}}, nil)
+	grpc.EXPECT().UpdateTrial(gomock.Any(), gomock.Any()).Times(0)
+	grpc.EXPECT().ListBillingAttempts(gomock.Any(), gomock.Any()).Times(0)
+	grpc.EXPECT().ChangePlan(gomock.Any(), gomock.Any()).Times(0)
+
+	provider := payments.NewFakeProvider()
+	notifier := &recordingNotifier{}
+	scheduler := newTestScheduler(grpc, provider, now)
+	scheduler.notifier = notifier
+
+	err := scheduler.RunOnce(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, provider.Charges())
+	require.Empty(t, notifier.messages)
+}
+
+func TestRunOnceRenewsTeam(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	expired := now.Add(time.Hour * 24)
+	team := &pb.Team{ID: 7, OwnerId: 1, Plan: 2, Seats: 5, ExpiredAt: timestamppb.New(expired)}
+	// members are renewed with their team, not on their own
+	member := &pb.User{ID: 2, Plan: 2, TeamId: 7, AutoRenew: true, ExpiredAt: timestamppb.New(expired)}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	expectPass(grpc, nil, []*pb.User{member}, []*pb.Team{team})
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Eq(&pb.GetUserRequest{ID: 1})).Return(&pb.GetUserResponse{User: &pb.User{ID: 1, TeamId: 7, AutoRenew: true}}, nil)
+	grpc.EXPECT().ListBillingAttempts(gomock.Any(), gomock.Eq(&pb.ListBillingAttemptsRequest{
+		UserId:    1,
+		TeamId:    7,
+		PeriodEnd: timestamppb.New(expired),
+	})).Return(&pb.ListBillingAttemptsResponse{}, nil)
+	grpc.EXPECT().CreateBillingAttempt(gomock.Any(), gomock.Eq(&pb.CreateBillingAttemptRequest{
+		UserId:    1,
+		TeamId:    7,
+		PlanId:    2,
+		Amount:    999 * 5,
+		PeriodEnd: timestamppb.New(expired),
+		Success:   true,
+		ChargeId:  "pi_fake_1",
+	})).Return(&pb.CreateBillingAttemptResponse{}, nil)
+	grpc.EXPECT().RenewTeam(gomock.Any(), gomock.Eq(&pb.RenewTeamRequest{
+		ID:        7,
+		ExpiredAt: timestamppb.New(expired.AddDate(0, 0, 30)),
+	})).Return(&pb.TeamResponse{}, nil)
+	grpc.EXPECT().ChangePlan(gomock.Any(), gomock.Any()).Times(0)
+
+	provider := payments.NewFakeProvider()
+	err := newTestScheduler(grpc, provider, now).RunOnce(context.Background())
+	require.NoError(t, err)
+	require.Len(t, provider.Charges(), 1)
+	require.Equal(t, int32(1), provider.Charges()[0].UserID)
+	require.Equal(t, int64(999*5), provider.Charges()[0].Amount)
+}
+
+func TestRunOnceSkipsTeamWithoutAutoRenew(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	expired := now.Add(time.Hour * 24)
+	team := &pb.Team{ID: 7, OwnerId: 1, Plan: 2, Seats: 5, ExpiredAt: timestamppb.New(expired)}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	expectPass(grpc, nil, nil, []*pb.Team{team})
+	grpc.EXPECT().GetUser(gomock.Any(), gomock.Eq(&pb.GetUserRequest{ID: 1})).Return(&pb.GetUserResponse{User: &pb.User{ID: 1, TeamId: 7}}, nil)
+	grpc.EXPECT().CreateBillingAttempt(gomock.Any(), gomock.Any()).Times(0)
+	grpc.EXPECT().RenewTeam(gomock.Any(), gomock.Any()).Times(0)
+
+	provider := payments.NewFakeProvider()
+	err := newTestScheduler(grpc, provider, now).RunOnce(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, provider.Charges())
+}
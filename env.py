@@ -26,8 +26,12 @@ def write_env_file(filename, env):
 
 def main():
     env = read_env_file('app.env')
+    env['ENVIRONMENT'] = 'production'
     env['GRPC_SERVER_ADDRESS'] = get_env_variable('GALAXY_GRPC_SERVER_ADDRESS')
     env['TOKEN_SYMMETRIC_KEY'] = get_env_variable('GALAXY_TOKEN_SYMMETRIC_KEY')
+    env['PAYMENTS_PROVIDER'] = get_env_variable('GALAXY_PAYMENTS_PROVIDER')
+    env['PAYMENTS_WEBHOOK_SECRET'] = get_env_variable('GALAXY_PAYMENTS_WEBHOOK_SECRET')
+    env['STRIPE_SECRET_KEY'] = get_env_variable('GALAXY_STRIPE_SECRET_KEY')
     write_env_file('app.env', env)
 
 if __name__ == '__main__':
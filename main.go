@@ -1,9 +1,12 @@
 package main
 
 import (
+	"context"
 	"log"
 
 	"github.com/machearn/galaxy_controller/api"
+	"github.com/machearn/galaxy_controller/billing"
+	"github.com/machearn/galaxy_controller/notify"
 	"github.com/machearn/galaxy_controller/pb"
 	"github.com/machearn/galaxy_controller/util"
 	"google.golang.org/grpc"
@@ -27,6 +30,12 @@ func main() {
 		log.Fatal("Failed to create server: ", err)
 	}
 
+	scheduler := billing.NewScheduler(config, grpc, server.Payments(), notify.NewNotifier(config))
+	go scheduler.Run(context.Background())
+
+	go server.RecoverSagas(context.Background())
+	go server.ExpirePoints(context.Background())
+
 	if err := server.Start(config.HTTPServerAddress); err != nil {
 		log.Fatal("Failed to start server: ", err)
 	}
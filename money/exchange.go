@@ -0,0 +1,124 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+)
+
+// ErrUnsupportedCurrency is returned for currencies without an exchange
+// rate.
+var ErrUnsupportedCurrency = errors.New("unsupported currency")
+
+// Rates is the content of the exchange rates file: how many units of each
+// currency one unit of Base buys. Rates are kept as decimal strings so they
+// are used exactly as written.
+type Rates struct {
+	Base  string                 `json:"base"`
+	Rates map[string]json.Number `json:"rates"`
+}
+
+// Exchange converts between currencies at fixed rates.
+type Exchange struct {
+	base  string
+	rates map[string]*big.Rat
+}
+
+// NewExchange checks rates and returns an exchange using them.
+func NewExchange(rates Rates) (*Exchange, error) {
+	exchange := Exchange{
+		base:  NormalizeCurrency(rates.Base),
+		rates: make(map[string]*big.Rat, len(rates.Rates)+1),
+	}
+	if len(exchange.base) == 0 {
+		return nil, errors.New("exchange rates need a base currency")
+	}
+
+	for currency, rate := range rates.Rates {
+		value, ok := new(big.Rat).SetString(rate.String())
+		if !ok || value.Sign() <= 0 {
+			return nil, fmt.Errorf("exchange rate of %s must be a positive number", currency)
+		}
+		exchange.rates[NormalizeCurrency(currency)] = value
+	}
+	exchange.rates[exchange.base] = big.NewRat(1, 1)
+
+	return &exchange, nil
+}
+
+// LoadRates reads the exchange rates from a JSON file. No path means only
+// base is supported.
+func LoadRates(path string, base string) (*Exchange, error) {
+	if len(path) == 0 {
+		return NewExchange(Rates{Base: base})
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read exchange rates: %w", err)
+	}
+
+	var rates Rates
+	if err := json.Unmarshal(data, &rates); err != nil {
+		return nil, fmt.Errorf("cannot parse exchange rates %s: %w", path, err)
+	}
+	return NewExchange(rates)
+}
+
+// Base is the currency rates are quoted against.
+func (e *Exchange) Base() string {
+	return e.base
+}
+
+// Supports reports whether amounts can be converted to and from currency.
+func (e *Exchange) Supports(currency string) bool {
+	_, ok := e.rates[NormalizeCurrency(currency)]
+	return ok
+}
+
+// Convert returns m in currency, rounded half away from zero to the minor
+// unit of currency.
+func (e *Exchange) Convert(m Money, currency string) (Money, error) {
+	currency = NormalizeCurrency(currency)
+	from := NormalizeCurrency(m.Currency)
+	if from == currency {
+		return New(m.Amount, currency), nil
+	}
+
+	fromRate, ok := e.rates[from]
+	if !ok {
+		return Money{}, fmt.Errorf("%w %s", ErrUnsupportedCurrency, from)
+	}
+	toRate, ok := e.rates[currency]
+	if !ok {
+		return Money{}, fmt.Errorf("%w %s", ErrUnsupportedCurrency, currency)
+	}
+
+	// amount / 10^fromExponent / fromRate * toRate * 10^toExponent
+	value := new(big.Rat).SetInt64(m.Amount)
+	value.Mul(value, toRate)
+	value.Quo(value, fromRate)
+	value.Mul(value, new(big.Rat).SetFrac(pow10(Exponent(currency)), pow10(Exponent(from))))
+
+	return New(round(value), currency), nil
+}
+
+func pow10(exponent int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exponent)), nil)
+}
+
+// round rounds value half away from zero.
+func round(value *big.Rat) int64 {
+	num := new(big.Int).Abs(value.Num())
+	den := value.Denom()
+	quotient, remainder := new(big.Int).QuoRem(num, den, new(big.Int))
+	if remainder.Mul(remainder, big.NewInt(2)).Cmp(den) >= 0 {
+		quotient.Add(quotient, big.NewInt(1))
+	}
+	if value.Sign() < 0 {
+		quotient.Neg(quotient)
+	}
+	return quotient.Int64()
+}
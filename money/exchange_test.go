@@ -0,0 +1,76 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestExchange(t *testing.T) *Exchange {
+	exchange, err := NewExchange(Rates{
+		Base:  "usd",
+		Rates: map[string]json.Number{"EUR": "0.5", "jpy": "150", "KWD": "0.25"},
+	})
+	require.NoError(t, err)
+	return exchange
+}
+
+func TestConvert(t *testing.T) {
+	exchange := newTestExchange(t)
+
+	testCases := []struct {
+		name     string
+		from     Money
+		currency string
+		to       Money
+	}{
+		{name: "Same", from: New(1999, "usd"), currency: "USD", to: New(1999, "USD")},
+		{name: "FromBase", from: New(1999, "USD"), currency: "EUR", to: New(1000, "EUR")},
+		{name: "ToBase", from: New(1000, "EUR"), currency: "usd", to: New(2000, "USD")},
+		{name: "Cross", from: New(1000, "EUR"), currency: "JPY", to: New(3000, "JPY")},
+		{name: "ZeroDecimals", from: New(3001, "JPY"), currency: "USD", to: New(2001, "USD")},
+		{name: "ThreeDecimals", from: New(100, "USD"), currency: "KWD", to: New(250, "KWD")},
+		{name: "Negative", from: New(-1999, "USD"), currency: "EUR", to: New(-1000, "EUR")},
+		{name: "Large", from: New(5_000_000_000_00, "USD"), currency: "JPY", to: New(750_000_000_000, "JPY")},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			to, err := exchange.Convert(tc.from, tc.currency)
+			require.NoError(t, err)
+			require.Equal(t, tc.to, to)
+		})
+	}
+
+	_, err := exchange.Convert(New(100, "USD"), "GBP")
+	require.True(t, errors.Is(err, ErrUnsupportedCurrency))
+	require.True(t, exchange.Supports("eur"))
+	require.False(t, exchange.Supports("GBP"))
+}
+
+func TestLoadRates(t *testing.T) {
+	exchange, err := LoadRates("", "usd")
+	require.NoError(t, err)
+	require.Equal(t, "USD", exchange.Base())
+	require.False(t, exchange.Supports("EUR"))
+
+	path := filepath.Join(t.TempDir(), "exchange_rates.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"base": "EUR", "rates": {"USD": 2}}`), 0o600))
+
+	exchange, err = LoadRates(path, "usd")
+	require.NoError(t, err)
+	require.Equal(t, "EUR", exchange.Base())
+
+	converted, err := exchange.Convert(New(300, "USD"), "EUR")
+	require.NoError(t, err)
+	require.Equal(t, New(150, "EUR"), converted)
+
+	_, err = NewExchange(Rates{Base: "EUR", Rates: map[string]json.Number{"USD": "0"}})
+	require.Error(t, err)
+}
@@ -0,0 +1,72 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/machearn/galaxy_controller/pb"
+)
+
+// Money is an amount in the minor units of an ISO 4217 currency, such as
+// cents for USD.
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// New returns amount minor units of currency.
+func New(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: NormalizeCurrency(currency)}
+}
+
+// FromProto converts m, treating nil as zero without a currency.
+func FromProto(m *pb.Money) Money {
+	return Money{Amount: m.GetAmount(), Currency: m.GetCurrency()}
+}
+
+func (m Money) Proto() *pb.Money {
+	return &pb.Money{Amount: m.Amount, Currency: m.Currency}
+}
+
+func (m Money) String() string {
+	return fmt.Sprintf("%d %s", m.Amount, m.Currency)
+}
+
+// NormalizeCurrency upper-cases a currency code.
+func NormalizeCurrency(currency string) string {
+	return strings.ToUpper(strings.TrimSpace(currency))
+}
+
+// exponents lists the ISO 4217 currencies whose minor unit is not a
+// hundredth of the major unit.
+var exponents = map[string]int{
+	"BHD": 3,
+	"CLP": 0,
+	"ISK": 0,
+	"JOD": 3,
+	"JPY": 0,
+	"KRW": 0,
+	"KWD": 3,
+	"OMR": 3,
+	"TND": 3,
+	"VND": 0,
+}
+
+// Exponent returns the number of decimals of the minor unit of currency.
+func Exponent(currency string) int {
+	if exponent, ok := exponents[NormalizeCurrency(currency)]; ok {
+		return exponent
+	}
+	return 2
+}
+
+// Find returns the amount in currency out of prices.
+func Find(prices []*pb.Money, currency string) (Money, bool) {
+	currency = NormalizeCurrency(currency)
+	for _, price := range prices {
+		if NormalizeCurrency(price.GetCurrency()) == currency {
+			return New(price.GetAmount(), currency), true
+		}
+	}
+	return Money{}, false
+}
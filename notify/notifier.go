@@ -0,0 +1,62 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/smtp"
+	"strings"
+
+	"github.com/machearn/galaxy_controller/util"
+)
+
+// Message is a plain-text email.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Notifier delivers messages to users.
+type Notifier interface {
+	Notify(ctx context.Context, msg Message) error
+}
+
+// NewNotifier returns an SMTP notifier when an SMTP server is configured and
+// a log notifier otherwise, which is convenient for local development.
+func NewNotifier(config util.Config) Notifier {
+	if len(config.SMTPAddress) == 0 {
+		return LogNotifier{}
+	}
+	return NewSMTPNotifier(config.SMTPAddress, config.SMTPUsername, config.SMTPPassword, config.EmailSender)
+}
+
+type LogNotifier struct{}
+
+func (LogNotifier) Notify(ctx context.Context, msg Message) error {
+	log.Printf("notify %s: %s\n%s", msg.To, msg.Subject, msg.Body)
+	return nil
+}
+
+type SMTPNotifier struct {
+	address string
+	auth    smtp.Auth
+	sender  string
+}
+
+func NewSMTPNotifier(address, username, password, sender string) *SMTPNotifier {
+	notifier := &SMTPNotifier{
+		address: address,
+		sender:  sender,
+	}
+	if len(username) > 0 {
+		host, _, _ := strings.Cut(address, ":")
+		notifier.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return notifier
+}
+
+func (n *SMTPNotifier) Notify(ctx context.Context, msg Message) error {
+	body := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\n\r\n%s\r\n", n.sender, msg.To, msg.Subject, msg.Body)
+	return smtp.SendMail(n.address, n.auth, n.sender, []string{msg.To}, []byte(body))
+}
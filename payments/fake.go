@@ -0,0 +1,198 @@
+package payments
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// FakeProvider is an in-memory Provider for tests and local development.
+// Intents are authorized as soon as they are created and charges succeed
+// unless the user has been marked as declining.
+type FakeProvider struct {
+	mu             sync.Mutex
+	intents        map[string]*Intent
+	keys           map[string]string
+	refunded       map[string]int64
+	declining      bool
+	charges        []ChargeRequest
+	decliningUsers map[int32]bool
+	paymentMethods map[int32]bool
+}
+
+func NewFakeProvider() *FakeProvider {
+	return &FakeProvider{
+		intents:        make(map[string]*Intent),
+		keys:           make(map[string]string),
+		refunded:       make(map[string]int64),
+		decliningUsers: make(map[int32]bool),
+		paymentMethods: make(map[int32]bool),
+	}
+}
+
+// Decline makes every future intent fail to authorize and every future
+// charge fail.
+func (p *FakeProvider) Decline() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.declining = true
+}
+
+// DeclineUser makes every future charge for the user fail with ErrDeclined.
+func (p *FakeProvider) DeclineUser(userID int32) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.decliningUsers[userID] = true
+}
+
+// AddPaymentMethod marks the user as having a payment method on file.
+func (p *FakeProvider) AddPaymentMethod(userID int32) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.paymentMethods[userID] = true
+}
+
+// Charges returns every charge request the provider has received.
+func (p *FakeProvider) Charges() []ChargeRequest {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]ChargeRequest(nil), p.charges...)
+}
+
+// Intent returns a copy of the intent with the given id.
+func (p *FakeProvider) Intent(id string) (Intent, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	intent, ok := p.intents[id]
+	if !ok {
+		return Intent{}, false
+	}
+	return *intent, true
+}
+
+func (p *FakeProvider) CreateIntent(ctx context.Context, req CreateIntentRequest) (*Intent, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if id, ok := p.keys[req.IdempotencyKey]; ok && len(req.IdempotencyKey) > 0 {
+		intent := *p.intents[id]
+		return &intent, nil
+	}
+	if p.declining {
+		return nil, ErrDeclined
+	}
+
+	intent := p.newIntent(req.Amount, req.Currency, IntentRequiresCapture, req.IdempotencyKey)
+	intent.Metadata = req.Metadata
+
+	res := *intent
+	return &res, nil
+}
+
+func (p *FakeProvider) Charge(ctx context.Context, req ChargeRequest) (*Intent, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.charges = append(p.charges, req)
+	if id, ok := p.keys[req.IdempotencyKey]; ok && len(req.IdempotencyKey) > 0 {
+		intent := *p.intents[id]
+		return &intent, nil
+	}
+	if p.declining || p.decliningUsers[req.UserID] {
+		return nil, ErrDeclined
+	}
+
+	res := *p.newIntent(req.Amount, req.Currency, IntentSucceeded, req.IdempotencyKey)
+	return &res, nil
+}
+
+func (p *FakeProvider) HasPaymentMethod(ctx context.Context, userID int32) (bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.paymentMethods[userID], nil
+}
+
+// newIntent stores a new intent. p.mu must be held.
+func (p *FakeProvider) newIntent(amount int64, currency string, status IntentStatus, idempotencyKey string) *Intent {
+	id := fmt.Sprintf("pi_fake_%d", len(p.intents)+1)
+	intent := &Intent{
+		ID:           id,
+		Amount:       amount,
+		Currency:     currency,
+		Status:       status,
+		ClientSecret: id + "_secret",
+	}
+	p.intents[id] = intent
+	p.keys[idempotencyKey] = id
+	return intent
+}
+
+func (p *FakeProvider) Capture(ctx context.Context, intentID string) (*Intent, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	intent, ok := p.intents[intentID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	switch intent.Status {
+	case IntentSucceeded:
+	case IntentRequiresCapture:
+		intent.Status = IntentSucceeded
+	default:
+		return nil, ErrInvalidState
+	}
+
+	res := *intent
+	return &res, nil
+}
+
+func (p *FakeProvider) Cancel(ctx context.Context, intentID string) (*Intent, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	intent, ok := p.intents[intentID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	switch intent.Status {
+	case IntentCanceled:
+	case IntentRequiresCapture:
+		intent.Status = IntentCanceled
+	default:
+		return nil, ErrInvalidState
+	}
+
+	res := *intent
+	return &res, nil
+}
+
+func (p *FakeProvider) Refund(ctx context.Context, intentID string, amount int64) (*Refund, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	intent, ok := p.intents[intentID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	if intent.Status != IntentSucceeded {
+		return nil, ErrInvalidState
+	}
+
+	remaining := intent.Amount - p.refunded[intentID]
+	if amount == 0 {
+		amount = remaining
+	}
+	if amount <= 0 || amount > remaining {
+		return nil, ErrInvalidState
+	}
+	p.refunded[intentID] += amount
+
+	return &Refund{
+		ID:       fmt.Sprintf("re_fake_%s_%d", intentID, p.refunded[intentID]),
+		IntentID: intentID,
+		Amount:   amount,
+		Status:   "succeeded",
+	}, nil
+}
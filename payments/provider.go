@@ -0,0 +1,116 @@
+package payments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/machearn/galaxy_controller/util"
+)
+
+var (
+	// ErrDeclined is returned when the customer's payment method was refused.
+	ErrDeclined = errors.New("payment declined")
+	// ErrNotFound is returned for unknown payment intents.
+	ErrNotFound = errors.New("payment intent not found")
+	// ErrInvalidState is returned when an intent cannot be captured or
+	// refunded in its current state.
+	ErrInvalidState = errors.New("payment intent is in an invalid state")
+	// ErrNoPaymentMethod is returned together with ErrDeclined when a
+	// customer is charged off-session without a payment method on file.
+	ErrNoPaymentMethod = errors.New("no payment method on file")
+)
+
+type IntentStatus string
+
+const (
+	// IntentRequiresPayment means the customer has not confirmed a payment
+	// method yet.
+	IntentRequiresPayment IntentStatus = "requires_payment_method"
+	// IntentRequiresCapture means the payment is authorized and can be
+	// captured.
+	IntentRequiresCapture IntentStatus = "requires_capture"
+	IntentProcessing      IntentStatus = "processing"
+	IntentSucceeded       IntentStatus = "succeeded"
+	IntentCanceled        IntentStatus = "canceled"
+)
+
+// Intent is a payment the customer has been asked to make. Funds are only
+// authorized until the intent is captured.
+type Intent struct {
+	ID           string            `json:"id"`
+	Amount       int64             `json:"amount"`
+	Currency     string            `json:"currency"`
+	Status       IntentStatus      `json:"status"`
+	ClientSecret string            `json:"client_secret"`
+	Metadata     map[string]string `json:"metadata"`
+}
+
+type CreateIntentRequest struct {
+	// Amount is in the minor units of Currency.
+	Amount         int64
+	Currency       string
+	Description    string
+	IdempotencyKey string
+	Metadata       map[string]string
+}
+
+// ChargeRequest charges the payment method a user has on file while they are
+// not on the site, e.g. to renew a subscription.
+type ChargeRequest struct {
+	UserID int32
+	// Amount is in the minor units of Currency.
+	Amount         int64
+	Currency       string
+	Description    string
+	IdempotencyKey string
+}
+
+type Refund struct {
+	ID       string `json:"id"`
+	IntentID string `json:"payment_intent"`
+	Amount   int64  `json:"amount"`
+	Status   string `json:"status"`
+}
+
+// Provider collects payments for purchases and subscriptions. Intents for
+// purchases are created with manual capture so money is only taken once the
+// purchase is confirmed; subscriptions are charged to the payment method the
+// user has on file.
+type Provider interface {
+	CreateIntent(ctx context.Context, req CreateIntentRequest) (*Intent, error)
+	Capture(ctx context.Context, intentID string) (*Intent, error)
+	// Cancel releases the funds of an intent that has not been captured.
+	// Cancelling a cancelled intent succeeds.
+	Cancel(ctx context.Context, intentID string) (*Intent, error)
+	// Refund returns amount of a captured intent to the customer. An amount
+	// of 0 refunds whatever has not been refunded yet.
+	Refund(ctx context.Context, intentID string, amount int64) (*Refund, error)
+	// Charge takes money from the payment method the user has on file
+	// without them being present. Repeated requests with the same
+	// idempotency key are one charge.
+	Charge(ctx context.Context, req ChargeRequest) (*Intent, error)
+	HasPaymentMethod(ctx context.Context, userID int32) (bool, error)
+}
+
+// NewProvider returns the provider selected by PAYMENTS_PROVIDER. There is
+// no default and the fake provider is refused outside development, so
+// nothing is ever sold or renewed for free by accident.
+func NewProvider(config util.Config) (Provider, error) {
+	switch config.PaymentsProvider {
+	case "fake":
+		if !config.Development() {
+			return nil, errors.New("the fake payments provider can only be used in development")
+		}
+		return NewFakeProvider(), nil
+	case "stripe":
+		if len(config.StripeSecretKey) == 0 {
+			return nil, errors.New("STRIPE_SECRET_KEY is required by the stripe payments provider")
+		}
+		return NewStripeProvider(config.StripeAPIURL, config.StripeSecretKey), nil
+	case "":
+		return nil, errors.New("no payments provider configured, set PAYMENTS_PROVIDER")
+	default:
+		return nil, fmt.Errorf("unknown payments provider %q", config.PaymentsProvider)
+	}
+}
@@ -0,0 +1,35 @@
+package payments
+
+import (
+	"testing"
+
+	"github.com/machearn/galaxy_controller/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProvider(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config util.Config
+		ok     bool
+	}{
+		{name: "Fake", config: util.Config{Environment: "development", PaymentsProvider: "fake"}, ok: true},
+		{name: "FakeInProduction", config: util.Config{Environment: "production", PaymentsProvider: "fake"}},
+		{name: "Stripe", config: util.Config{PaymentsProvider: "stripe", StripeSecretKey: "sk_test"}, ok: true},
+		{name: "StripeWithoutKey", config: util.Config{PaymentsProvider: "stripe"}},
+		{name: "NotConfigured", config: util.Config{}},
+		{name: "Unknown", config: util.Config{PaymentsProvider: "paypal"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			provider, err := NewProvider(tc.config)
+			if !tc.ok {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, provider)
+		})
+	}
+}
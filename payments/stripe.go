@@ -0,0 +1,217 @@
+package payments
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// StripeProvider talks to the Stripe API, or any server implementing the
+// same payment intent and refund endpoints.
+type StripeProvider struct {
+	baseURL   string
+	secretKey string
+	client    *http.Client
+}
+
+func NewStripeProvider(baseURL string, secretKey string) *StripeProvider {
+	if len(baseURL) == 0 {
+		baseURL = "https://api.stripe.com"
+	}
+	return &StripeProvider{
+		baseURL:   strings.TrimSuffix(baseURL, "/"),
+		secretKey: secretKey,
+		client:    &http.Client{Timeout: time.Second * 30},
+	}
+}
+
+type stripeCustomer struct {
+	ID              string `json:"id"`
+	InvoiceSettings struct {
+		DefaultPaymentMethod string `json:"default_payment_method"`
+	} `json:"invoice_settings"`
+}
+
+type stripeError struct {
+	Error struct {
+		Type    string `json:"type"`
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func (p *StripeProvider) CreateIntent(ctx context.Context, req CreateIntentRequest) (*Intent, error) {
+	form := url.Values{}
+	form.Set("amount", strconv.FormatInt(req.Amount, 10))
+	form.Set("currency", strings.ToLower(req.Currency))
+	form.Set("capture_method", "manual")
+	if len(req.Description) > 0 {
+		form.Set("description", req.Description)
+	}
+	for key, value := range req.Metadata {
+		form.Set(fmt.Sprintf("metadata[%s]", key), value)
+	}
+
+	var intent Intent
+	if err := p.post(ctx, "/v1/payment_intents", form, req.IdempotencyKey, &intent); err != nil {
+		return nil, err
+	}
+	return &intent, nil
+}
+
+func (p *StripeProvider) Capture(ctx context.Context, intentID string) (*Intent, error) {
+	var intent Intent
+	path := fmt.Sprintf("/v1/payment_intents/%s/capture", url.PathEscape(intentID))
+	if err := p.post(ctx, path, url.Values{}, "capture:"+intentID, &intent); err != nil {
+		return nil, err
+	}
+	return &intent, nil
+}
+
+func (p *StripeProvider) Cancel(ctx context.Context, intentID string) (*Intent, error) {
+	var intent Intent
+	path := fmt.Sprintf("/v1/payment_intents/%s/cancel", url.PathEscape(intentID))
+	if err := p.post(ctx, path, url.Values{}, "cancel:"+intentID, &intent); err != nil {
+		return nil, err
+	}
+	return &intent, nil
+}
+
+func (p *StripeProvider) Refund(ctx context.Context, intentID string, amount int64) (*Refund, error) {
+	form := url.Values{}
+	form.Set("payment_intent", intentID)
+	if amount > 0 {
+		form.Set("amount", strconv.FormatInt(amount, 10))
+	}
+
+	var refund Refund
+	if err := p.post(ctx, "/v1/refunds", form, "", &refund); err != nil {
+		return nil, err
+	}
+	return &refund, nil
+}
+
+// customer returns the Stripe customer of the user, found by the user_id in
+// its metadata, or ErrNoPaymentMethod if the user has none.
+func (p *StripeProvider) customer(ctx context.Context, userID int32) (*stripeCustomer, error) {
+	query := url.Values{}
+	query.Set("query", fmt.Sprintf("metadata['user_id']:'%d'", userID))
+
+	var result struct {
+		Data []stripeCustomer `json:"data"`
+	}
+	if err := p.get(ctx, "/v1/customers/search?"+query.Encode(), &result); err != nil {
+		return nil, err
+	}
+	if len(result.Data) == 0 {
+		return nil, ErrNoPaymentMethod
+	}
+	return &result.Data[0], nil
+}
+
+func (p *StripeProvider) HasPaymentMethod(ctx context.Context, userID int32) (bool, error) {
+	customer, err := p.customer(ctx, userID)
+	if errors.Is(err, ErrNoPaymentMethod) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return len(customer.InvoiceSettings.DefaultPaymentMethod) > 0, nil
+}
+
+func (p *StripeProvider) Charge(ctx context.Context, req ChargeRequest) (*Intent, error) {
+	customer, err := p.customer(ctx, req.UserID)
+	if errors.Is(err, ErrNoPaymentMethod) {
+		return nil, errors.Join(ErrDeclined, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(customer.InvoiceSettings.DefaultPaymentMethod) == 0 {
+		return nil, errors.Join(ErrDeclined, ErrNoPaymentMethod)
+	}
+
+	form := url.Values{}
+	form.Set("amount", strconv.FormatInt(req.Amount, 10))
+	form.Set("currency", strings.ToLower(req.Currency))
+	form.Set("customer", customer.ID)
+	form.Set("payment_method", customer.InvoiceSettings.DefaultPaymentMethod)
+	form.Set("off_session", "true")
+	form.Set("confirm", "true")
+	if len(req.Description) > 0 {
+		form.Set("description", req.Description)
+	}
+
+	var intent Intent
+	if err := p.post(ctx, "/v1/payment_intents", form, req.IdempotencyKey, &intent); err != nil {
+		return nil, err
+	}
+	if intent.Status != IntentSucceeded && intent.Status != IntentProcessing {
+		// the customer would have to authenticate, which they cannot do
+		// while not on the site
+		return nil, fmt.Errorf("%w: payment intent %s is %s", ErrDeclined, intent.ID, intent.Status)
+	}
+	return &intent, nil
+}
+
+func (p *StripeProvider) get(ctx context.Context, path string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.baseURL+path, nil)
+	if err != nil {
+		return err
+	}
+	return p.do(req, out)
+}
+
+func (p *StripeProvider) post(ctx context.Context, path string, form url.Values, idempotencyKey string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if len(idempotencyKey) > 0 {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+	return p.do(req, out)
+}
+
+func (p *StripeProvider) do(req *http.Request, out any) error {
+	req.Header.Set("Authorization", "Bearer "+p.secretKey)
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode >= http.StatusBadRequest {
+		var apiErr stripeError
+		if err := json.Unmarshal(body, &apiErr); err != nil {
+			return fmt.Errorf("payments: unexpected status %d", res.StatusCode)
+		}
+		switch {
+		case apiErr.Error.Type == "card_error":
+			return fmt.Errorf("%w: %s", ErrDeclined, apiErr.Error.Message)
+		case apiErr.Error.Code == "resource_missing":
+			return fmt.Errorf("%w: %s", ErrNotFound, apiErr.Error.Message)
+		case apiErr.Error.Code == "payment_intent_unexpected_state" || apiErr.Error.Code == "charge_already_refunded":
+			return fmt.Errorf("%w: %s", ErrInvalidState, apiErr.Error.Message)
+		default:
+			return fmt.Errorf("payments: %s", apiErr.Error.Message)
+		}
+	}
+
+	return json.Unmarshal(body, out)
+}
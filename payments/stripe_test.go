@@ -0,0 +1,134 @@
+package payments
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStripeCreateIntent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/payment_intents", r.URL.Path)
+		require.Equal(t, "Bearer sk_test", r.Header.Get("Authorization"))
+		require.Equal(t, "entry:1", r.Header.Get("Idempotency-Key"))
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "500", r.PostForm.Get("amount"))
+		require.Equal(t, "usd", r.PostForm.Get("currency"))
+		require.Equal(t, "manual", r.PostForm.Get("capture_method"))
+		require.Equal(t, "1", r.PostForm.Get("metadata[entry_id]"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"pi_1","amount":500,"currency":"usd","status":"requires_payment_method","client_secret":"pi_1_secret","metadata":{"entry_id":"1"}}`))
+	}))
+	defer server.Close()
+
+	provider := NewStripeProvider(server.URL, "sk_test")
+	intent, err := provider.CreateIntent(context.Background(), CreateIntentRequest{
+		Amount:         500,
+		Currency:       "usd",
+		IdempotencyKey: "entry:1",
+		Metadata:       map[string]string{"entry_id": "1"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "pi_1", intent.ID)
+	require.Equal(t, IntentRequiresPayment, intent.Status)
+	require.Equal(t, "pi_1_secret", intent.ClientSecret)
+}
+
+func TestStripeErrors(t *testing.T) {
+	testCases := []struct {
+		name string
+		body string
+		err  error
+	}{
+		{name: "Declined", body: `{"error":{"type":"card_error","code":"card_declined","message":"Your card was declined."}}`, err: ErrDeclined},
+		{name: "NotFound", body: `{"error":{"type":"invalid_request_error","code":"resource_missing","message":"No such payment_intent"}}`, err: ErrNotFound},
+		{name: "InvalidState", body: `{"error":{"type":"invalid_request_error","code":"payment_intent_unexpected_state","message":"cannot capture"}}`, err: ErrInvalidState},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/v1/payment_intents/pi_1/capture", r.URL.Path)
+				w.WriteHeader(http.StatusPaymentRequired)
+				w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			_, err := NewStripeProvider(server.URL, "sk_test").Capture(context.Background(), "pi_1")
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestStripeCharge(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/customers/search":
+			require.Equal(t, http.MethodGet, r.Method)
+			require.Equal(t, "metadata['user_id']:'1'", r.URL.Query().Get("query"))
+			w.Write([]byte(`{"data":[{"id":"cus_1","invoice_settings":{"default_payment_method":"pm_1"}}]}`))
+		case "/v1/payment_intents":
+			require.Equal(t, "renewal:1", r.Header.Get("Idempotency-Key"))
+			require.NoError(t, r.ParseForm())
+			require.Equal(t, "999", r.PostForm.Get("amount"))
+			require.Equal(t, "cus_1", r.PostForm.Get("customer"))
+			require.Equal(t, "pm_1", r.PostForm.Get("payment_method"))
+			require.Equal(t, "true", r.PostForm.Get("off_session"))
+			require.Equal(t, "true", r.PostForm.Get("confirm"))
+			w.Write([]byte(`{"id":"pi_1","amount":999,"currency":"usd","status":"succeeded"}`))
+		default:
+			t.Fatalf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	provider := NewStripeProvider(server.URL, "sk_test")
+	ok, err := provider.HasPaymentMethod(context.Background(), 1)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	intent, err := provider.Charge(context.Background(), ChargeRequest{
+		UserID:         1,
+		Amount:         999,
+		Currency:       "USD",
+		IdempotencyKey: "renewal:1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "pi_1", intent.ID)
+}
+
+func TestStripeChargeNoCustomer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/customers/search", r.URL.Path)
+		w.Write([]byte(`{"data":[]}`))
+	}))
+	defer server.Close()
+
+	provider := NewStripeProvider(server.URL, "sk_test")
+	ok, err := provider.HasPaymentMethod(context.Background(), 1)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, err = provider.Charge(context.Background(), ChargeRequest{UserID: 1, Amount: 999, Currency: "usd"})
+	require.ErrorIs(t, err, ErrNoPaymentMethod)
+	require.ErrorIs(t, err, ErrDeclined)
+}
+
+func TestStripeChargeRequiresAction(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/customers/search" {
+			w.Write([]byte(`{"data":[{"id":"cus_1","invoice_settings":{"default_payment_method":"pm_1"}}]}`))
+			return
+		}
+		w.Write([]byte(`{"id":"pi_1","amount":999,"currency":"usd","status":"requires_action"}`))
+	}))
+	defer server.Close()
+
+	_, err := NewStripeProvider(server.URL, "sk_test").Charge(context.Background(), ChargeRequest{UserID: 1, Amount: 999, Currency: "usd"})
+	require.ErrorIs(t, err, ErrDeclined)
+}
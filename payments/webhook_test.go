@@ -0,0 +1,45 @@
+package payments
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyWebhook(t *testing.T) {
+	secret := "whsec_test"
+	payload := []byte(`{"id":"evt_1","type":"payment_intent.succeeded","data":{"object":{"id":"pi_1","amount":500,"status":"succeeded","metadata":{"entry_id":"3"}}}}`)
+	now := time.Now()
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+
+	testCases := []struct {
+		name    string
+		payload []byte
+		header  string
+		err     error
+	}{
+		{name: "OK", payload: payload, header: SignWebhook(payload, secret, now)},
+		{name: "RotatedSecret", payload: payload, header: fmt.Sprintf("t=%s,v1=%s,v1=%s", timestamp, webhookSignature(payload, "old", timestamp), webhookSignature(payload, secret, timestamp))},
+		{name: "WrongSecret", payload: payload, header: SignWebhook(payload, "other", now), err: ErrInvalidWebhook},
+		{name: "Tampered", payload: []byte(`{"id":"evt_1","type":"payment_intent.succeeded"}`), header: SignWebhook(payload, secret, now), err: ErrInvalidWebhook},
+		{name: "TooOld", payload: payload, header: SignWebhook(payload, secret, now.Add(-time.Hour)), err: ErrInvalidWebhook},
+		{name: "Malformed", payload: payload, header: "garbage", err: ErrInvalidWebhook},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			event, err := VerifyWebhook(tc.payload, tc.header, secret, now)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, EventIntentSucceeded, event.Type)
+			require.Equal(t, "pi_1", event.Data.Object.ID)
+			require.Equal(t, "3", event.Data.Object.Metadata["entry_id"])
+		})
+	}
+}
@@ -82,86 +82,399 @@ var file_galaxy_service_proto_rawDesc = []byte{
 	0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x72, 0x70, 0x63, 0x5f, 0x71, 0x75,
 	0x65, 0x72, 0x79, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x16, 0x72, 0x70, 0x63, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x72,
-	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x32, 0xb0, 0x09, 0x0a, 0x06, 0x47, 0x61, 0x6c, 0x61, 0x78, 0x79, 0x12, 0x3d, 0x0a, 0x0a, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x07, 0x47, 0x65,
-	0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x74,
-	0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x3a, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x2e,
-	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65,
-	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65,
-	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0a, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x2e, 0x0a,
-	0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x69,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f,
-	0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a,
-	0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x62,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e,
-	0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12,
-	0x12, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x11, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x55, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
-	0x12, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x10, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x41, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x6e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6e, 0x65,
-	0x77, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
-	0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x40, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
-	0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69,
-	0x65, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x4c, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
-	0x42, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e,
-	0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x32, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x16,
-	0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x65, 0x61, 0x72, 0x6e, 0x2f, 0x67, 0x61, 0x6c, 0x61, 0x78,
-	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x72, 0x70, 0x63, 0x5f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x18, 0x72, 0x70, 0x63, 0x5f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x5f, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x72, 0x70, 0x63, 0x5f, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x15, 0x72, 0x70, 0x63, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x72, 0x70, 0x63, 0x5f, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x72, 0x70, 0x63, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70,
+	0x6c, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x72, 0x70, 0x63, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x0f, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x19, 0x72, 0x70, 0x63, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x72, 0x70,
+	0x63, 0x5f, 0x74, 0x72, 0x69, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x72,
+	0x70, 0x63, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e,
+	0x72, 0x70, 0x63, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e,
+	0x72, 0x70, 0x63, 0x5f, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f,
+	0x72, 0x70, 0x63, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x0e, 0x72, 0x70, 0x63, 0x5f, 0x73, 0x61, 0x67, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x10, 0x72, 0x70, 0x63, 0x5f, 0x63, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x10, 0x72, 0x70, 0x63, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x69, 0x66, 0x74, 0x5f, 0x63, 0x61,
+	0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x72, 0x70, 0x63, 0x5f,
+	0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xa3, 0x2d, 0x0a,
+	0x06, 0x47, 0x61, 0x6c, 0x61, 0x78, 0x79, 0x12, 0x3d, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70,
+	0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x12, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x09,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x15, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x70,
+	0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x05, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x34, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3a, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x14, 0x2e,
+	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x10, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70,
+	0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x70, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x10, 0x52, 0x65,
+	0x6e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62,
+	0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x62,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x36, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x70, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x2e,
+	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70,
+	0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34,
+	0x0a, 0x07, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e,
+	0x73, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3d, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x15,
+	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3c, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x6c, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a,
+	0x0f, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70,
+	0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x41,
+	0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x62,
+	0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5b, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a,
+	0x13, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x54, 0x72,
+	0x69, 0x61, 0x6c, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x40, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x69, 0x61, 0x6c, 0x12,
+	0x16, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x69, 0x61, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x72, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a,
+	0x08, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x65, 0x61, 0x6d, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x62,
+	0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x31, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x12, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
+	0x2e, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x65, 0x61, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x43, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62,
+	0x2e, 0x54, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x65, 0x61, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x61, 0x6d,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3c, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x54, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x55,
+	0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x61,
+	0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x65,
+	0x61, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x54, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
+	0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a,
+	0x09, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x09, 0x52, 0x65, 0x6e,
+	0x65, 0x77, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6e, 0x65,
+	0x77, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70,
+	0x62, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x31, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x74, 0x12, 0x12, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x10, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x43, 0x61, 0x72, 0x74, 0x4c, 0x69,
+	0x6e, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x61, 0x72, 0x74, 0x4c,
+	0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2e,
+	0x0a, 0x09, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61, 0x72, 0x74, 0x12, 0x14, 0x2e, 0x70, 0x62,
+	0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3a,
+	0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x16, 0x2e,
+	0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x08, 0x47, 0x65,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62,
+	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3d, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x15,
+	0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x46, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09,
+	0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x61, 0x67, 0x61, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x61, 0x67, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x61, 0x67, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x61,
+	0x67, 0x61, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x61,
+	0x67, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53,
+	0x61, 0x67, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a,
+	0x09, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x67, 0x61, 0x73, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x67, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x67, 0x61, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x62,
+	0x2e, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x43, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x42, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75,
+	0x70, 0x6f, 0x6e, 0x42, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x75, 0x70, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x52, 0x65, 0x64, 0x65, 0x65,
+	0x6d, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x64,
+	0x65, 0x65, 0x6d, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x43, 0x6f, 0x75, 0x70,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x16,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x64, 0x65,
+	0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x75, 0x70, 0x6f, 0x6e, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x20, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x52,
+	0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x70, 0x6f,
+	0x6e, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x5e, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x61, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70,
+	0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x43, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x69, 0x66, 0x74,
+	0x43, 0x61, 0x72, 0x64, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x47, 0x69,
+	0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x42, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x42, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72,
+	0x64, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x69, 0x66,
+	0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x38, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72,
+	0x64, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x69, 0x66,
+	0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x70,
+	0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x52, 0x65, 0x64,
+	0x65, 0x65, 0x6d, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x12, 0x19, 0x2e, 0x70, 0x62,
+	0x2e, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x64, 0x65,
+	0x65, 0x6d, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x19, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x47,
+	0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x47, 0x69,
+	0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x64,
+	0x65, 0x65, 0x6d, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x69, 0x66,
+	0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x69,
+	0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70,
+	0x62, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x17, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x16, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x70,
+	0x62, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x34, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x08, 0x47, 0x65, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4c, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x42, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x42, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4c, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x32,
+	0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x16, 0x2e,
+	0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6d, 0x61, 0x63, 0x68, 0x65, 0x61, 0x72, 0x6e, 0x2f, 0x67, 0x61, 0x6c, 0x61, 0x78, 0x79,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -178,85 +491,322 @@ func file_galaxy_service_proto_rawDescGZIP() []byte {
 
 var file_galaxy_service_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_galaxy_service_proto_goTypes = []interface{}{
-	(*Empty)(nil),                    // 0: pb.Empty
-	(*CreateItemRequest)(nil),        // 1: pb.CreateItemRequest
-	(*GetItemRequest)(nil),           // 2: pb.GetItemRequest
-	(*ListItemsRequest)(nil),         // 3: pb.ListItemsRequest
-	(*UpdateItemRequest)(nil),        // 4: pb.UpdateItemRequest
-	(*DeleteItemRequest)(nil),        // 5: pb.DeleteItemRequest
-	(*LoginRequest)(nil),             // 6: pb.LoginRequest
-	(*CreateUserRequest)(nil),        // 7: pb.CreateUserRequest
-	(*CreateSessionRequest)(nil),     // 8: pb.CreateSessionRequest
-	(*GetUserRequest)(nil),           // 9: pb.GetUserRequest
-	(*GetUserByUsernameRequest)(nil), // 10: pb.GetUserByUsernameRequest
-	(*UpdateUserRequest)(nil),        // 11: pb.UpdateUserRequest
-	(*AuthRequest)(nil),              // 12: pb.AuthRequest
-	(*RenewAccessTokenRequest)(nil),  // 13: pb.RenewAccessTokenRequest
-	(*CreateEntryRequest)(nil),       // 14: pb.CreateEntryRequest
-	(*GetEntryRequest)(nil),          // 15: pb.GetEntryRequest
-	(*ListEntriesRequest)(nil),       // 16: pb.ListEntriesRequest
-	(*ListEntriesByUserRequest)(nil), // 17: pb.ListEntriesByUserRequest
-	(*ListEntriesByItemRequest)(nil), // 18: pb.ListEntriesByItemRequest
-	(*DeleteEntryRequest)(nil),       // 19: pb.DeleteEntryRequest
-	(*CreateItemResponse)(nil),       // 20: pb.CreateItemResponse
-	(*GetItemResponse)(nil),          // 21: pb.GetItemResponse
-	(*ListItemsResponse)(nil),        // 22: pb.ListItemsResponse
-	(*UpdateItemResponse)(nil),       // 23: pb.UpdateItemResponse
-	(*LoginResponse)(nil),            // 24: pb.LoginResponse
-	(*CreateUserResponse)(nil),       // 25: pb.CreateUserResponse
-	(*CreateSessionResponse)(nil),    // 26: pb.CreateSessionResponse
-	(*GetUserResponse)(nil),          // 27: pb.GetUserResponse
-	(*UpdateUserResponse)(nil),       // 28: pb.UpdateUserResponse
-	(*AuthResponse)(nil),             // 29: pb.AuthResponse
-	(*RenewAccessTokenResponse)(nil), // 30: pb.RenewAccessTokenResponse
-	(*CreateEntryResponse)(nil),      // 31: pb.CreateEntryResponse
-	(*GetEntryResponse)(nil),         // 32: pb.GetEntryResponse
-	(*ListEntriesResponse)(nil),      // 33: pb.ListEntriesResponse
+	(*Empty)(nil),                            // 0: pb.Empty
+	(*CreateItemRequest)(nil),                // 1: pb.CreateItemRequest
+	(*GetItemRequest)(nil),                   // 2: pb.GetItemRequest
+	(*ListItemsRequest)(nil),                 // 3: pb.ListItemsRequest
+	(*UpdateItemRequest)(nil),                // 4: pb.UpdateItemRequest
+	(*DeleteItemRequest)(nil),                // 5: pb.DeleteItemRequest
+	(*LoginRequest)(nil),                     // 6: pb.LoginRequest
+	(*CreateUserRequest)(nil),                // 7: pb.CreateUserRequest
+	(*CreateSessionRequest)(nil),             // 8: pb.CreateSessionRequest
+	(*GetUserRequest)(nil),                   // 9: pb.GetUserRequest
+	(*GetUserByUsernameRequest)(nil),         // 10: pb.GetUserByUsernameRequest
+	(*ListUsersRequest)(nil),                 // 11: pb.ListUsersRequest
+	(*UpdateUserRequest)(nil),                // 12: pb.UpdateUserRequest
+	(*UpdateUserStatusRequest)(nil),          // 13: pb.UpdateUserStatusRequest
+	(*DeleteUserRequest)(nil),                // 14: pb.DeleteUserRequest
+	(*AuthRequest)(nil),                      // 15: pb.AuthRequest
+	(*RenewAccessTokenRequest)(nil),          // 16: pb.RenewAccessTokenRequest
+	(*ListSessionsRequest)(nil),              // 17: pb.ListSessionsRequest
+	(*RevokeSessionRequest)(nil),             // 18: pb.RevokeSessionRequest
+	(*RevokeSessionsRequest)(nil),            // 19: pb.RevokeSessionsRequest
+	(*CreateSecurityEventRequest)(nil),       // 20: pb.CreateSecurityEventRequest
+	(*ListSecurityEventsRequest)(nil),        // 21: pb.ListSecurityEventsRequest
+	(*GetPlanRequest)(nil),                   // 22: pb.GetPlanRequest
+	(*ListPlansRequest)(nil),                 // 23: pb.ListPlansRequest
+	(*ChangePlanRequest)(nil),                // 24: pb.ChangePlanRequest
+	(*CreatePlanChangeRequest)(nil),          // 25: pb.CreatePlanChangeRequest
+	(*ListPlanChangesRequest)(nil),           // 26: pb.ListPlanChangesRequest
+	(*AcquireLeaseRequest)(nil),              // 27: pb.AcquireLeaseRequest
+	(*CreateBillingAttemptRequest)(nil),      // 28: pb.CreateBillingAttemptRequest
+	(*ListBillingAttemptsRequest)(nil),       // 29: pb.ListBillingAttemptsRequest
+	(*GetTrialRequest)(nil),                  // 30: pb.GetTrialRequest
+	(*UpdateTrialRequest)(nil),               // 31: pb.UpdateTrialRequest
+	(*IncrementUsageRequest)(nil),            // 32: pb.IncrementUsageRequest
+	(*GetUsageRequest)(nil),                  // 33: pb.GetUsageRequest
+	(*CreateTeamRequest)(nil),                // 34: pb.CreateTeamRequest
+	(*GetTeamRequest)(nil),                   // 35: pb.GetTeamRequest
+	(*ListTeamMembersRequest)(nil),           // 36: pb.ListTeamMembersRequest
+	(*AddTeamMemberRequest)(nil),             // 37: pb.AddTeamMemberRequest
+	(*UpdateTeamMemberRequest)(nil),          // 38: pb.UpdateTeamMemberRequest
+	(*RemoveTeamMemberRequest)(nil),          // 39: pb.RemoveTeamMemberRequest
+	(*CreateTeamInvitationRequest)(nil),      // 40: pb.CreateTeamInvitationRequest
+	(*GetTeamInvitationRequest)(nil),         // 41: pb.GetTeamInvitationRequest
+	(*AcceptTeamInvitationRequest)(nil),      // 42: pb.AcceptTeamInvitationRequest
+	(*ListTeamsRequest)(nil),                 // 43: pb.ListTeamsRequest
+	(*RenewTeamRequest)(nil),                 // 44: pb.RenewTeamRequest
+	(*GetCartRequest)(nil),                   // 45: pb.GetCartRequest
+	(*SetCartLineRequest)(nil),               // 46: pb.SetCartLineRequest
+	(*ClearCartRequest)(nil),                 // 47: pb.ClearCartRequest
+	(*CreateOrderRequest)(nil),               // 48: pb.CreateOrderRequest
+	(*GetOrderRequest)(nil),                  // 49: pb.GetOrderRequest
+	(*ListOrdersRequest)(nil),                // 50: pb.ListOrdersRequest
+	(*UpdateOrderStatusRequest)(nil),         // 51: pb.UpdateOrderStatusRequest
+	(*DeleteOrderRequest)(nil),               // 52: pb.DeleteOrderRequest
+	(*CreateSagaRequest)(nil),                // 53: pb.CreateSagaRequest
+	(*UpdateSagaRequest)(nil),                // 54: pb.UpdateSagaRequest
+	(*ListSagasRequest)(nil),                 // 55: pb.ListSagasRequest
+	(*CreateCouponRequest)(nil),              // 56: pb.CreateCouponRequest
+	(*GetCouponRequest)(nil),                 // 57: pb.GetCouponRequest
+	(*GetCouponByCodeRequest)(nil),           // 58: pb.GetCouponByCodeRequest
+	(*ListCouponsRequest)(nil),               // 59: pb.ListCouponsRequest
+	(*UpdateCouponRequest)(nil),              // 60: pb.UpdateCouponRequest
+	(*RedeemCouponRequest)(nil),              // 61: pb.RedeemCouponRequest
+	(*DeleteCouponRedemptionRequest)(nil),    // 62: pb.DeleteCouponRedemptionRequest
+	(*ListCouponRedemptionsRequest)(nil),     // 63: pb.ListCouponRedemptionsRequest
+	(*GetWalletRequest)(nil),                 // 64: pb.GetWalletRequest
+	(*CreateWalletTransactionRequest)(nil),   // 65: pb.CreateWalletTransactionRequest
+	(*ListWalletTransactionsRequest)(nil),    // 66: pb.ListWalletTransactionsRequest
+	(*CreateGiftCardRequest)(nil),            // 67: pb.CreateGiftCardRequest
+	(*GetGiftCardByCodeRequest)(nil),         // 68: pb.GetGiftCardByCodeRequest
+	(*GetGiftCardByEntryRequest)(nil),        // 69: pb.GetGiftCardByEntryRequest
+	(*DeleteGiftCardRequest)(nil),            // 70: pb.DeleteGiftCardRequest
+	(*RedeemGiftCardRequest)(nil),            // 71: pb.RedeemGiftCardRequest
+	(*ReverseGiftCardRedemptionRequest)(nil), // 72: pb.ReverseGiftCardRedemptionRequest
+	(*ListGiftCardRedemptionsRequest)(nil),   // 73: pb.ListGiftCardRedemptionsRequest
+	(*GetPointsBalanceRequest)(nil),          // 74: pb.GetPointsBalanceRequest
+	(*CreatePointsTransactionRequest)(nil),   // 75: pb.CreatePointsTransactionRequest
+	(*ListPointsTransactionsRequest)(nil),    // 76: pb.ListPointsTransactionsRequest
+	(*ExpirePointsRequest)(nil),              // 77: pb.ExpirePointsRequest
+	(*ConsumeTokenRequest)(nil),              // 78: pb.ConsumeTokenRequest
+	(*CreateEntryRequest)(nil),               // 79: pb.CreateEntryRequest
+	(*GetEntryRequest)(nil),                  // 80: pb.GetEntryRequest
+	(*ListEntriesRequest)(nil),               // 81: pb.ListEntriesRequest
+	(*ListEntriesByUserRequest)(nil),         // 82: pb.ListEntriesByUserRequest
+	(*ListEntriesByItemRequest)(nil),         // 83: pb.ListEntriesByItemRequest
+	(*UpdateEntryStatusRequest)(nil),         // 84: pb.UpdateEntryStatusRequest
+	(*DeleteEntryRequest)(nil),               // 85: pb.DeleteEntryRequest
+	(*CreateItemResponse)(nil),               // 86: pb.CreateItemResponse
+	(*GetItemResponse)(nil),                  // 87: pb.GetItemResponse
+	(*ListItemsResponse)(nil),                // 88: pb.ListItemsResponse
+	(*UpdateItemResponse)(nil),               // 89: pb.UpdateItemResponse
+	(*LoginResponse)(nil),                    // 90: pb.LoginResponse
+	(*CreateUserResponse)(nil),               // 91: pb.CreateUserResponse
+	(*CreateSessionResponse)(nil),            // 92: pb.CreateSessionResponse
+	(*GetUserResponse)(nil),                  // 93: pb.GetUserResponse
+	(*ListUsersResponse)(nil),                // 94: pb.ListUsersResponse
+	(*UpdateUserResponse)(nil),               // 95: pb.UpdateUserResponse
+	(*AuthResponse)(nil),                     // 96: pb.AuthResponse
+	(*RenewAccessTokenResponse)(nil),         // 97: pb.RenewAccessTokenResponse
+	(*ListSessionsResponse)(nil),             // 98: pb.ListSessionsResponse
+	(*RevokeSessionsResponse)(nil),           // 99: pb.RevokeSessionsResponse
+	(*ListSecurityEventsResponse)(nil),       // 100: pb.ListSecurityEventsResponse
+	(*GetPlanResponse)(nil),                  // 101: pb.GetPlanResponse
+	(*ListPlansResponse)(nil),                // 102: pb.ListPlansResponse
+	(*ListPlanChangesResponse)(nil),          // 103: pb.ListPlanChangesResponse
+	(*AcquireLeaseResponse)(nil),             // 104: pb.AcquireLeaseResponse
+	(*CreateBillingAttemptResponse)(nil),     // 105: pb.CreateBillingAttemptResponse
+	(*ListBillingAttemptsResponse)(nil),      // 106: pb.ListBillingAttemptsResponse
+	(*GetTrialResponse)(nil),                 // 107: pb.GetTrialResponse
+	(*UpdateTrialResponse)(nil),              // 108: pb.UpdateTrialResponse
+	(*IncrementUsageResponse)(nil),           // 109: pb.IncrementUsageResponse
+	(*GetUsageResponse)(nil),                 // 110: pb.GetUsageResponse
+	(*TeamResponse)(nil),                     // 111: pb.TeamResponse
+	(*ListTeamMembersResponse)(nil),          // 112: pb.ListTeamMembersResponse
+	(*TeamMemberResponse)(nil),               // 113: pb.TeamMemberResponse
+	(*TeamInvitationResponse)(nil),           // 114: pb.TeamInvitationResponse
+	(*ListTeamsResponse)(nil),                // 115: pb.ListTeamsResponse
+	(*CartResponse)(nil),                     // 116: pb.CartResponse
+	(*OrderResponse)(nil),                    // 117: pb.OrderResponse
+	(*ListOrdersResponse)(nil),               // 118: pb.ListOrdersResponse
+	(*SagaResponse)(nil),                     // 119: pb.SagaResponse
+	(*ListSagasResponse)(nil),                // 120: pb.ListSagasResponse
+	(*CouponResponse)(nil),                   // 121: pb.CouponResponse
+	(*ListCouponsResponse)(nil),              // 122: pb.ListCouponsResponse
+	(*RedeemCouponResponse)(nil),             // 123: pb.RedeemCouponResponse
+	(*ListCouponRedemptionsResponse)(nil),    // 124: pb.ListCouponRedemptionsResponse
+	(*WalletResponse)(nil),                   // 125: pb.WalletResponse
+	(*WalletTransactionResponse)(nil),        // 126: pb.WalletTransactionResponse
+	(*ListWalletTransactionsResponse)(nil),   // 127: pb.ListWalletTransactionsResponse
+	(*GiftCardResponse)(nil),                 // 128: pb.GiftCardResponse
+	(*RedeemGiftCardResponse)(nil),           // 129: pb.RedeemGiftCardResponse
+	(*ListGiftCardRedemptionsResponse)(nil),  // 130: pb.ListGiftCardRedemptionsResponse
+	(*PointsBalanceResponse)(nil),            // 131: pb.PointsBalanceResponse
+	(*PointsTransactionResponse)(nil),        // 132: pb.PointsTransactionResponse
+	(*ListPointsTransactionsResponse)(nil),   // 133: pb.ListPointsTransactionsResponse
+	(*ExpirePointsResponse)(nil),             // 134: pb.ExpirePointsResponse
+	(*CreateEntryResponse)(nil),              // 135: pb.CreateEntryResponse
+	(*GetEntryResponse)(nil),                 // 136: pb.GetEntryResponse
+	(*ListEntriesResponse)(nil),              // 137: pb.ListEntriesResponse
+	(*UpdateEntryResponse)(nil),              // 138: pb.UpdateEntryResponse
 }
 var file_galaxy_service_proto_depIdxs = []int32{
-	1,  // 0: pb.Galaxy.CreateItem:input_type -> pb.CreateItemRequest
-	2,  // 1: pb.Galaxy.GetItem:input_type -> pb.GetItemRequest
-	3,  // 2: pb.Galaxy.ListItems:input_type -> pb.ListItemsRequest
-	4,  // 3: pb.Galaxy.UpdateItem:input_type -> pb.UpdateItemRequest
-	5,  // 4: pb.Galaxy.DeleteItem:input_type -> pb.DeleteItemRequest
-	6,  // 5: pb.Galaxy.Login:input_type -> pb.LoginRequest
-	7,  // 6: pb.Galaxy.CreateUser:input_type -> pb.CreateUserRequest
-	8,  // 7: pb.Galaxy.CreateSession:input_type -> pb.CreateSessionRequest
-	9,  // 8: pb.Galaxy.GetUser:input_type -> pb.GetUserRequest
-	10, // 9: pb.Galaxy.GetUserByUsername:input_type -> pb.GetUserByUsernameRequest
-	11, // 10: pb.Galaxy.UpdateUser:input_type -> pb.UpdateUserRequest
-	12, // 11: pb.Galaxy.Authorize:input_type -> pb.AuthRequest
-	13, // 12: pb.Galaxy.RenewAccessToken:input_type -> pb.RenewAccessTokenRequest
-	14, // 13: pb.Galaxy.CreateEntry:input_type -> pb.CreateEntryRequest
-	15, // 14: pb.Galaxy.GetEntry:input_type -> pb.GetEntryRequest
-	16, // 15: pb.Galaxy.ListEntries:input_type -> pb.ListEntriesRequest
-	17, // 16: pb.Galaxy.ListEntriesByUser:input_type -> pb.ListEntriesByUserRequest
-	18, // 17: pb.Galaxy.ListEntriesByItem:input_type -> pb.ListEntriesByItemRequest
-	19, // 18: pb.Galaxy.DeleteEntry:input_type -> pb.DeleteEntryRequest
-	20, // 19: pb.Galaxy.CreateItem:output_type -> pb.CreateItemResponse
-	21, // 20: pb.Galaxy.GetItem:output_type -> pb.GetItemResponse
-	22, // 21: pb.Galaxy.ListItems:output_type -> pb.ListItemsResponse
-	23, // 22: pb.Galaxy.UpdateItem:output_type -> pb.UpdateItemResponse
-	0,  // 23: pb.Galaxy.DeleteItem:output_type -> pb.Empty
-	24, // 24: pb.Galaxy.Login:output_type -> pb.LoginResponse
-	25, // 25: pb.Galaxy.CreateUser:output_type -> pb.CreateUserResponse
-	26, // 26: pb.Galaxy.CreateSession:output_type -> pb.CreateSessionResponse
-	27, // 27: pb.Galaxy.GetUser:output_type -> pb.GetUserResponse
-	27, // 28: pb.Galaxy.GetUserByUsername:output_type -> pb.GetUserResponse
-	28, // 29: pb.Galaxy.UpdateUser:output_type -> pb.UpdateUserResponse
-	29, // 30: pb.Galaxy.Authorize:output_type -> pb.AuthResponse
-	30, // 31: pb.Galaxy.RenewAccessToken:output_type -> pb.RenewAccessTokenResponse
-	31, // 32: pb.Galaxy.CreateEntry:output_type -> pb.CreateEntryResponse
-	32, // 33: pb.Galaxy.GetEntry:output_type -> pb.GetEntryResponse
-	33, // 34: pb.Galaxy.ListEntries:output_type -> pb.ListEntriesResponse
-	33, // 35: pb.Galaxy.ListEntriesByUser:output_type -> pb.ListEntriesResponse
-	33, // 36: pb.Galaxy.ListEntriesByItem:output_type -> pb.ListEntriesResponse
-	0,  // 37: pb.Galaxy.DeleteEntry:output_type -> pb.Empty
-	19, // [19:38] is the sub-list for method output_type
-	0,  // [0:19] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	1,   // 0: pb.Galaxy.CreateItem:input_type -> pb.CreateItemRequest
+	2,   // 1: pb.Galaxy.GetItem:input_type -> pb.GetItemRequest
+	3,   // 2: pb.Galaxy.ListItems:input_type -> pb.ListItemsRequest
+	4,   // 3: pb.Galaxy.UpdateItem:input_type -> pb.UpdateItemRequest
+	5,   // 4: pb.Galaxy.DeleteItem:input_type -> pb.DeleteItemRequest
+	6,   // 5: pb.Galaxy.Login:input_type -> pb.LoginRequest
+	7,   // 6: pb.Galaxy.CreateUser:input_type -> pb.CreateUserRequest
+	8,   // 7: pb.Galaxy.CreateSession:input_type -> pb.CreateSessionRequest
+	9,   // 8: pb.Galaxy.GetUser:input_type -> pb.GetUserRequest
+	10,  // 9: pb.Galaxy.GetUserByUsername:input_type -> pb.GetUserByUsernameRequest
+	11,  // 10: pb.Galaxy.ListUsers:input_type -> pb.ListUsersRequest
+	12,  // 11: pb.Galaxy.UpdateUser:input_type -> pb.UpdateUserRequest
+	13,  // 12: pb.Galaxy.UpdateUserStatus:input_type -> pb.UpdateUserStatusRequest
+	14,  // 13: pb.Galaxy.DeleteUser:input_type -> pb.DeleteUserRequest
+	15,  // 14: pb.Galaxy.Authorize:input_type -> pb.AuthRequest
+	16,  // 15: pb.Galaxy.RenewAccessToken:input_type -> pb.RenewAccessTokenRequest
+	17,  // 16: pb.Galaxy.ListSessions:input_type -> pb.ListSessionsRequest
+	18,  // 17: pb.Galaxy.RevokeSession:input_type -> pb.RevokeSessionRequest
+	19,  // 18: pb.Galaxy.RevokeSessions:input_type -> pb.RevokeSessionsRequest
+	20,  // 19: pb.Galaxy.CreateSecurityEvent:input_type -> pb.CreateSecurityEventRequest
+	21,  // 20: pb.Galaxy.ListSecurityEvents:input_type -> pb.ListSecurityEventsRequest
+	22,  // 21: pb.Galaxy.GetPlan:input_type -> pb.GetPlanRequest
+	23,  // 22: pb.Galaxy.ListPlans:input_type -> pb.ListPlansRequest
+	24,  // 23: pb.Galaxy.ChangePlan:input_type -> pb.ChangePlanRequest
+	25,  // 24: pb.Galaxy.CreatePlanChange:input_type -> pb.CreatePlanChangeRequest
+	26,  // 25: pb.Galaxy.ListPlanChanges:input_type -> pb.ListPlanChangesRequest
+	27,  // 26: pb.Galaxy.AcquireLease:input_type -> pb.AcquireLeaseRequest
+	28,  // 27: pb.Galaxy.CreateBillingAttempt:input_type -> pb.CreateBillingAttemptRequest
+	29,  // 28: pb.Galaxy.ListBillingAttempts:input_type -> pb.ListBillingAttemptsRequest
+	30,  // 29: pb.Galaxy.GetTrial:input_type -> pb.GetTrialRequest
+	31,  // 30: pb.Galaxy.UpdateTrial:input_type -> pb.UpdateTrialRequest
+	32,  // 31: pb.Galaxy.IncrementUsage:input_type -> pb.IncrementUsageRequest
+	33,  // 32: pb.Galaxy.GetUsage:input_type -> pb.GetUsageRequest
+	34,  // 33: pb.Galaxy.CreateTeam:input_type -> pb.CreateTeamRequest
+	35,  // 34: pb.Galaxy.GetTeam:input_type -> pb.GetTeamRequest
+	36,  // 35: pb.Galaxy.ListTeamMembers:input_type -> pb.ListTeamMembersRequest
+	37,  // 36: pb.Galaxy.AddTeamMember:input_type -> pb.AddTeamMemberRequest
+	38,  // 37: pb.Galaxy.UpdateTeamMember:input_type -> pb.UpdateTeamMemberRequest
+	39,  // 38: pb.Galaxy.RemoveTeamMember:input_type -> pb.RemoveTeamMemberRequest
+	40,  // 39: pb.Galaxy.CreateTeamInvitation:input_type -> pb.CreateTeamInvitationRequest
+	41,  // 40: pb.Galaxy.GetTeamInvitation:input_type -> pb.GetTeamInvitationRequest
+	42,  // 41: pb.Galaxy.AcceptTeamInvitation:input_type -> pb.AcceptTeamInvitationRequest
+	43,  // 42: pb.Galaxy.ListTeams:input_type -> pb.ListTeamsRequest
+	44,  // 43: pb.Galaxy.RenewTeam:input_type -> pb.RenewTeamRequest
+	45,  // 44: pb.Galaxy.GetCart:input_type -> pb.GetCartRequest
+	46,  // 45: pb.Galaxy.SetCartLine:input_type -> pb.SetCartLineRequest
+	47,  // 46: pb.Galaxy.ClearCart:input_type -> pb.ClearCartRequest
+	48,  // 47: pb.Galaxy.CreateOrder:input_type -> pb.CreateOrderRequest
+	49,  // 48: pb.Galaxy.GetOrder:input_type -> pb.GetOrderRequest
+	50,  // 49: pb.Galaxy.ListOrders:input_type -> pb.ListOrdersRequest
+	51,  // 50: pb.Galaxy.UpdateOrderStatus:input_type -> pb.UpdateOrderStatusRequest
+	52,  // 51: pb.Galaxy.DeleteOrder:input_type -> pb.DeleteOrderRequest
+	53,  // 52: pb.Galaxy.CreateSaga:input_type -> pb.CreateSagaRequest
+	54,  // 53: pb.Galaxy.UpdateSaga:input_type -> pb.UpdateSagaRequest
+	55,  // 54: pb.Galaxy.ListSagas:input_type -> pb.ListSagasRequest
+	56,  // 55: pb.Galaxy.CreateCoupon:input_type -> pb.CreateCouponRequest
+	57,  // 56: pb.Galaxy.GetCoupon:input_type -> pb.GetCouponRequest
+	58,  // 57: pb.Galaxy.GetCouponByCode:input_type -> pb.GetCouponByCodeRequest
+	59,  // 58: pb.Galaxy.ListCoupons:input_type -> pb.ListCouponsRequest
+	60,  // 59: pb.Galaxy.UpdateCoupon:input_type -> pb.UpdateCouponRequest
+	61,  // 60: pb.Galaxy.RedeemCoupon:input_type -> pb.RedeemCouponRequest
+	62,  // 61: pb.Galaxy.DeleteCouponRedemption:input_type -> pb.DeleteCouponRedemptionRequest
+	63,  // 62: pb.Galaxy.ListCouponRedemptions:input_type -> pb.ListCouponRedemptionsRequest
+	64,  // 63: pb.Galaxy.GetWallet:input_type -> pb.GetWalletRequest
+	65,  // 64: pb.Galaxy.CreateWalletTransaction:input_type -> pb.CreateWalletTransactionRequest
+	66,  // 65: pb.Galaxy.ListWalletTransactions:input_type -> pb.ListWalletTransactionsRequest
+	67,  // 66: pb.Galaxy.CreateGiftCard:input_type -> pb.CreateGiftCardRequest
+	68,  // 67: pb.Galaxy.GetGiftCardByCode:input_type -> pb.GetGiftCardByCodeRequest
+	69,  // 68: pb.Galaxy.GetGiftCardByEntry:input_type -> pb.GetGiftCardByEntryRequest
+	70,  // 69: pb.Galaxy.DeleteGiftCard:input_type -> pb.DeleteGiftCardRequest
+	71,  // 70: pb.Galaxy.RedeemGiftCard:input_type -> pb.RedeemGiftCardRequest
+	72,  // 71: pb.Galaxy.ReverseGiftCardRedemption:input_type -> pb.ReverseGiftCardRedemptionRequest
+	73,  // 72: pb.Galaxy.ListGiftCardRedemptions:input_type -> pb.ListGiftCardRedemptionsRequest
+	74,  // 73: pb.Galaxy.GetPointsBalance:input_type -> pb.GetPointsBalanceRequest
+	75,  // 74: pb.Galaxy.CreatePointsTransaction:input_type -> pb.CreatePointsTransactionRequest
+	76,  // 75: pb.Galaxy.ListPointsTransactions:input_type -> pb.ListPointsTransactionsRequest
+	77,  // 76: pb.Galaxy.ExpirePoints:input_type -> pb.ExpirePointsRequest
+	78,  // 77: pb.Galaxy.ConsumeToken:input_type -> pb.ConsumeTokenRequest
+	79,  // 78: pb.Galaxy.CreateEntry:input_type -> pb.CreateEntryRequest
+	80,  // 79: pb.Galaxy.GetEntry:input_type -> pb.GetEntryRequest
+	81,  // 80: pb.Galaxy.ListEntries:input_type -> pb.ListEntriesRequest
+	82,  // 81: pb.Galaxy.ListEntriesByUser:input_type -> pb.ListEntriesByUserRequest
+	83,  // 82: pb.Galaxy.ListEntriesByItem:input_type -> pb.ListEntriesByItemRequest
+	84,  // 83: pb.Galaxy.UpdateEntryStatus:input_type -> pb.UpdateEntryStatusRequest
+	85,  // 84: pb.Galaxy.DeleteEntry:input_type -> pb.DeleteEntryRequest
+	86,  // 85: pb.Galaxy.CreateItem:output_type -> pb.CreateItemResponse
+	87,  // 86: pb.Galaxy.GetItem:output_type -> pb.GetItemResponse
+	88,  // 87: pb.Galaxy.ListItems:output_type -> pb.ListItemsResponse
+	89,  // 88: pb.Galaxy.UpdateItem:output_type -> pb.UpdateItemResponse
+	0,   // 89: pb.Galaxy.DeleteItem:output_type -> pb.Empty
+	90,  // 90: pb.Galaxy.Login:output_type -> pb.LoginResponse
+	91,  // 91: pb.Galaxy.CreateUser:output_type -> pb.CreateUserResponse
+	92,  // 92: pb.Galaxy.CreateSession:output_type -> pb.CreateSessionResponse
+	93,  // 93: pb.Galaxy.GetUser:output_type -> pb.GetUserResponse
+	93,  // 94: pb.Galaxy.GetUserByUsername:output_type -> pb.GetUserResponse
+	94,  // 95: pb.Galaxy.ListUsers:output_type -> pb.ListUsersResponse
+	95,  // 96: pb.Galaxy.UpdateUser:output_type -> pb.UpdateUserResponse
+	95,  // 97: pb.Galaxy.UpdateUserStatus:output_type -> pb.UpdateUserResponse
+	0,   // 98: pb.Galaxy.DeleteUser:output_type -> pb.Empty
+	96,  // 99: pb.Galaxy.Authorize:output_type -> pb.AuthResponse
+	97,  // 100: pb.Galaxy.RenewAccessToken:output_type -> pb.RenewAccessTokenResponse
+	98,  // 101: pb.Galaxy.ListSessions:output_type -> pb.ListSessionsResponse
+	0,   // 102: pb.Galaxy.RevokeSession:output_type -> pb.Empty
+	99,  // 103: pb.Galaxy.RevokeSessions:output_type -> pb.RevokeSessionsResponse
+	0,   // 104: pb.Galaxy.CreateSecurityEvent:output_type -> pb.Empty
+	100, // 105: pb.Galaxy.ListSecurityEvents:output_type -> pb.ListSecurityEventsResponse
+	101, // 106: pb.Galaxy.GetPlan:output_type -> pb.GetPlanResponse
+	102, // 107: pb.Galaxy.ListPlans:output_type -> pb.ListPlansResponse
+	95,  // 108: pb.Galaxy.ChangePlan:output_type -> pb.UpdateUserResponse
+	0,   // 109: pb.Galaxy.CreatePlanChange:output_type -> pb.Empty
+	103, // 110: pb.Galaxy.ListPlanChanges:output_type -> pb.ListPlanChangesResponse
+	104, // 111: pb.Galaxy.AcquireLease:output_type -> pb.AcquireLeaseResponse
+	105, // 112: pb.Galaxy.CreateBillingAttempt:output_type -> pb.CreateBillingAttemptResponse
+	106, // 113: pb.Galaxy.ListBillingAttempts:output_type -> pb.ListBillingAttemptsResponse
+	107, // 114: pb.Galaxy.GetTrial:output_type -> pb.GetTrialResponse
+	108, // 115: pb.Galaxy.UpdateTrial:output_type -> pb.UpdateTrialResponse
+	109, // 116: pb.Galaxy.IncrementUsage:output_type -> pb.IncrementUsageResponse
+	110, // 117: pb.Galaxy.GetUsage:output_type -> pb.GetUsageResponse
+	111, // 118: pb.Galaxy.CreateTeam:output_type -> pb.TeamResponse
+	111, // 119: pb.Galaxy.GetTeam:output_type -> pb.TeamResponse
+	112, // 120: pb.Galaxy.ListTeamMembers:output_type -> pb.ListTeamMembersResponse
+	113, // 121: pb.Galaxy.AddTeamMember:output_type -> pb.TeamMemberResponse
+	113, // 122: pb.Galaxy.UpdateTeamMember:output_type -> pb.TeamMemberResponse
+	0,   // 123: pb.Galaxy.RemoveTeamMember:output_type -> pb.Empty
+	114, // 124: pb.Galaxy.CreateTeamInvitation:output_type -> pb.TeamInvitationResponse
+	114, // 125: pb.Galaxy.GetTeamInvitation:output_type -> pb.TeamInvitationResponse
+	114, // 126: pb.Galaxy.AcceptTeamInvitation:output_type -> pb.TeamInvitationResponse
+	115, // 127: pb.Galaxy.ListTeams:output_type -> pb.ListTeamsResponse
+	111, // 128: pb.Galaxy.RenewTeam:output_type -> pb.TeamResponse
+	116, // 129: pb.Galaxy.GetCart:output_type -> pb.CartResponse
+	116, // 130: pb.Galaxy.SetCartLine:output_type -> pb.CartResponse
+	0,   // 131: pb.Galaxy.ClearCart:output_type -> pb.Empty
+	117, // 132: pb.Galaxy.CreateOrder:output_type -> pb.OrderResponse
+	117, // 133: pb.Galaxy.GetOrder:output_type -> pb.OrderResponse
+	118, // 134: pb.Galaxy.ListOrders:output_type -> pb.ListOrdersResponse
+	117, // 135: pb.Galaxy.UpdateOrderStatus:output_type -> pb.OrderResponse
+	0,   // 136: pb.Galaxy.DeleteOrder:output_type -> pb.Empty
+	119, // 137: pb.Galaxy.CreateSaga:output_type -> pb.SagaResponse
+	119, // 138: pb.Galaxy.UpdateSaga:output_type -> pb.SagaResponse
+	120, // 139: pb.Galaxy.ListSagas:output_type -> pb.ListSagasResponse
+	121, // 140: pb.Galaxy.CreateCoupon:output_type -> pb.CouponResponse
+	121, // 141: pb.Galaxy.GetCoupon:output_type -> pb.CouponResponse
+	121, // 142: pb.Galaxy.GetCouponByCode:output_type -> pb.CouponResponse
+	122, // 143: pb.Galaxy.ListCoupons:output_type -> pb.ListCouponsResponse
+	121, // 144: pb.Galaxy.UpdateCoupon:output_type -> pb.CouponResponse
+	123, // 145: pb.Galaxy.RedeemCoupon:output_type -> pb.RedeemCouponResponse
+	0,   // 146: pb.Galaxy.DeleteCouponRedemption:output_type -> pb.Empty
+	124, // 147: pb.Galaxy.ListCouponRedemptions:output_type -> pb.ListCouponRedemptionsResponse
+	125, // 148: pb.Galaxy.GetWallet:output_type -> pb.WalletResponse
+	126, // 149: pb.Galaxy.CreateWalletTransaction:output_type -> pb.WalletTransactionResponse
+	127, // 150: pb.Galaxy.ListWalletTransactions:output_type -> pb.ListWalletTransactionsResponse
+	128, // 151: pb.Galaxy.CreateGiftCard:output_type -> pb.GiftCardResponse
+	128, // 152: pb.Galaxy.GetGiftCardByCode:output_type -> pb.GiftCardResponse
+	128, // 153: pb.Galaxy.GetGiftCardByEntry:output_type -> pb.GiftCardResponse
+	0,   // 154: pb.Galaxy.DeleteGiftCard:output_type -> pb.Empty
+	129, // 155: pb.Galaxy.RedeemGiftCard:output_type -> pb.RedeemGiftCardResponse
+	129, // 156: pb.Galaxy.ReverseGiftCardRedemption:output_type -> pb.RedeemGiftCardResponse
+	130, // 157: pb.Galaxy.ListGiftCardRedemptions:output_type -> pb.ListGiftCardRedemptionsResponse
+	131, // 158: pb.Galaxy.GetPointsBalance:output_type -> pb.PointsBalanceResponse
+	132, // 159: pb.Galaxy.CreatePointsTransaction:output_type -> pb.PointsTransactionResponse
+	133, // 160: pb.Galaxy.ListPointsTransactions:output_type -> pb.ListPointsTransactionsResponse
+	134, // 161: pb.Galaxy.ExpirePoints:output_type -> pb.ExpirePointsResponse
+	0,   // 162: pb.Galaxy.ConsumeToken:output_type -> pb.Empty
+	135, // 163: pb.Galaxy.CreateEntry:output_type -> pb.CreateEntryResponse
+	136, // 164: pb.Galaxy.GetEntry:output_type -> pb.GetEntryResponse
+	137, // 165: pb.Galaxy.ListEntries:output_type -> pb.ListEntriesResponse
+	137, // 166: pb.Galaxy.ListEntriesByUser:output_type -> pb.ListEntriesResponse
+	137, // 167: pb.Galaxy.ListEntriesByItem:output_type -> pb.ListEntriesResponse
+	138, // 168: pb.Galaxy.UpdateEntryStatus:output_type -> pb.UpdateEntryResponse
+	0,   // 169: pb.Galaxy.DeleteEntry:output_type -> pb.Empty
+	85,  // [85:170] is the sub-list for method output_type
+	0,   // [0:85] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_galaxy_service_proto_init() }
@@ -278,6 +828,26 @@ func file_galaxy_service_proto_init() {
 	file_rpc_create_entry_proto_init()
 	file_rpc_query_entry_proto_init()
 	file_rpc_delete_entry_proto_init()
+	file_rpc_update_entry_proto_init()
+	file_rpc_revoke_session_proto_init()
+	file_rpc_query_session_proto_init()
+	file_rpc_delete_user_proto_init()
+	file_rpc_security_event_proto_init()
+	file_rpc_query_plan_proto_init()
+	file_rpc_change_plan_proto_init()
+	file_rpc_lease_proto_init()
+	file_rpc_billing_attempt_proto_init()
+	file_rpc_trial_proto_init()
+	file_rpc_usage_proto_init()
+	file_rpc_team_proto_init()
+	file_rpc_cart_proto_init()
+	file_rpc_order_proto_init()
+	file_rpc_saga_proto_init()
+	file_rpc_coupon_proto_init()
+	file_rpc_wallet_proto_init()
+	file_rpc_gift_card_proto_init()
+	file_rpc_points_proto_init()
+	file_rpc_consume_token_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_galaxy_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Empty); i {
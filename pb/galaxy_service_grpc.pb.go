@@ -19,25 +19,91 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Galaxy_CreateItem_FullMethodName        = "/pb.Galaxy/CreateItem"
-	Galaxy_GetItem_FullMethodName           = "/pb.Galaxy/GetItem"
-	Galaxy_ListItems_FullMethodName         = "/pb.Galaxy/ListItems"
-	Galaxy_UpdateItem_FullMethodName        = "/pb.Galaxy/UpdateItem"
-	Galaxy_DeleteItem_FullMethodName        = "/pb.Galaxy/DeleteItem"
-	Galaxy_Login_FullMethodName             = "/pb.Galaxy/Login"
-	Galaxy_CreateUser_FullMethodName        = "/pb.Galaxy/CreateUser"
-	Galaxy_CreateSession_FullMethodName     = "/pb.Galaxy/CreateSession"
-	Galaxy_GetUser_FullMethodName           = "/pb.Galaxy/GetUser"
-	Galaxy_GetUserByUsername_FullMethodName = "/pb.Galaxy/GetUserByUsername"
-	Galaxy_UpdateUser_FullMethodName        = "/pb.Galaxy/UpdateUser"
-	Galaxy_Authorize_FullMethodName         = "/pb.Galaxy/Authorize"
-	Galaxy_RenewAccessToken_FullMethodName  = "/pb.Galaxy/RenewAccessToken"
-	Galaxy_CreateEntry_FullMethodName       = "/pb.Galaxy/CreateEntry"
-	Galaxy_GetEntry_FullMethodName          = "/pb.Galaxy/GetEntry"
-	Galaxy_ListEntries_FullMethodName       = "/pb.Galaxy/ListEntries"
-	Galaxy_ListEntriesByUser_FullMethodName = "/pb.Galaxy/ListEntriesByUser"
-	Galaxy_ListEntriesByItem_FullMethodName = "/pb.Galaxy/ListEntriesByItem"
-	Galaxy_DeleteEntry_FullMethodName       = "/pb.Galaxy/DeleteEntry"
+	Galaxy_CreateItem_FullMethodName                = "/pb.Galaxy/CreateItem"
+	Galaxy_GetItem_FullMethodName                   = "/pb.Galaxy/GetItem"
+	Galaxy_ListItems_FullMethodName                 = "/pb.Galaxy/ListItems"
+	Galaxy_UpdateItem_FullMethodName                = "/pb.Galaxy/UpdateItem"
+	Galaxy_DeleteItem_FullMethodName                = "/pb.Galaxy/DeleteItem"
+	Galaxy_Login_FullMethodName                     = "/pb.Galaxy/Login"
+	Galaxy_CreateUser_FullMethodName                = "/pb.Galaxy/CreateUser"
+	Galaxy_CreateSession_FullMethodName             = "/pb.Galaxy/CreateSession"
+	Galaxy_GetUser_FullMethodName                   = "/pb.Galaxy/GetUser"
+	Galaxy_GetUserByUsername_FullMethodName         = "/pb.Galaxy/GetUserByUsername"
+	Galaxy_ListUsers_FullMethodName                 = "/pb.Galaxy/ListUsers"
+	Galaxy_UpdateUser_FullMethodName                = "/pb.Galaxy/UpdateUser"
+	Galaxy_UpdateUserStatus_FullMethodName          = "/pb.Galaxy/UpdateUserStatus"
+	Galaxy_DeleteUser_FullMethodName                = "/pb.Galaxy/DeleteUser"
+	Galaxy_Authorize_FullMethodName                 = "/pb.Galaxy/Authorize"
+	Galaxy_RenewAccessToken_FullMethodName          = "/pb.Galaxy/RenewAccessToken"
+	Galaxy_ListSessions_FullMethodName              = "/pb.Galaxy/ListSessions"
+	Galaxy_RevokeSession_FullMethodName             = "/pb.Galaxy/RevokeSession"
+	Galaxy_RevokeSessions_FullMethodName            = "/pb.Galaxy/RevokeSessions"
+	Galaxy_CreateSecurityEvent_FullMethodName       = "/pb.Galaxy/CreateSecurityEvent"
+	Galaxy_ListSecurityEvents_FullMethodName        = "/pb.Galaxy/ListSecurityEvents"
+	Galaxy_GetPlan_FullMethodName                   = "/pb.Galaxy/GetPlan"
+	Galaxy_ListPlans_FullMethodName                 = "/pb.Galaxy/ListPlans"
+	Galaxy_ChangePlan_FullMethodName                = "/pb.Galaxy/ChangePlan"
+	Galaxy_CreatePlanChange_FullMethodName          = "/pb.Galaxy/CreatePlanChange"
+	Galaxy_ListPlanChanges_FullMethodName           = "/pb.Galaxy/ListPlanChanges"
+	Galaxy_AcquireLease_FullMethodName              = "/pb.Galaxy/AcquireLease"
+	Galaxy_CreateBillingAttempt_FullMethodName      = "/pb.Galaxy/CreateBillingAttempt"
+	Galaxy_ListBillingAttempts_FullMethodName       = "/pb.Galaxy/ListBillingAttempts"
+	Galaxy_GetTrial_FullMethodName                  = "/pb.Galaxy/GetTrial"
+	Galaxy_UpdateTrial_FullMethodName               = "/pb.Galaxy/UpdateTrial"
+	Galaxy_IncrementUsage_FullMethodName            = "/pb.Galaxy/IncrementUsage"
+	Galaxy_GetUsage_FullMethodName                  = "/pb.Galaxy/GetUsage"
+	Galaxy_CreateTeam_FullMethodName                = "/pb.Galaxy/CreateTeam"
+	Galaxy_GetTeam_FullMethodName                   = "/pb.Galaxy/GetTeam"
+	Galaxy_ListTeamMembers_FullMethodName           = "/pb.Galaxy/ListTeamMembers"
+	Galaxy_AddTeamMember_FullMethodName             = "/pb.Galaxy/AddTeamMember"
+	Galaxy_UpdateTeamMember_FullMethodName          = "/pb.Galaxy/UpdateTeamMember"
+	Galaxy_RemoveTeamMember_FullMethodName          = "/pb.Galaxy/RemoveTeamMember"
+	Galaxy_CreateTeamInvitation_FullMethodName      = "/pb.Galaxy/CreateTeamInvitation"
+	Galaxy_GetTeamInvitation_FullMethodName         = "/pb.Galaxy/GetTeamInvitation"
+	Galaxy_AcceptTeamInvitation_FullMethodName      = "/pb.Galaxy/AcceptTeamInvitation"
+	Galaxy_ListTeams_FullMethodName                 = "/pb.Galaxy/ListTeams"
+	Galaxy_RenewTeam_FullMethodName                 = "/pb.Galaxy/RenewTeam"
+	Galaxy_GetCart_FullMethodName                   = "/pb.Galaxy/GetCart"
+	Galaxy_SetCartLine_FullMethodName               = "/pb.Galaxy/SetCartLine"
+	Galaxy_ClearCart_FullMethodName                 = "/pb.Galaxy/ClearCart"
+	Galaxy_CreateOrder_FullMethodName               = "/pb.Galaxy/CreateOrder"
+	Galaxy_GetOrder_FullMethodName                  = "/pb.Galaxy/GetOrder"
+	Galaxy_ListOrders_FullMethodName                = "/pb.Galaxy/ListOrders"
+	Galaxy_UpdateOrderStatus_FullMethodName         = "/pb.Galaxy/UpdateOrderStatus"
+	Galaxy_DeleteOrder_FullMethodName               = "/pb.Galaxy/DeleteOrder"
+	Galaxy_CreateSaga_FullMethodName                = "/pb.Galaxy/CreateSaga"
+	Galaxy_UpdateSaga_FullMethodName                = "/pb.Galaxy/UpdateSaga"
+	Galaxy_ListSagas_FullMethodName                 = "/pb.Galaxy/ListSagas"
+	Galaxy_CreateCoupon_FullMethodName              = "/pb.Galaxy/CreateCoupon"
+	Galaxy_GetCoupon_FullMethodName                 = "/pb.Galaxy/GetCoupon"
+	Galaxy_GetCouponByCode_FullMethodName           = "/pb.Galaxy/GetCouponByCode"
+	Galaxy_ListCoupons_FullMethodName               = "/pb.Galaxy/ListCoupons"
+	Galaxy_UpdateCoupon_FullMethodName              = "/pb.Galaxy/UpdateCoupon"
+	Galaxy_RedeemCoupon_FullMethodName              = "/pb.Galaxy/RedeemCoupon"
+	Galaxy_DeleteCouponRedemption_FullMethodName    = "/pb.Galaxy/DeleteCouponRedemption"
+	Galaxy_ListCouponRedemptions_FullMethodName     = "/pb.Galaxy/ListCouponRedemptions"
+	Galaxy_GetWallet_FullMethodName                 = "/pb.Galaxy/GetWallet"
+	Galaxy_CreateWalletTransaction_FullMethodName   = "/pb.Galaxy/CreateWalletTransaction"
+	Galaxy_ListWalletTransactions_FullMethodName    = "/pb.Galaxy/ListWalletTransactions"
+	Galaxy_CreateGiftCard_FullMethodName            = "/pb.Galaxy/CreateGiftCard"
+	Galaxy_GetGiftCardByCode_FullMethodName         = "/pb.Galaxy/GetGiftCardByCode"
+	Galaxy_GetGiftCardByEntry_FullMethodName        = "/pb.Galaxy/GetGiftCardByEntry"
+	Galaxy_DeleteGiftCard_FullMethodName            = "/pb.Galaxy/DeleteGiftCard"
+	Galaxy_RedeemGiftCard_FullMethodName            = "/pb.Galaxy/RedeemGiftCard"
+	Galaxy_ReverseGiftCardRedemption_FullMethodName = "/pb.Galaxy/ReverseGiftCardRedemption"
+	Galaxy_ListGiftCardRedemptions_FullMethodName   = "/pb.Galaxy/ListGiftCardRedemptions"
+	Galaxy_GetPointsBalance_FullMethodName          = "/pb.Galaxy/GetPointsBalance"
+	Galaxy_CreatePointsTransaction_FullMethodName   = "/pb.Galaxy/CreatePointsTransaction"
+	Galaxy_ListPointsTransactions_FullMethodName    = "/pb.Galaxy/ListPointsTransactions"
+	Galaxy_ExpirePoints_FullMethodName              = "/pb.Galaxy/ExpirePoints"
+	Galaxy_ConsumeToken_FullMethodName              = "/pb.Galaxy/ConsumeToken"
+	Galaxy_CreateEntry_FullMethodName               = "/pb.Galaxy/CreateEntry"
+	Galaxy_GetEntry_FullMethodName                  = "/pb.Galaxy/GetEntry"
+	Galaxy_ListEntries_FullMethodName               = "/pb.Galaxy/ListEntries"
+	Galaxy_ListEntriesByUser_FullMethodName         = "/pb.Galaxy/ListEntriesByUser"
+	Galaxy_ListEntriesByItem_FullMethodName         = "/pb.Galaxy/ListEntriesByItem"
+	Galaxy_UpdateEntryStatus_FullMethodName         = "/pb.Galaxy/UpdateEntryStatus"
+	Galaxy_DeleteEntry_FullMethodName               = "/pb.Galaxy/DeleteEntry"
 )
 
 // GalaxyClient is the client API for Galaxy service.
@@ -54,14 +120,80 @@ type GalaxyClient interface {
 	CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*CreateSessionResponse, error)
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
 	GetUserByUsername(ctx context.Context, in *GetUserByUsernameRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
+	UpdateUserStatus(ctx context.Context, in *UpdateUserStatusRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*Empty, error)
 	Authorize(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error)
 	RenewAccessToken(ctx context.Context, in *RenewAccessTokenRequest, opts ...grpc.CallOption) (*RenewAccessTokenResponse, error)
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*Empty, error)
+	RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...grpc.CallOption) (*RevokeSessionsResponse, error)
+	CreateSecurityEvent(ctx context.Context, in *CreateSecurityEventRequest, opts ...grpc.CallOption) (*Empty, error)
+	ListSecurityEvents(ctx context.Context, in *ListSecurityEventsRequest, opts ...grpc.CallOption) (*ListSecurityEventsResponse, error)
+	GetPlan(ctx context.Context, in *GetPlanRequest, opts ...grpc.CallOption) (*GetPlanResponse, error)
+	ListPlans(ctx context.Context, in *ListPlansRequest, opts ...grpc.CallOption) (*ListPlansResponse, error)
+	ChangePlan(ctx context.Context, in *ChangePlanRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
+	CreatePlanChange(ctx context.Context, in *CreatePlanChangeRequest, opts ...grpc.CallOption) (*Empty, error)
+	ListPlanChanges(ctx context.Context, in *ListPlanChangesRequest, opts ...grpc.CallOption) (*ListPlanChangesResponse, error)
+	AcquireLease(ctx context.Context, in *AcquireLeaseRequest, opts ...grpc.CallOption) (*AcquireLeaseResponse, error)
+	CreateBillingAttempt(ctx context.Context, in *CreateBillingAttemptRequest, opts ...grpc.CallOption) (*CreateBillingAttemptResponse, error)
+	ListBillingAttempts(ctx context.Context, in *ListBillingAttemptsRequest, opts ...grpc.CallOption) (*ListBillingAttemptsResponse, error)
+	GetTrial(ctx context.Context, in *GetTrialRequest, opts ...grpc.CallOption) (*GetTrialResponse, error)
+	UpdateTrial(ctx context.Context, in *UpdateTrialRequest, opts ...grpc.CallOption) (*UpdateTrialResponse, error)
+	IncrementUsage(ctx context.Context, in *IncrementUsageRequest, opts ...grpc.CallOption) (*IncrementUsageResponse, error)
+	GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error)
+	CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*TeamResponse, error)
+	GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*TeamResponse, error)
+	ListTeamMembers(ctx context.Context, in *ListTeamMembersRequest, opts ...grpc.CallOption) (*ListTeamMembersResponse, error)
+	AddTeamMember(ctx context.Context, in *AddTeamMemberRequest, opts ...grpc.CallOption) (*TeamMemberResponse, error)
+	UpdateTeamMember(ctx context.Context, in *UpdateTeamMemberRequest, opts ...grpc.CallOption) (*TeamMemberResponse, error)
+	RemoveTeamMember(ctx context.Context, in *RemoveTeamMemberRequest, opts ...grpc.CallOption) (*Empty, error)
+	CreateTeamInvitation(ctx context.Context, in *CreateTeamInvitationRequest, opts ...grpc.CallOption) (*TeamInvitationResponse, error)
+	GetTeamInvitation(ctx context.Context, in *GetTeamInvitationRequest, opts ...grpc.CallOption) (*TeamInvitationResponse, error)
+	AcceptTeamInvitation(ctx context.Context, in *AcceptTeamInvitationRequest, opts ...grpc.CallOption) (*TeamInvitationResponse, error)
+	ListTeams(ctx context.Context, in *ListTeamsRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error)
+	RenewTeam(ctx context.Context, in *RenewTeamRequest, opts ...grpc.CallOption) (*TeamResponse, error)
+	GetCart(ctx context.Context, in *GetCartRequest, opts ...grpc.CallOption) (*CartResponse, error)
+	SetCartLine(ctx context.Context, in *SetCartLineRequest, opts ...grpc.CallOption) (*CartResponse, error)
+	ClearCart(ctx context.Context, in *ClearCartRequest, opts ...grpc.CallOption) (*Empty, error)
+	CreateOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error)
+	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error)
+	ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error)
+	UpdateOrderStatus(ctx context.Context, in *UpdateOrderStatusRequest, opts ...grpc.CallOption) (*OrderResponse, error)
+	DeleteOrder(ctx context.Context, in *DeleteOrderRequest, opts ...grpc.CallOption) (*Empty, error)
+	CreateSaga(ctx context.Context, in *CreateSagaRequest, opts ...grpc.CallOption) (*SagaResponse, error)
+	UpdateSaga(ctx context.Context, in *UpdateSagaRequest, opts ...grpc.CallOption) (*SagaResponse, error)
+	ListSagas(ctx context.Context, in *ListSagasRequest, opts ...grpc.CallOption) (*ListSagasResponse, error)
+	CreateCoupon(ctx context.Context, in *CreateCouponRequest, opts ...grpc.CallOption) (*CouponResponse, error)
+	GetCoupon(ctx context.Context, in *GetCouponRequest, opts ...grpc.CallOption) (*CouponResponse, error)
+	GetCouponByCode(ctx context.Context, in *GetCouponByCodeRequest, opts ...grpc.CallOption) (*CouponResponse, error)
+	ListCoupons(ctx context.Context, in *ListCouponsRequest, opts ...grpc.CallOption) (*ListCouponsResponse, error)
+	UpdateCoupon(ctx context.Context, in *UpdateCouponRequest, opts ...grpc.CallOption) (*CouponResponse, error)
+	RedeemCoupon(ctx context.Context, in *RedeemCouponRequest, opts ...grpc.CallOption) (*RedeemCouponResponse, error)
+	DeleteCouponRedemption(ctx context.Context, in *DeleteCouponRedemptionRequest, opts ...grpc.CallOption) (*Empty, error)
+	ListCouponRedemptions(ctx context.Context, in *ListCouponRedemptionsRequest, opts ...grpc.CallOption) (*ListCouponRedemptionsResponse, error)
+	GetWallet(ctx context.Context, in *GetWalletRequest, opts ...grpc.CallOption) (*WalletResponse, error)
+	CreateWalletTransaction(ctx context.Context, in *CreateWalletTransactionRequest, opts ...grpc.CallOption) (*WalletTransactionResponse, error)
+	ListWalletTransactions(ctx context.Context, in *ListWalletTransactionsRequest, opts ...grpc.CallOption) (*ListWalletTransactionsResponse, error)
+	CreateGiftCard(ctx context.Context, in *CreateGiftCardRequest, opts ...grpc.CallOption) (*GiftCardResponse, error)
+	GetGiftCardByCode(ctx context.Context, in *GetGiftCardByCodeRequest, opts ...grpc.CallOption) (*GiftCardResponse, error)
+	GetGiftCardByEntry(ctx context.Context, in *GetGiftCardByEntryRequest, opts ...grpc.CallOption) (*GiftCardResponse, error)
+	DeleteGiftCard(ctx context.Context, in *DeleteGiftCardRequest, opts ...grpc.CallOption) (*Empty, error)
+	RedeemGiftCard(ctx context.Context, in *RedeemGiftCardRequest, opts ...grpc.CallOption) (*RedeemGiftCardResponse, error)
+	ReverseGiftCardRedemption(ctx context.Context, in *ReverseGiftCardRedemptionRequest, opts ...grpc.CallOption) (*RedeemGiftCardResponse, error)
+	ListGiftCardRedemptions(ctx context.Context, in *ListGiftCardRedemptionsRequest, opts ...grpc.CallOption) (*ListGiftCardRedemptionsResponse, error)
+	GetPointsBalance(ctx context.Context, in *GetPointsBalanceRequest, opts ...grpc.CallOption) (*PointsBalanceResponse, error)
+	CreatePointsTransaction(ctx context.Context, in *CreatePointsTransactionRequest, opts ...grpc.CallOption) (*PointsTransactionResponse, error)
+	ListPointsTransactions(ctx context.Context, in *ListPointsTransactionsRequest, opts ...grpc.CallOption) (*ListPointsTransactionsResponse, error)
+	ExpirePoints(ctx context.Context, in *ExpirePointsRequest, opts ...grpc.CallOption) (*ExpirePointsResponse, error)
+	ConsumeToken(ctx context.Context, in *ConsumeTokenRequest, opts ...grpc.CallOption) (*Empty, error)
 	CreateEntry(ctx context.Context, in *CreateEntryRequest, opts ...grpc.CallOption) (*CreateEntryResponse, error)
 	GetEntry(ctx context.Context, in *GetEntryRequest, opts ...grpc.CallOption) (*GetEntryResponse, error)
 	ListEntries(ctx context.Context, in *ListEntriesRequest, opts ...grpc.CallOption) (*ListEntriesResponse, error)
 	ListEntriesByUser(ctx context.Context, in *ListEntriesByUserRequest, opts ...grpc.CallOption) (*ListEntriesResponse, error)
 	ListEntriesByItem(ctx context.Context, in *ListEntriesByItemRequest, opts ...grpc.CallOption) (*ListEntriesResponse, error)
+	UpdateEntryStatus(ctx context.Context, in *UpdateEntryStatusRequest, opts ...grpc.CallOption) (*UpdateEntryResponse, error)
 	DeleteEntry(ctx context.Context, in *DeleteEntryRequest, opts ...grpc.CallOption) (*Empty, error)
 }
 
@@ -163,6 +295,15 @@ func (c *galaxyClient) GetUserByUsername(ctx context.Context, in *GetUserByUsern
 	return out, nil
 }
 
+func (c *galaxyClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, Galaxy_ListUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *galaxyClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error) {
 	out := new(UpdateUserResponse)
 	err := c.cc.Invoke(ctx, Galaxy_UpdateUser_FullMethodName, in, out, opts...)
@@ -172,6 +313,24 @@ func (c *galaxyClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, op
 	return out, nil
 }
 
+func (c *galaxyClient) UpdateUserStatus(ctx context.Context, in *UpdateUserStatusRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error) {
+	out := new(UpdateUserResponse)
+	err := c.cc.Invoke(ctx, Galaxy_UpdateUserStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Galaxy_DeleteUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *galaxyClient) Authorize(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
 	out := new(AuthResponse)
 	err := c.cc.Invoke(ctx, Galaxy_Authorize_FullMethodName, in, out, opts...)
@@ -190,390 +349,2391 @@ func (c *galaxyClient) RenewAccessToken(ctx context.Context, in *RenewAccessToke
 	return out, nil
 }
 
-func (c *galaxyClient) CreateEntry(ctx context.Context, in *CreateEntryRequest, opts ...grpc.CallOption) (*CreateEntryResponse, error) {
-	out := new(CreateEntryResponse)
-	err := c.cc.Invoke(ctx, Galaxy_CreateEntry_FullMethodName, in, out, opts...)
+func (c *galaxyClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, Galaxy_ListSessions_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *galaxyClient) GetEntry(ctx context.Context, in *GetEntryRequest, opts ...grpc.CallOption) (*GetEntryResponse, error) {
-	out := new(GetEntryResponse)
-	err := c.cc.Invoke(ctx, Galaxy_GetEntry_FullMethodName, in, out, opts...)
+func (c *galaxyClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Galaxy_RevokeSession_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *galaxyClient) ListEntries(ctx context.Context, in *ListEntriesRequest, opts ...grpc.CallOption) (*ListEntriesResponse, error) {
-	out := new(ListEntriesResponse)
-	err := c.cc.Invoke(ctx, Galaxy_ListEntries_FullMethodName, in, out, opts...)
+func (c *galaxyClient) RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...grpc.CallOption) (*RevokeSessionsResponse, error) {
+	out := new(RevokeSessionsResponse)
+	err := c.cc.Invoke(ctx, Galaxy_RevokeSessions_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *galaxyClient) ListEntriesByUser(ctx context.Context, in *ListEntriesByUserRequest, opts ...grpc.CallOption) (*ListEntriesResponse, error) {
-	out := new(ListEntriesResponse)
-	err := c.cc.Invoke(ctx, Galaxy_ListEntriesByUser_FullMethodName, in, out, opts...)
+func (c *galaxyClient) CreateSecurityEvent(ctx context.Context, in *CreateSecurityEventRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Galaxy_CreateSecurityEvent_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *galaxyClient) ListEntriesByItem(ctx context.Context, in *ListEntriesByItemRequest, opts ...grpc.CallOption) (*ListEntriesResponse, error) {
-	out := new(ListEntriesResponse)
-	err := c.cc.Invoke(ctx, Galaxy_ListEntriesByItem_FullMethodName, in, out, opts...)
+func (c *galaxyClient) ListSecurityEvents(ctx context.Context, in *ListSecurityEventsRequest, opts ...grpc.CallOption) (*ListSecurityEventsResponse, error) {
+	out := new(ListSecurityEventsResponse)
+	err := c.cc.Invoke(ctx, Galaxy_ListSecurityEvents_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *galaxyClient) DeleteEntry(ctx context.Context, in *DeleteEntryRequest, opts ...grpc.CallOption) (*Empty, error) {
+func (c *galaxyClient) GetPlan(ctx context.Context, in *GetPlanRequest, opts ...grpc.CallOption) (*GetPlanResponse, error) {
+	out := new(GetPlanResponse)
+	err := c.cc.Invoke(ctx, Galaxy_GetPlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) ListPlans(ctx context.Context, in *ListPlansRequest, opts ...grpc.CallOption) (*ListPlansResponse, error) {
+	out := new(ListPlansResponse)
+	err := c.cc.Invoke(ctx, Galaxy_ListPlans_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) ChangePlan(ctx context.Context, in *ChangePlanRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error) {
+	out := new(UpdateUserResponse)
+	err := c.cc.Invoke(ctx, Galaxy_ChangePlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) CreatePlanChange(ctx context.Context, in *CreatePlanChangeRequest, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
-	err := c.cc.Invoke(ctx, Galaxy_DeleteEntry_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Galaxy_CreatePlanChange_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// GalaxyServer is the server API for Galaxy service.
-// All implementations must embed UnimplementedGalaxyServer
-// for forward compatibility
-type GalaxyServer interface {
-	CreateItem(context.Context, *CreateItemRequest) (*CreateItemResponse, error)
-	GetItem(context.Context, *GetItemRequest) (*GetItemResponse, error)
-	ListItems(context.Context, *ListItemsRequest) (*ListItemsResponse, error)
-	UpdateItem(context.Context, *UpdateItemRequest) (*UpdateItemResponse, error)
-	DeleteItem(context.Context, *DeleteItemRequest) (*Empty, error)
-	Login(context.Context, *LoginRequest) (*LoginResponse, error)
-	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
-	CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error)
-	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
-	GetUserByUsername(context.Context, *GetUserByUsernameRequest) (*GetUserResponse, error)
-	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
-	Authorize(context.Context, *AuthRequest) (*AuthResponse, error)
-	RenewAccessToken(context.Context, *RenewAccessTokenRequest) (*RenewAccessTokenResponse, error)
-	CreateEntry(context.Context, *CreateEntryRequest) (*CreateEntryResponse, error)
-	GetEntry(context.Context, *GetEntryRequest) (*GetEntryResponse, error)
-	ListEntries(context.Context, *ListEntriesRequest) (*ListEntriesResponse, error)
-	ListEntriesByUser(context.Context, *ListEntriesByUserRequest) (*ListEntriesResponse, error)
-	ListEntriesByItem(context.Context, *ListEntriesByItemRequest) (*ListEntriesResponse, error)
-	DeleteEntry(context.Context, *DeleteEntryRequest) (*Empty, error)
-	mustEmbedUnimplementedGalaxyServer()
+func (c *galaxyClient) ListPlanChanges(ctx context.Context, in *ListPlanChangesRequest, opts ...grpc.CallOption) (*ListPlanChangesResponse, error) {
+	out := new(ListPlanChangesResponse)
+	err := c.cc.Invoke(ctx, Galaxy_ListPlanChanges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedGalaxyServer must be embedded to have forward compatible implementations.
-type UnimplementedGalaxyServer struct {
+func (c *galaxyClient) AcquireLease(ctx context.Context, in *AcquireLeaseRequest, opts ...grpc.CallOption) (*AcquireLeaseResponse, error) {
+	out := new(AcquireLeaseResponse)
+	err := c.cc.Invoke(ctx, Galaxy_AcquireLease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedGalaxyServer) CreateItem(context.Context, *CreateItemRequest) (*CreateItemResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateItem not implemented")
+func (c *galaxyClient) CreateBillingAttempt(ctx context.Context, in *CreateBillingAttemptRequest, opts ...grpc.CallOption) (*CreateBillingAttemptResponse, error) {
+	out := new(CreateBillingAttemptResponse)
+	err := c.cc.Invoke(ctx, Galaxy_CreateBillingAttempt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGalaxyServer) GetItem(context.Context, *GetItemRequest) (*GetItemResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetItem not implemented")
+
+func (c *galaxyClient) ListBillingAttempts(ctx context.Context, in *ListBillingAttemptsRequest, opts ...grpc.CallOption) (*ListBillingAttemptsResponse, error) {
+	out := new(ListBillingAttemptsResponse)
+	err := c.cc.Invoke(ctx, Galaxy_ListBillingAttempts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGalaxyServer) ListItems(context.Context, *ListItemsRequest) (*ListItemsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListItems not implemented")
+
+func (c *galaxyClient) GetTrial(ctx context.Context, in *GetTrialRequest, opts ...grpc.CallOption) (*GetTrialResponse, error) {
+	out := new(GetTrialResponse)
+	err := c.cc.Invoke(ctx, Galaxy_GetTrial_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGalaxyServer) UpdateItem(context.Context, *UpdateItemRequest) (*UpdateItemResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateItem not implemented")
+
+func (c *galaxyClient) UpdateTrial(ctx context.Context, in *UpdateTrialRequest, opts ...grpc.CallOption) (*UpdateTrialResponse, error) {
+	out := new(UpdateTrialResponse)
+	err := c.cc.Invoke(ctx, Galaxy_UpdateTrial_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGalaxyServer) DeleteItem(context.Context, *DeleteItemRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteItem not implemented")
+
+func (c *galaxyClient) IncrementUsage(ctx context.Context, in *IncrementUsageRequest, opts ...grpc.CallOption) (*IncrementUsageResponse, error) {
+	out := new(IncrementUsageResponse)
+	err := c.cc.Invoke(ctx, Galaxy_IncrementUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGalaxyServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+
+func (c *galaxyClient) GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error) {
+	out := new(GetUsageResponse)
+	err := c.cc.Invoke(ctx, Galaxy_GetUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGalaxyServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+
+func (c *galaxyClient) CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*TeamResponse, error) {
+	out := new(TeamResponse)
+	err := c.cc.Invoke(ctx, Galaxy_CreateTeam_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGalaxyServer) CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateSession not implemented")
+
+func (c *galaxyClient) GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*TeamResponse, error) {
+	out := new(TeamResponse)
+	err := c.cc.Invoke(ctx, Galaxy_GetTeam_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGalaxyServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+
+func (c *galaxyClient) ListTeamMembers(ctx context.Context, in *ListTeamMembersRequest, opts ...grpc.CallOption) (*ListTeamMembersResponse, error) {
+	out := new(ListTeamMembersResponse)
+	err := c.cc.Invoke(ctx, Galaxy_ListTeamMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGalaxyServer) GetUserByUsername(context.Context, *GetUserByUsernameRequest) (*GetUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserByUsername not implemented")
+
+func (c *galaxyClient) AddTeamMember(ctx context.Context, in *AddTeamMemberRequest, opts ...grpc.CallOption) (*TeamMemberResponse, error) {
+	out := new(TeamMemberResponse)
+	err := c.cc.Invoke(ctx, Galaxy_AddTeamMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGalaxyServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+
+func (c *galaxyClient) UpdateTeamMember(ctx context.Context, in *UpdateTeamMemberRequest, opts ...grpc.CallOption) (*TeamMemberResponse, error) {
+	out := new(TeamMemberResponse)
+	err := c.cc.Invoke(ctx, Galaxy_UpdateTeamMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGalaxyServer) Authorize(context.Context, *AuthRequest) (*AuthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Authorize not implemented")
+
+func (c *galaxyClient) RemoveTeamMember(ctx context.Context, in *RemoveTeamMemberRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Galaxy_RemoveTeamMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGalaxyServer) RenewAccessToken(context.Context, *RenewAccessTokenRequest) (*RenewAccessTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RenewAccessToken not implemented")
+
+func (c *galaxyClient) CreateTeamInvitation(ctx context.Context, in *CreateTeamInvitationRequest, opts ...grpc.CallOption) (*TeamInvitationResponse, error) {
+	out := new(TeamInvitationResponse)
+	err := c.cc.Invoke(ctx, Galaxy_CreateTeamInvitation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGalaxyServer) CreateEntry(context.Context, *CreateEntryRequest) (*CreateEntryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateEntry not implemented")
+
+func (c *galaxyClient) GetTeamInvitation(ctx context.Context, in *GetTeamInvitationRequest, opts ...grpc.CallOption) (*TeamInvitationResponse, error) {
+	out := new(TeamInvitationResponse)
+	err := c.cc.Invoke(ctx, Galaxy_GetTeamInvitation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGalaxyServer) GetEntry(context.Context, *GetEntryRequest) (*GetEntryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetEntry not implemented")
+
+func (c *galaxyClient) AcceptTeamInvitation(ctx context.Context, in *AcceptTeamInvitationRequest, opts ...grpc.CallOption) (*TeamInvitationResponse, error) {
+	out := new(TeamInvitationResponse)
+	err := c.cc.Invoke(ctx, Galaxy_AcceptTeamInvitation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGalaxyServer) ListEntries(context.Context, *ListEntriesRequest) (*ListEntriesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListEntries not implemented")
+
+func (c *galaxyClient) ListTeams(ctx context.Context, in *ListTeamsRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error) {
+	out := new(ListTeamsResponse)
+	err := c.cc.Invoke(ctx, Galaxy_ListTeams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGalaxyServer) ListEntriesByUser(context.Context, *ListEntriesByUserRequest) (*ListEntriesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListEntriesByUser not implemented")
+
+func (c *galaxyClient) RenewTeam(ctx context.Context, in *RenewTeamRequest, opts ...grpc.CallOption) (*TeamResponse, error) {
+	out := new(TeamResponse)
+	err := c.cc.Invoke(ctx, Galaxy_RenewTeam_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGalaxyServer) ListEntriesByItem(context.Context, *ListEntriesByItemRequest) (*ListEntriesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListEntriesByItem not implemented")
+
+func (c *galaxyClient) GetCart(ctx context.Context, in *GetCartRequest, opts ...grpc.CallOption) (*CartResponse, error) {
+	out := new(CartResponse)
+	err := c.cc.Invoke(ctx, Galaxy_GetCart_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) SetCartLine(ctx context.Context, in *SetCartLineRequest, opts ...grpc.CallOption) (*CartResponse, error) {
+	out := new(CartResponse)
+	err := c.cc.Invoke(ctx, Galaxy_SetCartLine_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) ClearCart(ctx context.Context, in *ClearCartRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Galaxy_ClearCart_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) CreateOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error) {
+	out := new(OrderResponse)
+	err := c.cc.Invoke(ctx, Galaxy_CreateOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error) {
+	out := new(OrderResponse)
+	err := c.cc.Invoke(ctx, Galaxy_GetOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error) {
+	out := new(ListOrdersResponse)
+	err := c.cc.Invoke(ctx, Galaxy_ListOrders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) UpdateOrderStatus(ctx context.Context, in *UpdateOrderStatusRequest, opts ...grpc.CallOption) (*OrderResponse, error) {
+	out := new(OrderResponse)
+	err := c.cc.Invoke(ctx, Galaxy_UpdateOrderStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) DeleteOrder(ctx context.Context, in *DeleteOrderRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Galaxy_DeleteOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) CreateSaga(ctx context.Context, in *CreateSagaRequest, opts ...grpc.CallOption) (*SagaResponse, error) {
+	out := new(SagaResponse)
+	err := c.cc.Invoke(ctx, Galaxy_CreateSaga_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) UpdateSaga(ctx context.Context, in *UpdateSagaRequest, opts ...grpc.CallOption) (*SagaResponse, error) {
+	out := new(SagaResponse)
+	err := c.cc.Invoke(ctx, Galaxy_UpdateSaga_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) ListSagas(ctx context.Context, in *ListSagasRequest, opts ...grpc.CallOption) (*ListSagasResponse, error) {
+	out := new(ListSagasResponse)
+	err := c.cc.Invoke(ctx, Galaxy_ListSagas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) CreateCoupon(ctx context.Context, in *CreateCouponRequest, opts ...grpc.CallOption) (*CouponResponse, error) {
+	out := new(CouponResponse)
+	err := c.cc.Invoke(ctx, Galaxy_CreateCoupon_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) GetCoupon(ctx context.Context, in *GetCouponRequest, opts ...grpc.CallOption) (*CouponResponse, error) {
+	out := new(CouponResponse)
+	err := c.cc.Invoke(ctx, Galaxy_GetCoupon_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) GetCouponByCode(ctx context.Context, in *GetCouponByCodeRequest, opts ...grpc.CallOption) (*CouponResponse, error) {
+	out := new(CouponResponse)
+	err := c.cc.Invoke(ctx, Galaxy_GetCouponByCode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) ListCoupons(ctx context.Context, in *ListCouponsRequest, opts ...grpc.CallOption) (*ListCouponsResponse, error) {
+	out := new(ListCouponsResponse)
+	err := c.cc.Invoke(ctx, Galaxy_ListCoupons_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) UpdateCoupon(ctx context.Context, in *UpdateCouponRequest, opts ...grpc.CallOption) (*CouponResponse, error) {
+	out := new(CouponResponse)
+	err := c.cc.Invoke(ctx, Galaxy_UpdateCoupon_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) RedeemCoupon(ctx context.Context, in *RedeemCouponRequest, opts ...grpc.CallOption) (*RedeemCouponResponse, error) {
+	out := new(RedeemCouponResponse)
+	err := c.cc.Invoke(ctx, Galaxy_RedeemCoupon_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) DeleteCouponRedemption(ctx context.Context, in *DeleteCouponRedemptionRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Galaxy_DeleteCouponRedemption_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) ListCouponRedemptions(ctx context.Context, in *ListCouponRedemptionsRequest, opts ...grpc.CallOption) (*ListCouponRedemptionsResponse, error) {
+	out := new(ListCouponRedemptionsResponse)
+	err := c.cc.Invoke(ctx, Galaxy_ListCouponRedemptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) GetWallet(ctx context.Context, in *GetWalletRequest, opts ...grpc.CallOption) (*WalletResponse, error) {
+	out := new(WalletResponse)
+	err := c.cc.Invoke(ctx, Galaxy_GetWallet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) CreateWalletTransaction(ctx context.Context, in *CreateWalletTransactionRequest, opts ...grpc.CallOption) (*WalletTransactionResponse, error) {
+	out := new(WalletTransactionResponse)
+	err := c.cc.Invoke(ctx, Galaxy_CreateWalletTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) ListWalletTransactions(ctx context.Context, in *ListWalletTransactionsRequest, opts ...grpc.CallOption) (*ListWalletTransactionsResponse, error) {
+	out := new(ListWalletTransactionsResponse)
+	err := c.cc.Invoke(ctx, Galaxy_ListWalletTransactions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) CreateGiftCard(ctx context.Context, in *CreateGiftCardRequest, opts ...grpc.CallOption) (*GiftCardResponse, error) {
+	out := new(GiftCardResponse)
+	err := c.cc.Invoke(ctx, Galaxy_CreateGiftCard_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) GetGiftCardByCode(ctx context.Context, in *GetGiftCardByCodeRequest, opts ...grpc.CallOption) (*GiftCardResponse, error) {
+	out := new(GiftCardResponse)
+	err := c.cc.Invoke(ctx, Galaxy_GetGiftCardByCode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) GetGiftCardByEntry(ctx context.Context, in *GetGiftCardByEntryRequest, opts ...grpc.CallOption) (*GiftCardResponse, error) {
+	out := new(GiftCardResponse)
+	err := c.cc.Invoke(ctx, Galaxy_GetGiftCardByEntry_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) DeleteGiftCard(ctx context.Context, in *DeleteGiftCardRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Galaxy_DeleteGiftCard_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) RedeemGiftCard(ctx context.Context, in *RedeemGiftCardRequest, opts ...grpc.CallOption) (*RedeemGiftCardResponse, error) {
+	out := new(RedeemGiftCardResponse)
+	err := c.cc.Invoke(ctx, Galaxy_RedeemGiftCard_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) ReverseGiftCardRedemption(ctx context.Context, in *ReverseGiftCardRedemptionRequest, opts ...grpc.CallOption) (*RedeemGiftCardResponse, error) {
+	out := new(RedeemGiftCardResponse)
+	err := c.cc.Invoke(ctx, Galaxy_ReverseGiftCardRedemption_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) ListGiftCardRedemptions(ctx context.Context, in *ListGiftCardRedemptionsRequest, opts ...grpc.CallOption) (*ListGiftCardRedemptionsResponse, error) {
+	out := new(ListGiftCardRedemptionsResponse)
+	err := c.cc.Invoke(ctx, Galaxy_ListGiftCardRedemptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) GetPointsBalance(ctx context.Context, in *GetPointsBalanceRequest, opts ...grpc.CallOption) (*PointsBalanceResponse, error) {
+	out := new(PointsBalanceResponse)
+	err := c.cc.Invoke(ctx, Galaxy_GetPointsBalance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) CreatePointsTransaction(ctx context.Context, in *CreatePointsTransactionRequest, opts ...grpc.CallOption) (*PointsTransactionResponse, error) {
+	out := new(PointsTransactionResponse)
+	err := c.cc.Invoke(ctx, Galaxy_CreatePointsTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) ListPointsTransactions(ctx context.Context, in *ListPointsTransactionsRequest, opts ...grpc.CallOption) (*ListPointsTransactionsResponse, error) {
+	out := new(ListPointsTransactionsResponse)
+	err := c.cc.Invoke(ctx, Galaxy_ListPointsTransactions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) ExpirePoints(ctx context.Context, in *ExpirePointsRequest, opts ...grpc.CallOption) (*ExpirePointsResponse, error) {
+	out := new(ExpirePointsResponse)
+	err := c.cc.Invoke(ctx, Galaxy_ExpirePoints_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) ConsumeToken(ctx context.Context, in *ConsumeTokenRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Galaxy_ConsumeToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) CreateEntry(ctx context.Context, in *CreateEntryRequest, opts ...grpc.CallOption) (*CreateEntryResponse, error) {
+	out := new(CreateEntryResponse)
+	err := c.cc.Invoke(ctx, Galaxy_CreateEntry_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) GetEntry(ctx context.Context, in *GetEntryRequest, opts ...grpc.CallOption) (*GetEntryResponse, error) {
+	out := new(GetEntryResponse)
+	err := c.cc.Invoke(ctx, Galaxy_GetEntry_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) ListEntries(ctx context.Context, in *ListEntriesRequest, opts ...grpc.CallOption) (*ListEntriesResponse, error) {
+	out := new(ListEntriesResponse)
+	err := c.cc.Invoke(ctx, Galaxy_ListEntries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) ListEntriesByUser(ctx context.Context, in *ListEntriesByUserRequest, opts ...grpc.CallOption) (*ListEntriesResponse, error) {
+	out := new(ListEntriesResponse)
+	err := c.cc.Invoke(ctx, Galaxy_ListEntriesByUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) ListEntriesByItem(ctx context.Context, in *ListEntriesByItemRequest, opts ...grpc.CallOption) (*ListEntriesResponse, error) {
+	out := new(ListEntriesResponse)
+	err := c.cc.Invoke(ctx, Galaxy_ListEntriesByItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) UpdateEntryStatus(ctx context.Context, in *UpdateEntryStatusRequest, opts ...grpc.CallOption) (*UpdateEntryResponse, error) {
+	out := new(UpdateEntryResponse)
+	err := c.cc.Invoke(ctx, Galaxy_UpdateEntryStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *galaxyClient) DeleteEntry(ctx context.Context, in *DeleteEntryRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Galaxy_DeleteEntry_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GalaxyServer is the server API for Galaxy service.
+// All implementations must embed UnimplementedGalaxyServer
+// for forward compatibility
+type GalaxyServer interface {
+	CreateItem(context.Context, *CreateItemRequest) (*CreateItemResponse, error)
+	GetItem(context.Context, *GetItemRequest) (*GetItemResponse, error)
+	ListItems(context.Context, *ListItemsRequest) (*ListItemsResponse, error)
+	UpdateItem(context.Context, *UpdateItemRequest) (*UpdateItemResponse, error)
+	DeleteItem(context.Context, *DeleteItemRequest) (*Empty, error)
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
+	CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error)
+	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
+	GetUserByUsername(context.Context, *GetUserByUsernameRequest) (*GetUserResponse, error)
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
+	UpdateUserStatus(context.Context, *UpdateUserStatusRequest) (*UpdateUserResponse, error)
+	DeleteUser(context.Context, *DeleteUserRequest) (*Empty, error)
+	Authorize(context.Context, *AuthRequest) (*AuthResponse, error)
+	RenewAccessToken(context.Context, *RenewAccessTokenRequest) (*RenewAccessTokenResponse, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*Empty, error)
+	RevokeSessions(context.Context, *RevokeSessionsRequest) (*RevokeSessionsResponse, error)
+	CreateSecurityEvent(context.Context, *CreateSecurityEventRequest) (*Empty, error)
+	ListSecurityEvents(context.Context, *ListSecurityEventsRequest) (*ListSecurityEventsResponse, error)
+	GetPlan(context.Context, *GetPlanRequest) (*GetPlanResponse, error)
+	ListPlans(context.Context, *ListPlansRequest) (*ListPlansResponse, error)
+	ChangePlan(context.Context, *ChangePlanRequest) (*UpdateUserResponse, error)
+	CreatePlanChange(context.Context, *CreatePlanChangeRequest) (*Empty, error)
+	ListPlanChanges(context.Context, *ListPlanChangesRequest) (*ListPlanChangesResponse, error)
+	AcquireLease(context.Context, *AcquireLeaseRequest) (*AcquireLeaseResponse, error)
+	CreateBillingAttempt(context.Context, *CreateBillingAttemptRequest) (*CreateBillingAttemptResponse, error)
+	ListBillingAttempts(context.Context, *ListBillingAttemptsRequest) (*ListBillingAttemptsResponse, error)
+	GetTrial(context.Context, *GetTrialRequest) (*GetTrialResponse, error)
+	UpdateTrial(context.Context, *UpdateTrialRequest) (*UpdateTrialResponse, error)
+	IncrementUsage(context.Context, *IncrementUsageRequest) (*IncrementUsageResponse, error)
+	GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error)
+	CreateTeam(context.Context, *CreateTeamRequest) (*TeamResponse, error)
+	GetTeam(context.Context, *GetTeamRequest) (*TeamResponse, error)
+	ListTeamMembers(context.Context, *ListTeamMembersRequest) (*ListTeamMembersResponse, error)
+	AddTeamMember(context.Context, *AddTeamMemberRequest) (*TeamMemberResponse, error)
+	UpdateTeamMember(context.Context, *UpdateTeamMemberRequest) (*TeamMemberResponse, error)
+	RemoveTeamMember(context.Context, *RemoveTeamMemberRequest) (*Empty, error)
+	CreateTeamInvitation(context.Context, *CreateTeamInvitationRequest) (*TeamInvitationResponse, error)
+	GetTeamInvitation(context.Context, *GetTeamInvitationRequest) (*TeamInvitationResponse, error)
+	AcceptTeamInvitation(context.Context, *AcceptTeamInvitationRequest) (*TeamInvitationResponse, error)
+	ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error)
+	RenewTeam(context.Context, *RenewTeamRequest) (*TeamResponse, error)
+	GetCart(context.Context, *GetCartRequest) (*CartResponse, error)
+	SetCartLine(context.Context, *SetCartLineRequest) (*CartResponse, error)
+	ClearCart(context.Context, *ClearCartRequest) (*Empty, error)
+	CreateOrder(context.Context, *CreateOrderRequest) (*OrderResponse, error)
+	GetOrder(context.Context, *GetOrderRequest) (*OrderResponse, error)
+	ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error)
+	UpdateOrderStatus(context.Context, *UpdateOrderStatusRequest) (*OrderResponse, error)
+	DeleteOrder(context.Context, *DeleteOrderRequest) (*Empty, error)
+	CreateSaga(context.Context, *CreateSagaRequest) (*SagaResponse, error)
+	UpdateSaga(context.Context, *UpdateSagaRequest) (*SagaResponse, error)
+	ListSagas(context.Context, *ListSagasRequest) (*ListSagasResponse, error)
+	CreateCoupon(context.Context, *CreateCouponRequest) (*CouponResponse, error)
+	GetCoupon(context.Context, *GetCouponRequest) (*CouponResponse, error)
+	GetCouponByCode(context.Context, *GetCouponByCodeRequest) (*CouponResponse, error)
+	ListCoupons(context.Context, *ListCouponsRequest) (*ListCouponsResponse, error)
+	UpdateCoupon(context.Context, *UpdateCouponRequest) (*CouponResponse, error)
+	RedeemCoupon(context.Context, *RedeemCouponRequest) (*RedeemCouponResponse, error)
+	DeleteCouponRedemption(context.Context, *DeleteCouponRedemptionRequest) (*Empty, error)
+	ListCouponRedemptions(context.Context, *ListCouponRedemptionsRequest) (*ListCouponRedemptionsResponse, error)
+	GetWallet(context.Context, *GetWalletRequest) (*WalletResponse, error)
+	CreateWalletTransaction(context.Context, *CreateWalletTransactionRequest) (*WalletTransactionResponse, error)
+	ListWalletTransactions(context.Context, *ListWalletTransactionsRequest) (*ListWalletTransactionsResponse, error)
+	CreateGiftCard(context.Context, *CreateGiftCardRequest) (*GiftCardResponse, error)
+	GetGiftCardByCode(context.Context, *GetGiftCardByCodeRequest) (*GiftCardResponse, error)
+	GetGiftCardByEntry(context.Context, *GetGiftCardByEntryRequest) (*GiftCardResponse, error)
+	DeleteGiftCard(context.Context, *DeleteGiftCardRequest) (*Empty, error)
+	RedeemGiftCard(context.Context, *RedeemGiftCardRequest) (*RedeemGiftCardResponse, error)
+	ReverseGiftCardRedemption(context.Context, *ReverseGiftCardRedemptionRequest) (*RedeemGiftCardResponse, error)
+	ListGiftCardRedemptions(context.Context, *ListGiftCardRedemptionsRequest) (*ListGiftCardRedemptionsResponse, error)
+	GetPointsBalance(context.Context, *GetPointsBalanceRequest) (*PointsBalanceResponse, error)
+	CreatePointsTransaction(context.Context, *CreatePointsTransactionRequest) (*PointsTransactionResponse, error)
+	ListPointsTransactions(context.Context, *ListPointsTransactionsRequest) (*ListPointsTransactionsResponse, error)
+	ExpirePoints(context.Context, *ExpirePointsRequest) (*ExpirePointsResponse, error)
+	ConsumeToken(context.Context, *ConsumeTokenRequest) (*Empty, error)
+	CreateEntry(context.Context, *CreateEntryRequest) (*CreateEntryResponse, error)
+	GetEntry(context.Context, *GetEntryRequest) (*GetEntryResponse, error)
+	ListEntries(context.Context, *ListEntriesRequest) (*ListEntriesResponse, error)
+	ListEntriesByUser(context.Context, *ListEntriesByUserRequest) (*ListEntriesResponse, error)
+	ListEntriesByItem(context.Context, *ListEntriesByItemRequest) (*ListEntriesResponse, error)
+	UpdateEntryStatus(context.Context, *UpdateEntryStatusRequest) (*UpdateEntryResponse, error)
+	DeleteEntry(context.Context, *DeleteEntryRequest) (*Empty, error)
+	mustEmbedUnimplementedGalaxyServer()
+}
+
+// UnimplementedGalaxyServer must be embedded to have forward compatible implementations.
+type UnimplementedGalaxyServer struct {
+}
+
+func (UnimplementedGalaxyServer) CreateItem(context.Context, *CreateItemRequest) (*CreateItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateItem not implemented")
+}
+func (UnimplementedGalaxyServer) GetItem(context.Context, *GetItemRequest) (*GetItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItem not implemented")
+}
+func (UnimplementedGalaxyServer) ListItems(context.Context, *ListItemsRequest) (*ListItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListItems not implemented")
+}
+func (UnimplementedGalaxyServer) UpdateItem(context.Context, *UpdateItemRequest) (*UpdateItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateItem not implemented")
+}
+func (UnimplementedGalaxyServer) DeleteItem(context.Context, *DeleteItemRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteItem not implemented")
+}
+func (UnimplementedGalaxyServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedGalaxyServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedGalaxyServer) CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSession not implemented")
+}
+func (UnimplementedGalaxyServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedGalaxyServer) GetUserByUsername(context.Context, *GetUserByUsernameRequest) (*GetUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByUsername not implemented")
+}
+func (UnimplementedGalaxyServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedGalaxyServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedGalaxyServer) UpdateUserStatus(context.Context, *UpdateUserStatusRequest) (*UpdateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserStatus not implemented")
+}
+func (UnimplementedGalaxyServer) DeleteUser(context.Context, *DeleteUserRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedGalaxyServer) Authorize(context.Context, *AuthRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authorize not implemented")
+}
+func (UnimplementedGalaxyServer) RenewAccessToken(context.Context, *RenewAccessTokenRequest) (*RenewAccessTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewAccessToken not implemented")
+}
+func (UnimplementedGalaxyServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedGalaxyServer) RevokeSession(context.Context, *RevokeSessionRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedGalaxyServer) RevokeSessions(context.Context, *RevokeSessionsRequest) (*RevokeSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSessions not implemented")
+}
+func (UnimplementedGalaxyServer) CreateSecurityEvent(context.Context, *CreateSecurityEventRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSecurityEvent not implemented")
+}
+func (UnimplementedGalaxyServer) ListSecurityEvents(context.Context, *ListSecurityEventsRequest) (*ListSecurityEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSecurityEvents not implemented")
+}
+func (UnimplementedGalaxyServer) GetPlan(context.Context, *GetPlanRequest) (*GetPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlan not implemented")
+}
+func (UnimplementedGalaxyServer) ListPlans(context.Context, *ListPlansRequest) (*ListPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlans not implemented")
+}
+func (UnimplementedGalaxyServer) ChangePlan(context.Context, *ChangePlanRequest) (*UpdateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePlan not implemented")
+}
+func (UnimplementedGalaxyServer) CreatePlanChange(context.Context, *CreatePlanChangeRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePlanChange not implemented")
+}
+func (UnimplementedGalaxyServer) ListPlanChanges(context.Context, *ListPlanChangesRequest) (*ListPlanChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlanChanges not implemented")
+}
+func (UnimplementedGalaxyServer) AcquireLease(context.Context, *AcquireLeaseRequest) (*AcquireLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcquireLease not implemented")
+}
+func (UnimplementedGalaxyServer) CreateBillingAttempt(context.Context, *CreateBillingAttemptRequest) (*CreateBillingAttemptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBillingAttempt not implemented")
+}
+func (UnimplementedGalaxyServer) ListBillingAttempts(context.Context, *ListBillingAttemptsRequest) (*ListBillingAttemptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBillingAttempts not implemented")
+}
+func (UnimplementedGalaxyServer) GetTrial(context.Context, *GetTrialRequest) (*GetTrialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrial not implemented")
+}
+func (UnimplementedGalaxyServer) UpdateTrial(context.Context, *UpdateTrialRequest) (*UpdateTrialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTrial not implemented")
+}
+func (UnimplementedGalaxyServer) IncrementUsage(context.Context, *IncrementUsageRequest) (*IncrementUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncrementUsage not implemented")
+}
+func (UnimplementedGalaxyServer) GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsage not implemented")
+}
+func (UnimplementedGalaxyServer) CreateTeam(context.Context, *CreateTeamRequest) (*TeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeam not implemented")
+}
+func (UnimplementedGalaxyServer) GetTeam(context.Context, *GetTeamRequest) (*TeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeam not implemented")
+}
+func (UnimplementedGalaxyServer) ListTeamMembers(context.Context, *ListTeamMembersRequest) (*ListTeamMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTeamMembers not implemented")
+}
+func (UnimplementedGalaxyServer) AddTeamMember(context.Context, *AddTeamMemberRequest) (*TeamMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTeamMember not implemented")
+}
+func (UnimplementedGalaxyServer) UpdateTeamMember(context.Context, *UpdateTeamMemberRequest) (*TeamMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTeamMember not implemented")
+}
+func (UnimplementedGalaxyServer) RemoveTeamMember(context.Context, *RemoveTeamMemberRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTeamMember not implemented")
+}
+func (UnimplementedGalaxyServer) CreateTeamInvitation(context.Context, *CreateTeamInvitationRequest) (*TeamInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeamInvitation not implemented")
+}
+func (UnimplementedGalaxyServer) GetTeamInvitation(context.Context, *GetTeamInvitationRequest) (*TeamInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeamInvitation not implemented")
+}
+func (UnimplementedGalaxyServer) AcceptTeamInvitation(context.Context, *AcceptTeamInvitationRequest) (*TeamInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptTeamInvitation not implemented")
+}
+func (UnimplementedGalaxyServer) ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTeams not implemented")
+}
+func (UnimplementedGalaxyServer) RenewTeam(context.Context, *RenewTeamRequest) (*TeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewTeam not implemented")
+}
+func (UnimplementedGalaxyServer) GetCart(context.Context, *GetCartRequest) (*CartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCart not implemented")
+}
+func (UnimplementedGalaxyServer) SetCartLine(context.Context, *SetCartLineRequest) (*CartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCartLine not implemented")
+}
+func (UnimplementedGalaxyServer) ClearCart(context.Context, *ClearCartRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearCart not implemented")
+}
+func (UnimplementedGalaxyServer) CreateOrder(context.Context, *CreateOrderRequest) (*OrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrder not implemented")
+}
+func (UnimplementedGalaxyServer) GetOrder(context.Context, *GetOrderRequest) (*OrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+}
+func (UnimplementedGalaxyServer) ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
+}
+func (UnimplementedGalaxyServer) UpdateOrderStatus(context.Context, *UpdateOrderStatusRequest) (*OrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrderStatus not implemented")
+}
+func (UnimplementedGalaxyServer) DeleteOrder(context.Context, *DeleteOrderRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrder not implemented")
+}
+func (UnimplementedGalaxyServer) CreateSaga(context.Context, *CreateSagaRequest) (*SagaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSaga not implemented")
+}
+func (UnimplementedGalaxyServer) UpdateSaga(context.Context, *UpdateSagaRequest) (*SagaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSaga not implemented")
+}
+func (UnimplementedGalaxyServer) ListSagas(context.Context, *ListSagasRequest) (*ListSagasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSagas not implemented")
+}
+func (UnimplementedGalaxyServer) CreateCoupon(context.Context, *CreateCouponRequest) (*CouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCoupon not implemented")
+}
+func (UnimplementedGalaxyServer) GetCoupon(context.Context, *GetCouponRequest) (*CouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoupon not implemented")
+}
+func (UnimplementedGalaxyServer) GetCouponByCode(context.Context, *GetCouponByCodeRequest) (*CouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCouponByCode not implemented")
+}
+func (UnimplementedGalaxyServer) ListCoupons(context.Context, *ListCouponsRequest) (*ListCouponsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCoupons not implemented")
+}
+func (UnimplementedGalaxyServer) UpdateCoupon(context.Context, *UpdateCouponRequest) (*CouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCoupon not implemented")
+}
+func (UnimplementedGalaxyServer) RedeemCoupon(context.Context, *RedeemCouponRequest) (*RedeemCouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemCoupon not implemented")
+}
+func (UnimplementedGalaxyServer) DeleteCouponRedemption(context.Context, *DeleteCouponRedemptionRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCouponRedemption not implemented")
+}
+func (UnimplementedGalaxyServer) ListCouponRedemptions(context.Context, *ListCouponRedemptionsRequest) (*ListCouponRedemptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCouponRedemptions not implemented")
+}
+func (UnimplementedGalaxyServer) GetWallet(context.Context, *GetWalletRequest) (*WalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWallet not implemented")
+}
+func (UnimplementedGalaxyServer) CreateWalletTransaction(context.Context, *CreateWalletTransactionRequest) (*WalletTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWalletTransaction not implemented")
+}
+func (UnimplementedGalaxyServer) ListWalletTransactions(context.Context, *ListWalletTransactionsRequest) (*ListWalletTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWalletTransactions not implemented")
+}
+func (UnimplementedGalaxyServer) CreateGiftCard(context.Context, *CreateGiftCardRequest) (*GiftCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGiftCard not implemented")
+}
+func (UnimplementedGalaxyServer) GetGiftCardByCode(context.Context, *GetGiftCardByCodeRequest) (*GiftCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGiftCardByCode not implemented")
+}
+func (UnimplementedGalaxyServer) GetGiftCardByEntry(context.Context, *GetGiftCardByEntryRequest) (*GiftCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGiftCardByEntry not implemented")
+}
+func (UnimplementedGalaxyServer) DeleteGiftCard(context.Context, *DeleteGiftCardRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGiftCard not implemented")
+}
+func (UnimplementedGalaxyServer) RedeemGiftCard(context.Context, *RedeemGiftCardRequest) (*RedeemGiftCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemGiftCard not implemented")
+}
+func (UnimplementedGalaxyServer) ReverseGiftCardRedemption(context.Context, *ReverseGiftCardRedemptionRequest) (*RedeemGiftCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseGiftCardRedemption not implemented")
+}
+func (UnimplementedGalaxyServer) ListGiftCardRedemptions(context.Context, *ListGiftCardRedemptionsRequest) (*ListGiftCardRedemptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGiftCardRedemptions not implemented")
+}
+func (UnimplementedGalaxyServer) GetPointsBalance(context.Context, *GetPointsBalanceRequest) (*PointsBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPointsBalance not implemented")
+}
+func (UnimplementedGalaxyServer) CreatePointsTransaction(context.Context, *CreatePointsTransactionRequest) (*PointsTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePointsTransaction not implemented")
+}
+func (UnimplementedGalaxyServer) ListPointsTransactions(context.Context, *ListPointsTransactionsRequest) (*ListPointsTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPointsTransactions not implemented")
+}
+func (UnimplementedGalaxyServer) ExpirePoints(context.Context, *ExpirePointsRequest) (*ExpirePointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpirePoints not implemented")
+}
+func (UnimplementedGalaxyServer) ConsumeToken(context.Context, *ConsumeTokenRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsumeToken not implemented")
+}
+func (UnimplementedGalaxyServer) CreateEntry(context.Context, *CreateEntryRequest) (*CreateEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEntry not implemented")
+}
+func (UnimplementedGalaxyServer) GetEntry(context.Context, *GetEntryRequest) (*GetEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEntry not implemented")
+}
+func (UnimplementedGalaxyServer) ListEntries(context.Context, *ListEntriesRequest) (*ListEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEntries not implemented")
+}
+func (UnimplementedGalaxyServer) ListEntriesByUser(context.Context, *ListEntriesByUserRequest) (*ListEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEntriesByUser not implemented")
+}
+func (UnimplementedGalaxyServer) ListEntriesByItem(context.Context, *ListEntriesByItemRequest) (*ListEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEntriesByItem not implemented")
+}
+func (UnimplementedGalaxyServer) UpdateEntryStatus(context.Context, *UpdateEntryStatusRequest) (*UpdateEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEntryStatus not implemented")
+}
+func (UnimplementedGalaxyServer) DeleteEntry(context.Context, *DeleteEntryRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEntry not implemented")
+}
+func (UnimplementedGalaxyServer) mustEmbedUnimplementedGalaxyServer() {}
+
+// UnsafeGalaxyServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GalaxyServer will
+// result in compilation errors.
+type UnsafeGalaxyServer interface {
+	mustEmbedUnimplementedGalaxyServer()
+}
+
+func RegisterGalaxyServer(s grpc.ServiceRegistrar, srv GalaxyServer) {
+	s.RegisterService(&Galaxy_ServiceDesc, srv)
+}
+
+func _Galaxy_CreateItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).CreateItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_CreateItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).CreateItem(ctx, req.(*CreateItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_GetItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).GetItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_GetItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).GetItem(ctx, req.(*GetItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_ListItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).ListItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_ListItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).ListItems(ctx, req.(*ListItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_UpdateItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).UpdateItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_UpdateItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).UpdateItem(ctx, req.(*UpdateItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_DeleteItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).DeleteItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_DeleteItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).DeleteItem(ctx, req.(*DeleteItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_CreateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).CreateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_CreateSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).CreateSession(ctx, req.(*CreateSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_GetUserByUsername_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserByUsernameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).GetUserByUsername(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_GetUserByUsername_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).GetUserByUsername(ctx, req.(*GetUserByUsernameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_UpdateUserStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).UpdateUserStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_UpdateUserStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).UpdateUserStatus(ctx, req.(*UpdateUserStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_Authorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).Authorize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_Authorize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).Authorize(ctx, req.(*AuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_RenewAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).RenewAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_RenewAccessToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).RenewAccessToken(ctx, req.(*RenewAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_RevokeSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).RevokeSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_RevokeSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).RevokeSessions(ctx, req.(*RevokeSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_CreateSecurityEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSecurityEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).CreateSecurityEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_CreateSecurityEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).CreateSecurityEvent(ctx, req.(*CreateSecurityEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_ListSecurityEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSecurityEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).ListSecurityEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_ListSecurityEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).ListSecurityEvents(ctx, req.(*ListSecurityEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_GetPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).GetPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_GetPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).GetPlan(ctx, req.(*GetPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_ListPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).ListPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_ListPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).ListPlans(ctx, req.(*ListPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_ChangePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).ChangePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_ChangePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).ChangePlan(ctx, req.(*ChangePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_CreatePlanChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePlanChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).CreatePlanChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_CreatePlanChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).CreatePlanChange(ctx, req.(*CreatePlanChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_ListPlanChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlanChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).ListPlanChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_ListPlanChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).ListPlanChanges(ctx, req.(*ListPlanChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_AcquireLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcquireLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).AcquireLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_AcquireLease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).AcquireLease(ctx, req.(*AcquireLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_CreateBillingAttempt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBillingAttemptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).CreateBillingAttempt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_CreateBillingAttempt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).CreateBillingAttempt(ctx, req.(*CreateBillingAttemptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_ListBillingAttempts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBillingAttemptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).ListBillingAttempts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_ListBillingAttempts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).ListBillingAttempts(ctx, req.(*ListBillingAttemptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_GetTrial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).GetTrial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_GetTrial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).GetTrial(ctx, req.(*GetTrialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_UpdateTrial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTrialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).UpdateTrial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_UpdateTrial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).UpdateTrial(ctx, req.(*UpdateTrialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_IncrementUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrementUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).IncrementUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_IncrementUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).IncrementUsage(ctx, req.(*IncrementUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_GetUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).GetUsage(ctx, req.(*GetUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_CreateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).CreateTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_CreateTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).CreateTeam(ctx, req.(*CreateTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_GetTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).GetTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_GetTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).GetTeam(ctx, req.(*GetTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_ListTeamMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTeamMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).ListTeamMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_ListTeamMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).ListTeamMembers(ctx, req.(*ListTeamMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_AddTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTeamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).AddTeamMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_AddTeamMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).AddTeamMember(ctx, req.(*AddTeamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_UpdateTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTeamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).UpdateTeamMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_UpdateTeamMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).UpdateTeamMember(ctx, req.(*UpdateTeamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_RemoveTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTeamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).RemoveTeamMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_RemoveTeamMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).RemoveTeamMember(ctx, req.(*RemoveTeamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_CreateTeamInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeamInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).CreateTeamInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_CreateTeamInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).CreateTeamInvitation(ctx, req.(*CreateTeamInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_GetTeamInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).GetTeamInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_GetTeamInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).GetTeamInvitation(ctx, req.(*GetTeamInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_AcceptTeamInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptTeamInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).AcceptTeamInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_AcceptTeamInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).AcceptTeamInvitation(ctx, req.(*AcceptTeamInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_ListTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).ListTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_ListTeams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).ListTeams(ctx, req.(*ListTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_RenewTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).RenewTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_RenewTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).RenewTeam(ctx, req.(*RenewTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_GetCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).GetCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_GetCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).GetCart(ctx, req.(*GetCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_SetCartLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCartLineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).SetCartLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_SetCartLine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).SetCartLine(ctx, req.(*SetCartLineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_ClearCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).ClearCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_ClearCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).ClearCart(ctx, req.(*ClearCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_CreateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).CreateOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_CreateOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).CreateOrder(ctx, req.(*CreateOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_GetOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).GetOrder(ctx, req.(*GetOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).ListOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_ListOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).ListOrders(ctx, req.(*ListOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_UpdateOrderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).UpdateOrderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_UpdateOrderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).UpdateOrderStatus(ctx, req.(*UpdateOrderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_DeleteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).DeleteOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_DeleteOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).DeleteOrder(ctx, req.(*DeleteOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_CreateSaga_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSagaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).CreateSaga(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_CreateSaga_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).CreateSaga(ctx, req.(*CreateSagaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_UpdateSaga_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSagaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).UpdateSaga(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_UpdateSaga_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).UpdateSaga(ctx, req.(*UpdateSagaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_ListSagas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSagasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).ListSagas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_ListSagas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).ListSagas(ctx, req.(*ListSagasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_CreateCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).CreateCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_CreateCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).CreateCoupon(ctx, req.(*CreateCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_GetCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).GetCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_GetCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).GetCoupon(ctx, req.(*GetCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_GetCouponByCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCouponByCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).GetCouponByCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_GetCouponByCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).GetCouponByCode(ctx, req.(*GetCouponByCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_ListCoupons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCouponsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).ListCoupons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_ListCoupons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).ListCoupons(ctx, req.(*ListCouponsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_UpdateCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).UpdateCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_UpdateCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).UpdateCoupon(ctx, req.(*UpdateCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_RedeemCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).RedeemCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_RedeemCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).RedeemCoupon(ctx, req.(*RedeemCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Galaxy_DeleteCouponRedemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCouponRedemptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).DeleteCouponRedemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_DeleteCouponRedemption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).DeleteCouponRedemption(ctx, req.(*DeleteCouponRedemptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGalaxyServer) DeleteEntry(context.Context, *DeleteEntryRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteEntry not implemented")
+
+func _Galaxy_ListCouponRedemptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCouponRedemptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).ListCouponRedemptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_ListCouponRedemptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).ListCouponRedemptions(ctx, req.(*ListCouponRedemptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGalaxyServer) mustEmbedUnimplementedGalaxyServer() {}
 
-// UnsafeGalaxyServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to GalaxyServer will
-// result in compilation errors.
-type UnsafeGalaxyServer interface {
-	mustEmbedUnimplementedGalaxyServer()
+func _Galaxy_GetWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).GetWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_GetWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).GetWallet(ctx, req.(*GetWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterGalaxyServer(s grpc.ServiceRegistrar, srv GalaxyServer) {
-	s.RegisterService(&Galaxy_ServiceDesc, srv)
+func _Galaxy_CreateWalletTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWalletTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).CreateWalletTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_CreateWalletTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).CreateWalletTransaction(ctx, req.(*CreateWalletTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Galaxy_CreateItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateItemRequest)
+func _Galaxy_ListWalletTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWalletTransactionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GalaxyServer).CreateItem(ctx, in)
+		return srv.(GalaxyServer).ListWalletTransactions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Galaxy_CreateItem_FullMethodName,
+		FullMethod: Galaxy_ListWalletTransactions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GalaxyServer).CreateItem(ctx, req.(*CreateItemRequest))
+		return srv.(GalaxyServer).ListWalletTransactions(ctx, req.(*ListWalletTransactionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Galaxy_GetItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetItemRequest)
+func _Galaxy_CreateGiftCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGiftCardRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GalaxyServer).GetItem(ctx, in)
+		return srv.(GalaxyServer).CreateGiftCard(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Galaxy_GetItem_FullMethodName,
+		FullMethod: Galaxy_CreateGiftCard_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GalaxyServer).GetItem(ctx, req.(*GetItemRequest))
+		return srv.(GalaxyServer).CreateGiftCard(ctx, req.(*CreateGiftCardRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Galaxy_ListItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListItemsRequest)
+func _Galaxy_GetGiftCardByCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGiftCardByCodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GalaxyServer).ListItems(ctx, in)
+		return srv.(GalaxyServer).GetGiftCardByCode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Galaxy_ListItems_FullMethodName,
+		FullMethod: Galaxy_GetGiftCardByCode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GalaxyServer).ListItems(ctx, req.(*ListItemsRequest))
+		return srv.(GalaxyServer).GetGiftCardByCode(ctx, req.(*GetGiftCardByCodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Galaxy_UpdateItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateItemRequest)
+func _Galaxy_GetGiftCardByEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGiftCardByEntryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GalaxyServer).UpdateItem(ctx, in)
+		return srv.(GalaxyServer).GetGiftCardByEntry(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Galaxy_UpdateItem_FullMethodName,
+		FullMethod: Galaxy_GetGiftCardByEntry_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GalaxyServer).UpdateItem(ctx, req.(*UpdateItemRequest))
+		return srv.(GalaxyServer).GetGiftCardByEntry(ctx, req.(*GetGiftCardByEntryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Galaxy_DeleteItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteItemRequest)
+func _Galaxy_DeleteGiftCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGiftCardRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GalaxyServer).DeleteItem(ctx, in)
+		return srv.(GalaxyServer).DeleteGiftCard(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Galaxy_DeleteItem_FullMethodName,
+		FullMethod: Galaxy_DeleteGiftCard_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GalaxyServer).DeleteItem(ctx, req.(*DeleteItemRequest))
+		return srv.(GalaxyServer).DeleteGiftCard(ctx, req.(*DeleteGiftCardRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Galaxy_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _Galaxy_RedeemGiftCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemGiftCardRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GalaxyServer).Login(ctx, in)
+		return srv.(GalaxyServer).RedeemGiftCard(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Galaxy_Login_FullMethodName,
+		FullMethod: Galaxy_RedeemGiftCard_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GalaxyServer).Login(ctx, req.(*LoginRequest))
+		return srv.(GalaxyServer).RedeemGiftCard(ctx, req.(*RedeemGiftCardRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Galaxy_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserRequest)
+func _Galaxy_ReverseGiftCardRedemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseGiftCardRedemptionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GalaxyServer).CreateUser(ctx, in)
+		return srv.(GalaxyServer).ReverseGiftCardRedemption(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Galaxy_CreateUser_FullMethodName,
+		FullMethod: Galaxy_ReverseGiftCardRedemption_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GalaxyServer).CreateUser(ctx, req.(*CreateUserRequest))
+		return srv.(GalaxyServer).ReverseGiftCardRedemption(ctx, req.(*ReverseGiftCardRedemptionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Galaxy_CreateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSessionRequest)
+func _Galaxy_ListGiftCardRedemptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGiftCardRedemptionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GalaxyServer).CreateSession(ctx, in)
+		return srv.(GalaxyServer).ListGiftCardRedemptions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Galaxy_CreateSession_FullMethodName,
+		FullMethod: Galaxy_ListGiftCardRedemptions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GalaxyServer).CreateSession(ctx, req.(*CreateSessionRequest))
+		return srv.(GalaxyServer).ListGiftCardRedemptions(ctx, req.(*ListGiftCardRedemptionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Galaxy_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserRequest)
+func _Galaxy_GetPointsBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPointsBalanceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GalaxyServer).GetUser(ctx, in)
+		return srv.(GalaxyServer).GetPointsBalance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Galaxy_GetUser_FullMethodName,
+		FullMethod: Galaxy_GetPointsBalance_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GalaxyServer).GetUser(ctx, req.(*GetUserRequest))
+		return srv.(GalaxyServer).GetPointsBalance(ctx, req.(*GetPointsBalanceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Galaxy_GetUserByUsername_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserByUsernameRequest)
+func _Galaxy_CreatePointsTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePointsTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GalaxyServer).GetUserByUsername(ctx, in)
+		return srv.(GalaxyServer).CreatePointsTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Galaxy_GetUserByUsername_FullMethodName,
+		FullMethod: Galaxy_CreatePointsTransaction_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GalaxyServer).GetUserByUsername(ctx, req.(*GetUserByUsernameRequest))
+		return srv.(GalaxyServer).CreatePointsTransaction(ctx, req.(*CreatePointsTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Galaxy_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
+func _Galaxy_ListPointsTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPointsTransactionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GalaxyServer).UpdateUser(ctx, in)
+		return srv.(GalaxyServer).ListPointsTransactions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Galaxy_UpdateUser_FullMethodName,
+		FullMethod: Galaxy_ListPointsTransactions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GalaxyServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+		return srv.(GalaxyServer).ListPointsTransactions(ctx, req.(*ListPointsTransactionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Galaxy_Authorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthRequest)
+func _Galaxy_ExpirePoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpirePointsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GalaxyServer).Authorize(ctx, in)
+		return srv.(GalaxyServer).ExpirePoints(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Galaxy_Authorize_FullMethodName,
+		FullMethod: Galaxy_ExpirePoints_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GalaxyServer).Authorize(ctx, req.(*AuthRequest))
+		return srv.(GalaxyServer).ExpirePoints(ctx, req.(*ExpirePointsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Galaxy_RenewAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RenewAccessTokenRequest)
+func _Galaxy_ConsumeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumeTokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GalaxyServer).RenewAccessToken(ctx, in)
+		return srv.(GalaxyServer).ConsumeToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Galaxy_RenewAccessToken_FullMethodName,
+		FullMethod: Galaxy_ConsumeToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GalaxyServer).RenewAccessToken(ctx, req.(*RenewAccessTokenRequest))
+		return srv.(GalaxyServer).ConsumeToken(ctx, req.(*ConsumeTokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -668,6 +2828,24 @@ func _Galaxy_ListEntriesByItem_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Galaxy_UpdateEntryStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEntryStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GalaxyServer).UpdateEntryStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Galaxy_UpdateEntryStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GalaxyServer).UpdateEntryStatus(ctx, req.(*UpdateEntryStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Galaxy_DeleteEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteEntryRequest)
 	if err := dec(in); err != nil {
@@ -733,10 +2911,22 @@ var Galaxy_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserByUsername",
 			Handler:    _Galaxy_GetUserByUsername_Handler,
 		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _Galaxy_ListUsers_Handler,
+		},
 		{
 			MethodName: "UpdateUser",
 			Handler:    _Galaxy_UpdateUser_Handler,
 		},
+		{
+			MethodName: "UpdateUserStatus",
+			Handler:    _Galaxy_UpdateUserStatus_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _Galaxy_DeleteUser_Handler,
+		},
 		{
 			MethodName: "Authorize",
 			Handler:    _Galaxy_Authorize_Handler,
@@ -745,6 +2935,254 @@ var Galaxy_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RenewAccessToken",
 			Handler:    _Galaxy_RenewAccessToken_Handler,
 		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _Galaxy_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _Galaxy_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeSessions",
+			Handler:    _Galaxy_RevokeSessions_Handler,
+		},
+		{
+			MethodName: "CreateSecurityEvent",
+			Handler:    _Galaxy_CreateSecurityEvent_Handler,
+		},
+		{
+			MethodName: "ListSecurityEvents",
+			Handler:    _Galaxy_ListSecurityEvents_Handler,
+		},
+		{
+			MethodName: "GetPlan",
+			Handler:    _Galaxy_GetPlan_Handler,
+		},
+		{
+			MethodName: "ListPlans",
+			Handler:    _Galaxy_ListPlans_Handler,
+		},
+		{
+			MethodName: "ChangePlan",
+			Handler:    _Galaxy_ChangePlan_Handler,
+		},
+		{
+			MethodName: "CreatePlanChange",
+			Handler:    _Galaxy_CreatePlanChange_Handler,
+		},
+		{
+			MethodName: "ListPlanChanges",
+			Handler:    _Galaxy_ListPlanChanges_Handler,
+		},
+		{
+			MethodName: "AcquireLease",
+			Handler:    _Galaxy_AcquireLease_Handler,
+		},
+		{
+			MethodName: "CreateBillingAttempt",
+			Handler:    _Galaxy_CreateBillingAttempt_Handler,
+		},
+		{
+			MethodName: "ListBillingAttempts",
+			Handler:    _Galaxy_ListBillingAttempts_Handler,
+		},
+		{
+			MethodName: "GetTrial",
+			Handler:    _Galaxy_GetTrial_Handler,
+		},
+		{
+			MethodName: "UpdateTrial",
+			Handler:    _Galaxy_UpdateTrial_Handler,
+		},
+		{
+			MethodName: "IncrementUsage",
+			Handler:    _Galaxy_IncrementUsage_Handler,
+		},
+		{
+			MethodName: "GetUsage",
+			Handler:    _Galaxy_GetUsage_Handler,
+		},
+		{
+			MethodName: "CreateTeam",
+			Handler:    _Galaxy_CreateTeam_Handler,
+		},
+		{
+			MethodName: "GetTeam",
+			Handler:    _Galaxy_GetTeam_Handler,
+		},
+		{
+			MethodName: "ListTeamMembers",
+			Handler:    _Galaxy_ListTeamMembers_Handler,
+		},
+		{
+			MethodName: "AddTeamMember",
+			Handler:    _Galaxy_AddTeamMember_Handler,
+		},
+		{
+			MethodName: "UpdateTeamMember",
+			Handler:    _Galaxy_UpdateTeamMember_Handler,
+		},
+		{
+			MethodName: "RemoveTeamMember",
+			Handler:    _Galaxy_RemoveTeamMember_Handler,
+		},
+		{
+			MethodName: "CreateTeamInvitation",
+			Handler:    _Galaxy_CreateTeamInvitation_Handler,
+		},
+		{
+			MethodName: "GetTeamInvitation",
+			Handler:    _Galaxy_GetTeamInvitation_Handler,
+		},
+		{
+			MethodName: "AcceptTeamInvitation",
+			Handler:    _Galaxy_AcceptTeamInvitation_Handler,
+		},
+		{
+			MethodName: "ListTeams",
+			Handler:    _Galaxy_ListTeams_Handler,
+		},
+		{
+			MethodName: "RenewTeam",
+			Handler:    _Galaxy_RenewTeam_Handler,
+		},
+		{
+			MethodName: "GetCart",
+			Handler:    _Galaxy_GetCart_Handler,
+		},
+		{
+			MethodName: "SetCartLine",
+			Handler:    _Galaxy_SetCartLine_Handler,
+		},
+		{
+			MethodName: "ClearCart",
+			Handler:    _Galaxy_ClearCart_Handler,
+		},
+		{
+			MethodName: "CreateOrder",
+			Handler:    _Galaxy_CreateOrder_Handler,
+		},
+		{
+			MethodName: "GetOrder",
+			Handler:    _Galaxy_GetOrder_Handler,
+		},
+		{
+			MethodName: "ListOrders",
+			Handler:    _Galaxy_ListOrders_Handler,
+		},
+		{
+			MethodName: "UpdateOrderStatus",
+			Handler:    _Galaxy_UpdateOrderStatus_Handler,
+		},
+		{
+			MethodName: "DeleteOrder",
+			Handler:    _Galaxy_DeleteOrder_Handler,
+		},
+		{
+			MethodName: "CreateSaga",
+			Handler:    _Galaxy_CreateSaga_Handler,
+		},
+		{
+			MethodName: "UpdateSaga",
+			Handler:    _Galaxy_UpdateSaga_Handler,
+		},
+		{
+			MethodName: "ListSagas",
+			Handler:    _Galaxy_ListSagas_Handler,
+		},
+		{
+			MethodName: "CreateCoupon",
+			Handler:    _Galaxy_CreateCoupon_Handler,
+		},
+		{
+			MethodName: "GetCoupon",
+			Handler:    _Galaxy_GetCoupon_Handler,
+		},
+		{
+			MethodName: "GetCouponByCode",
+			Handler:    _Galaxy_GetCouponByCode_Handler,
+		},
+		{
+			MethodName: "ListCoupons",
+			Handler:    _Galaxy_ListCoupons_Handler,
+		},
+		{
+			MethodName: "UpdateCoupon",
+			Handler:    _Galaxy_UpdateCoupon_Handler,
+		},
+		{
+			MethodName: "RedeemCoupon",
+			Handler:    _Galaxy_RedeemCoupon_Handler,
+		},
+		{
+			MethodName: "DeleteCouponRedemption",
+			Handler:    _Galaxy_DeleteCouponRedemption_Handler,
+		},
+		{
+			MethodName: "ListCouponRedemptions",
+			Handler:    _Galaxy_ListCouponRedemptions_Handler,
+		},
+		{
+			MethodName: "GetWallet",
+			Handler:    _Galaxy_GetWallet_Handler,
+		},
+		{
+			MethodName: "CreateWalletTransaction",
+			Handler:    _Galaxy_CreateWalletTransaction_Handler,
+		},
+		{
+			MethodName: "ListWalletTransactions",
+			Handler:    _Galaxy_ListWalletTransactions_Handler,
+		},
+		{
+			MethodName: "CreateGiftCard",
+			Handler:    _Galaxy_CreateGiftCard_Handler,
+		},
+		{
+			MethodName: "GetGiftCardByCode",
+			Handler:    _Galaxy_GetGiftCardByCode_Handler,
+		},
+		{
+			MethodName: "GetGiftCardByEntry",
+			Handler:    _Galaxy_GetGiftCardByEntry_Handler,
+		},
+		{
+			MethodName: "DeleteGiftCard",
+			Handler:    _Galaxy_DeleteGiftCard_Handler,
+		},
+		{
+			MethodName: "RedeemGiftCard",
+			Handler:    _Galaxy_RedeemGiftCard_Handler,
+		},
+		{
+			MethodName: "ReverseGiftCardRedemption",
+			Handler:    _Galaxy_ReverseGiftCardRedemption_Handler,
+		},
+		{
+			MethodName: "ListGiftCardRedemptions",
+			Handler:    _Galaxy_ListGiftCardRedemptions_Handler,
+		},
+		{
+			MethodName: "GetPointsBalance",
+			Handler:    _Galaxy_GetPointsBalance_Handler,
+		},
+		{
+			MethodName: "CreatePointsTransaction",
+			Handler:    _Galaxy_CreatePointsTransaction_Handler,
+		},
+		{
+			MethodName: "ListPointsTransactions",
+			Handler:    _Galaxy_ListPointsTransactions_Handler,
+		},
+		{
+			MethodName: "ExpirePoints",
+			Handler:    _Galaxy_ExpirePoints_Handler,
+		},
+		{
+			MethodName: "ConsumeToken",
+			Handler:    _Galaxy_ConsumeToken_Handler,
+		},
 		{
 			MethodName: "CreateEntry",
 			Handler:    _Galaxy_CreateEntry_Handler,
@@ -765,6 +3203,10 @@ var Galaxy_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListEntriesByItem",
 			Handler:    _Galaxy_ListEntriesByItem_Handler,
 		},
+		{
+			MethodName: "UpdateEntryStatus",
+			Handler:    _Galaxy_UpdateEntryStatus_Handler,
+		},
 		{
 			MethodName: "DeleteEntry",
 			Handler:    _Galaxy_DeleteEntry_Handler,
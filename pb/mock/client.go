@@ -36,6 +36,66 @@ func (m *MockGalaxyClient) EXPECT() *MockGalaxyClientMockRecorder {
 	return m.recorder
 }
 
+// AcceptTeamInvitation mocks base method.
+func (m *MockGalaxyClient) AcceptTeamInvitation(arg0 context.Context, arg1 *pb.AcceptTeamInvitationRequest, arg2 ...grpc.CallOption) (*pb.TeamInvitationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AcceptTeamInvitation", varargs...)
+	ret0, _ := ret[0].(*pb.TeamInvitationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptTeamInvitation indicates an expected call of AcceptTeamInvitation.
+func (mr *MockGalaxyClientMockRecorder) AcceptTeamInvitation(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptTeamInvitation", reflect.TypeOf((*MockGalaxyClient)(nil).AcceptTeamInvitation), varargs...)
+}
+
+// AcquireLease mocks base method.
+func (m *MockGalaxyClient) AcquireLease(arg0 context.Context, arg1 *pb.AcquireLeaseRequest, arg2 ...grpc.CallOption) (*pb.AcquireLeaseResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AcquireLease", varargs...)
+	ret0, _ := ret[0].(*pb.AcquireLeaseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcquireLease indicates an expected call of AcquireLease.
+func (mr *MockGalaxyClientMockRecorder) AcquireLease(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireLease", reflect.TypeOf((*MockGalaxyClient)(nil).AcquireLease), varargs...)
+}
+
+// AddTeamMember mocks base method.
+func (m *MockGalaxyClient) AddTeamMember(arg0 context.Context, arg1 *pb.AddTeamMemberRequest, arg2 ...grpc.CallOption) (*pb.TeamMemberResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddTeamMember", varargs...)
+	ret0, _ := ret[0].(*pb.TeamMemberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTeamMember indicates an expected call of AddTeamMember.
+func (mr *MockGalaxyClientMockRecorder) AddTeamMember(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTeamMember", reflect.TypeOf((*MockGalaxyClient)(nil).AddTeamMember), varargs...)
+}
+
 // Authorize mocks base method.
 func (m *MockGalaxyClient) Authorize(arg0 context.Context, arg1 *pb.AuthRequest, arg2 ...grpc.CallOption) (*pb.AuthResponse, error) {
 	m.ctrl.T.Helper()
@@ -43,337 +103,1497 @@ func (m *MockGalaxyClient) Authorize(arg0 context.Context, arg1 *pb.AuthRequest,
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "Authorize", varargs...)
-	ret0, _ := ret[0].(*pb.AuthResponse)
+	ret := m.ctrl.Call(m, "Authorize", varargs...)
+	ret0, _ := ret[0].(*pb.AuthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockGalaxyClientMockRecorder) Authorize(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockGalaxyClient)(nil).Authorize), varargs...)
+}
+
+// ChangePlan mocks base method.
+func (m *MockGalaxyClient) ChangePlan(arg0 context.Context, arg1 *pb.ChangePlanRequest, arg2 ...grpc.CallOption) (*pb.UpdateUserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ChangePlan", varargs...)
+	ret0, _ := ret[0].(*pb.UpdateUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePlan indicates an expected call of ChangePlan.
+func (mr *MockGalaxyClientMockRecorder) ChangePlan(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePlan", reflect.TypeOf((*MockGalaxyClient)(nil).ChangePlan), varargs...)
+}
+
+// ClearCart mocks base method.
+func (m *MockGalaxyClient) ClearCart(arg0 context.Context, arg1 *pb.ClearCartRequest, arg2 ...grpc.CallOption) (*pb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ClearCart", varargs...)
+	ret0, _ := ret[0].(*pb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClearCart indicates an expected call of ClearCart.
+func (mr *MockGalaxyClientMockRecorder) ClearCart(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearCart", reflect.TypeOf((*MockGalaxyClient)(nil).ClearCart), varargs...)
+}
+
+// ConsumeToken mocks base method.
+func (m *MockGalaxyClient) ConsumeToken(arg0 context.Context, arg1 *pb.ConsumeTokenRequest, arg2 ...grpc.CallOption) (*pb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConsumeToken", varargs...)
+	ret0, _ := ret[0].(*pb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeToken indicates an expected call of ConsumeToken.
+func (mr *MockGalaxyClientMockRecorder) ConsumeToken(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeToken", reflect.TypeOf((*MockGalaxyClient)(nil).ConsumeToken), varargs...)
+}
+
+// CreateBillingAttempt mocks base method.
+func (m *MockGalaxyClient) CreateBillingAttempt(arg0 context.Context, arg1 *pb.CreateBillingAttemptRequest, arg2 ...grpc.CallOption) (*pb.CreateBillingAttemptResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateBillingAttempt", varargs...)
+	ret0, _ := ret[0].(*pb.CreateBillingAttemptResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBillingAttempt indicates an expected call of CreateBillingAttempt.
+func (mr *MockGalaxyClientMockRecorder) CreateBillingAttempt(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBillingAttempt", reflect.TypeOf((*MockGalaxyClient)(nil).CreateBillingAttempt), varargs...)
+}
+
+// CreateCoupon mocks base method.
+func (m *MockGalaxyClient) CreateCoupon(arg0 context.Context, arg1 *pb.CreateCouponRequest, arg2 ...grpc.CallOption) (*pb.CouponResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateCoupon", varargs...)
+	ret0, _ := ret[0].(*pb.CouponResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCoupon indicates an expected call of CreateCoupon.
+func (mr *MockGalaxyClientMockRecorder) CreateCoupon(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCoupon", reflect.TypeOf((*MockGalaxyClient)(nil).CreateCoupon), varargs...)
+}
+
+// CreateEntry mocks base method.
+func (m *MockGalaxyClient) CreateEntry(arg0 context.Context, arg1 *pb.CreateEntryRequest, arg2 ...grpc.CallOption) (*pb.CreateEntryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateEntry", varargs...)
+	ret0, _ := ret[0].(*pb.CreateEntryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEntry indicates an expected call of CreateEntry.
+func (mr *MockGalaxyClientMockRecorder) CreateEntry(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntry", reflect.TypeOf((*MockGalaxyClient)(nil).CreateEntry), varargs...)
+}
+
+// CreateGiftCard mocks base method.
+func (m *MockGalaxyClient) CreateGiftCard(arg0 context.Context, arg1 *pb.CreateGiftCardRequest, arg2 ...grpc.CallOption) (*pb.GiftCardResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateGiftCard", varargs...)
+	ret0, _ := ret[0].(*pb.GiftCardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGiftCard indicates an expected call of CreateGiftCard.
+func (mr *MockGalaxyClientMockRecorder) CreateGiftCard(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGiftCard", reflect.TypeOf((*MockGalaxyClient)(nil).CreateGiftCard), varargs...)
+}
+
+// CreateItem mocks base method.
+func (m *MockGalaxyClient) CreateItem(arg0 context.Context, arg1 *pb.CreateItemRequest, arg2 ...grpc.CallOption) (*pb.CreateItemResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateItem", varargs...)
+	ret0, _ := ret[0].(*pb.CreateItemResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateItem indicates an expected call of CreateItem.
+func (mr *MockGalaxyClientMockRecorder) CreateItem(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateItem", reflect.TypeOf((*MockGalaxyClient)(nil).CreateItem), varargs...)
+}
+
+// CreateOrder mocks base method.
+func (m *MockGalaxyClient) CreateOrder(arg0 context.Context, arg1 *pb.CreateOrderRequest, arg2 ...grpc.CallOption) (*pb.OrderResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateOrder", varargs...)
+	ret0, _ := ret[0].(*pb.OrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrder indicates an expected call of CreateOrder.
+func (mr *MockGalaxyClientMockRecorder) CreateOrder(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockGalaxyClient)(nil).CreateOrder), varargs...)
+}
+
+// CreatePlanChange mocks base method.
+func (m *MockGalaxyClient) CreatePlanChange(arg0 context.Context, arg1 *pb.CreatePlanChangeRequest, arg2 ...grpc.CallOption) (*pb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreatePlanChange", varargs...)
+	ret0, _ := ret[0].(*pb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePlanChange indicates an expected call of CreatePlanChange.
+func (mr *MockGalaxyClientMockRecorder) CreatePlanChange(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlanChange", reflect.TypeOf((*MockGalaxyClient)(nil).CreatePlanChange), varargs...)
+}
+
+// CreatePointsTransaction mocks base method.
+func (m *MockGalaxyClient) CreatePointsTransaction(arg0 context.Context, arg1 *pb.CreatePointsTransactionRequest, arg2 ...grpc.CallOption) (*pb.PointsTransactionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreatePointsTransaction", varargs...)
+	ret0, _ := ret[0].(*pb.PointsTransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePointsTransaction indicates an expected call of CreatePointsTransaction.
+func (mr *MockGalaxyClientMockRecorder) CreatePointsTransaction(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePointsTransaction", reflect.TypeOf((*MockGalaxyClient)(nil).CreatePointsTransaction), varargs...)
+}
+
+// CreateSaga mocks base method.
+func (m *MockGalaxyClient) CreateSaga(arg0 context.Context, arg1 *pb.CreateSagaRequest, arg2 ...grpc.CallOption) (*pb.SagaResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateSaga", varargs...)
+	ret0, _ := ret[0].(*pb.SagaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSaga indicates an expected call of CreateSaga.
+func (mr *MockGalaxyClientMockRecorder) CreateSaga(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSaga", reflect.TypeOf((*MockGalaxyClient)(nil).CreateSaga), varargs...)
+}
+
+// CreateSecurityEvent mocks base method.
+func (m *MockGalaxyClient) CreateSecurityEvent(arg0 context.Context, arg1 *pb.CreateSecurityEventRequest, arg2 ...grpc.CallOption) (*pb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateSecurityEvent", varargs...)
+	ret0, _ := ret[0].(*pb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSecurityEvent indicates an expected call of CreateSecurityEvent.
+func (mr *MockGalaxyClientMockRecorder) CreateSecurityEvent(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecurityEvent", reflect.TypeOf((*MockGalaxyClient)(nil).CreateSecurityEvent), varargs...)
+}
+
+// CreateSession mocks base method.
+func (m *MockGalaxyClient) CreateSession(arg0 context.Context, arg1 *pb.CreateSessionRequest, arg2 ...grpc.CallOption) (*pb.CreateSessionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateSession", varargs...)
+	ret0, _ := ret[0].(*pb.CreateSessionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockGalaxyClientMockRecorder) CreateSession(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockGalaxyClient)(nil).CreateSession), varargs...)
+}
+
+// CreateTeam mocks base method.
+func (m *MockGalaxyClient) CreateTeam(arg0 context.Context, arg1 *pb.CreateTeamRequest, arg2 ...grpc.CallOption) (*pb.TeamResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateTeam", varargs...)
+	ret0, _ := ret[0].(*pb.TeamResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTeam indicates an expected call of CreateTeam.
+func (mr *MockGalaxyClientMockRecorder) CreateTeam(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTeam", reflect.TypeOf((*MockGalaxyClient)(nil).CreateTeam), varargs...)
+}
+
+// CreateTeamInvitation mocks base method.
+func (m *MockGalaxyClient) CreateTeamInvitation(arg0 context.Context, arg1 *pb.CreateTeamInvitationRequest, arg2 ...grpc.CallOption) (*pb.TeamInvitationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateTeamInvitation", varargs...)
+	ret0, _ := ret[0].(*pb.TeamInvitationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTeamInvitation indicates an expected call of CreateTeamInvitation.
+func (mr *MockGalaxyClientMockRecorder) CreateTeamInvitation(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTeamInvitation", reflect.TypeOf((*MockGalaxyClient)(nil).CreateTeamInvitation), varargs...)
+}
+
+// CreateUser mocks base method.
+func (m *MockGalaxyClient) CreateUser(arg0 context.Context, arg1 *pb.CreateUserRequest, arg2 ...grpc.CallOption) (*pb.CreateUserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateUser", varargs...)
+	ret0, _ := ret[0].(*pb.CreateUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockGalaxyClientMockRecorder) CreateUser(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockGalaxyClient)(nil).CreateUser), varargs...)
+}
+
+// CreateWalletTransaction mocks base method.
+func (m *MockGalaxyClient) CreateWalletTransaction(arg0 context.Context, arg1 *pb.CreateWalletTransactionRequest, arg2 ...grpc.CallOption) (*pb.WalletTransactionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateWalletTransaction", varargs...)
+	ret0, _ := ret[0].(*pb.WalletTransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWalletTransaction indicates an expected call of CreateWalletTransaction.
+func (mr *MockGalaxyClientMockRecorder) CreateWalletTransaction(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWalletTransaction", reflect.TypeOf((*MockGalaxyClient)(nil).CreateWalletTransaction), varargs...)
+}
+
+// DeleteCouponRedemption mocks base method.
+func (m *MockGalaxyClient) DeleteCouponRedemption(arg0 context.Context, arg1 *pb.DeleteCouponRedemptionRequest, arg2 ...grpc.CallOption) (*pb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteCouponRedemption", varargs...)
+	ret0, _ := ret[0].(*pb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCouponRedemption indicates an expected call of DeleteCouponRedemption.
+func (mr *MockGalaxyClientMockRecorder) DeleteCouponRedemption(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCouponRedemption", reflect.TypeOf((*MockGalaxyClient)(nil).DeleteCouponRedemption), varargs...)
+}
+
+// DeleteEntry mocks base method.
+func (m *MockGalaxyClient) DeleteEntry(arg0 context.Context, arg1 *pb.DeleteEntryRequest, arg2 ...grpc.CallOption) (*pb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteEntry", varargs...)
+	ret0, _ := ret[0].(*pb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEntry indicates an expected call of DeleteEntry.
+func (mr *MockGalaxyClientMockRecorder) DeleteEntry(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEntry", reflect.TypeOf((*MockGalaxyClient)(nil).DeleteEntry), varargs...)
+}
+
+// DeleteGiftCard mocks base method.
+func (m *MockGalaxyClient) DeleteGiftCard(arg0 context.Context, arg1 *pb.DeleteGiftCardRequest, arg2 ...grpc.CallOption) (*pb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteGiftCard", varargs...)
+	ret0, _ := ret[0].(*pb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteGiftCard indicates an expected call of DeleteGiftCard.
+func (mr *MockGalaxyClientMockRecorder) DeleteGiftCard(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGiftCard", reflect.TypeOf((*MockGalaxyClient)(nil).DeleteGiftCard), varargs...)
+}
+
+// DeleteItem mocks base method.
+func (m *MockGalaxyClient) DeleteItem(arg0 context.Context, arg1 *pb.DeleteItemRequest, arg2 ...grpc.CallOption) (*pb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteItem", varargs...)
+	ret0, _ := ret[0].(*pb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteItem indicates an expected call of DeleteItem.
+func (mr *MockGalaxyClientMockRecorder) DeleteItem(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItem", reflect.TypeOf((*MockGalaxyClient)(nil).DeleteItem), varargs...)
+}
+
+// DeleteOrder mocks base method.
+func (m *MockGalaxyClient) DeleteOrder(arg0 context.Context, arg1 *pb.DeleteOrderRequest, arg2 ...grpc.CallOption) (*pb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteOrder", varargs...)
+	ret0, _ := ret[0].(*pb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrder indicates an expected call of DeleteOrder.
+func (mr *MockGalaxyClientMockRecorder) DeleteOrder(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrder", reflect.TypeOf((*MockGalaxyClient)(nil).DeleteOrder), varargs...)
+}
+
+// DeleteUser mocks base method.
+func (m *MockGalaxyClient) DeleteUser(arg0 context.Context, arg1 *pb.DeleteUserRequest, arg2 ...grpc.CallOption) (*pb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteUser", varargs...)
+	ret0, _ := ret[0].(*pb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockGalaxyClientMockRecorder) DeleteUser(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockGalaxyClient)(nil).DeleteUser), varargs...)
+}
+
+// ExpirePoints mocks base method.
+func (m *MockGalaxyClient) ExpirePoints(arg0 context.Context, arg1 *pb.ExpirePointsRequest, arg2 ...grpc.CallOption) (*pb.ExpirePointsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExpirePoints", varargs...)
+	ret0, _ := ret[0].(*pb.ExpirePointsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpirePoints indicates an expected call of ExpirePoints.
+func (mr *MockGalaxyClientMockRecorder) ExpirePoints(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpirePoints", reflect.TypeOf((*MockGalaxyClient)(nil).ExpirePoints), varargs...)
+}
+
+// GetCart mocks base method.
+func (m *MockGalaxyClient) GetCart(arg0 context.Context, arg1 *pb.GetCartRequest, arg2 ...grpc.CallOption) (*pb.CartResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCart", varargs...)
+	ret0, _ := ret[0].(*pb.CartResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCart indicates an expected call of GetCart.
+func (mr *MockGalaxyClientMockRecorder) GetCart(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCart", reflect.TypeOf((*MockGalaxyClient)(nil).GetCart), varargs...)
+}
+
+// GetCoupon mocks base method.
+func (m *MockGalaxyClient) GetCoupon(arg0 context.Context, arg1 *pb.GetCouponRequest, arg2 ...grpc.CallOption) (*pb.CouponResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCoupon", varargs...)
+	ret0, _ := ret[0].(*pb.CouponResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCoupon indicates an expected call of GetCoupon.
+func (mr *MockGalaxyClientMockRecorder) GetCoupon(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoupon", reflect.TypeOf((*MockGalaxyClient)(nil).GetCoupon), varargs...)
+}
+
+// GetCouponByCode mocks base method.
+func (m *MockGalaxyClient) GetCouponByCode(arg0 context.Context, arg1 *pb.GetCouponByCodeRequest, arg2 ...grpc.CallOption) (*pb.CouponResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCouponByCode", varargs...)
+	ret0, _ := ret[0].(*pb.CouponResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCouponByCode indicates an expected call of GetCouponByCode.
+func (mr *MockGalaxyClientMockRecorder) GetCouponByCode(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCouponByCode", reflect.TypeOf((*MockGalaxyClient)(nil).GetCouponByCode), varargs...)
+}
+
+// GetEntry mocks base method.
+func (m *MockGalaxyClient) GetEntry(arg0 context.Context, arg1 *pb.GetEntryRequest, arg2 ...grpc.CallOption) (*pb.GetEntryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetEntry", varargs...)
+	ret0, _ := ret[0].(*pb.GetEntryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntry indicates an expected call of GetEntry.
+func (mr *MockGalaxyClientMockRecorder) GetEntry(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntry", reflect.TypeOf((*MockGalaxyClient)(nil).GetEntry), varargs...)
+}
+
+// GetGiftCardByCode mocks base method.
+func (m *MockGalaxyClient) GetGiftCardByCode(arg0 context.Context, arg1 *pb.GetGiftCardByCodeRequest, arg2 ...grpc.CallOption) (*pb.GiftCardResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetGiftCardByCode", varargs...)
+	ret0, _ := ret[0].(*pb.GiftCardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGiftCardByCode indicates an expected call of GetGiftCardByCode.
+func (mr *MockGalaxyClientMockRecorder) GetGiftCardByCode(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGiftCardByCode", reflect.TypeOf((*MockGalaxyClient)(nil).GetGiftCardByCode), varargs...)
+}
+
+// GetGiftCardByEntry mocks base method.
+func (m *MockGalaxyClient) GetGiftCardByEntry(arg0 context.Context, arg1 *pb.GetGiftCardByEntryRequest, arg2 ...grpc.CallOption) (*pb.GiftCardResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetGiftCardByEntry", varargs...)
+	ret0, _ := ret[0].(*pb.GiftCardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGiftCardByEntry indicates an expected call of GetGiftCardByEntry.
+func (mr *MockGalaxyClientMockRecorder) GetGiftCardByEntry(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGiftCardByEntry", reflect.TypeOf((*MockGalaxyClient)(nil).GetGiftCardByEntry), varargs...)
+}
+
+// GetItem mocks base method.
+func (m *MockGalaxyClient) GetItem(arg0 context.Context, arg1 *pb.GetItemRequest, arg2 ...grpc.CallOption) (*pb.GetItemResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetItem", varargs...)
+	ret0, _ := ret[0].(*pb.GetItemResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItem indicates an expected call of GetItem.
+func (mr *MockGalaxyClientMockRecorder) GetItem(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItem", reflect.TypeOf((*MockGalaxyClient)(nil).GetItem), varargs...)
+}
+
+// GetOrder mocks base method.
+func (m *MockGalaxyClient) GetOrder(arg0 context.Context, arg1 *pb.GetOrderRequest, arg2 ...grpc.CallOption) (*pb.OrderResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOrder", varargs...)
+	ret0, _ := ret[0].(*pb.OrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrder indicates an expected call of GetOrder.
+func (mr *MockGalaxyClientMockRecorder) GetOrder(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrder", reflect.TypeOf((*MockGalaxyClient)(nil).GetOrder), varargs...)
+}
+
+// GetPlan mocks base method.
+func (m *MockGalaxyClient) GetPlan(arg0 context.Context, arg1 *pb.GetPlanRequest, arg2 ...grpc.CallOption) (*pb.GetPlanResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPlan", varargs...)
+	ret0, _ := ret[0].(*pb.GetPlanResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlan indicates an expected call of GetPlan.
+func (mr *MockGalaxyClientMockRecorder) GetPlan(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlan", reflect.TypeOf((*MockGalaxyClient)(nil).GetPlan), varargs...)
+}
+
+// GetPointsBalance mocks base method.
+func (m *MockGalaxyClient) GetPointsBalance(arg0 context.Context, arg1 *pb.GetPointsBalanceRequest, arg2 ...grpc.CallOption) (*pb.PointsBalanceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPointsBalance", varargs...)
+	ret0, _ := ret[0].(*pb.PointsBalanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPointsBalance indicates an expected call of GetPointsBalance.
+func (mr *MockGalaxyClientMockRecorder) GetPointsBalance(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPointsBalance", reflect.TypeOf((*MockGalaxyClient)(nil).GetPointsBalance), varargs...)
+}
+
+// GetTeam mocks base method.
+func (m *MockGalaxyClient) GetTeam(arg0 context.Context, arg1 *pb.GetTeamRequest, arg2 ...grpc.CallOption) (*pb.TeamResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTeam", varargs...)
+	ret0, _ := ret[0].(*pb.TeamResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeam indicates an expected call of GetTeam.
+func (mr *MockGalaxyClientMockRecorder) GetTeam(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeam", reflect.TypeOf((*MockGalaxyClient)(nil).GetTeam), varargs...)
+}
+
+// GetTeamInvitation mocks base method.
+func (m *MockGalaxyClient) GetTeamInvitation(arg0 context.Context, arg1 *pb.GetTeamInvitationRequest, arg2 ...grpc.CallOption) (*pb.TeamInvitationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTeamInvitation", varargs...)
+	ret0, _ := ret[0].(*pb.TeamInvitationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamInvitation indicates an expected call of GetTeamInvitation.
+func (mr *MockGalaxyClientMockRecorder) GetTeamInvitation(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamInvitation", reflect.TypeOf((*MockGalaxyClient)(nil).GetTeamInvitation), varargs...)
+}
+
+// GetTrial mocks base method.
+func (m *MockGalaxyClient) GetTrial(arg0 context.Context, arg1 *pb.GetTrialRequest, arg2 ...grpc.CallOption) (*pb.GetTrialResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTrial", varargs...)
+	ret0, _ := ret[0].(*pb.GetTrialResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrial indicates an expected call of GetTrial.
+func (mr *MockGalaxyClientMockRecorder) GetTrial(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrial", reflect.TypeOf((*MockGalaxyClient)(nil).GetTrial), varargs...)
+}
+
+// GetUsage mocks base method.
+func (m *MockGalaxyClient) GetUsage(arg0 context.Context, arg1 *pb.GetUsageRequest, arg2 ...grpc.CallOption) (*pb.GetUsageResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUsage", varargs...)
+	ret0, _ := ret[0].(*pb.GetUsageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsage indicates an expected call of GetUsage.
+func (mr *MockGalaxyClientMockRecorder) GetUsage(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsage", reflect.TypeOf((*MockGalaxyClient)(nil).GetUsage), varargs...)
+}
+
+// GetUser mocks base method.
+func (m *MockGalaxyClient) GetUser(arg0 context.Context, arg1 *pb.GetUserRequest, arg2 ...grpc.CallOption) (*pb.GetUserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUser", varargs...)
+	ret0, _ := ret[0].(*pb.GetUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockGalaxyClientMockRecorder) GetUser(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockGalaxyClient)(nil).GetUser), varargs...)
+}
+
+// GetUserByUsername mocks base method.
+func (m *MockGalaxyClient) GetUserByUsername(arg0 context.Context, arg1 *pb.GetUserByUsernameRequest, arg2 ...grpc.CallOption) (*pb.GetUserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUserByUsername", varargs...)
+	ret0, _ := ret[0].(*pb.GetUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByUsername indicates an expected call of GetUserByUsername.
+func (mr *MockGalaxyClientMockRecorder) GetUserByUsername(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByUsername", reflect.TypeOf((*MockGalaxyClient)(nil).GetUserByUsername), varargs...)
+}
+
+// GetWallet mocks base method.
+func (m *MockGalaxyClient) GetWallet(arg0 context.Context, arg1 *pb.GetWalletRequest, arg2 ...grpc.CallOption) (*pb.WalletResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWallet", varargs...)
+	ret0, _ := ret[0].(*pb.WalletResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWallet indicates an expected call of GetWallet.
+func (mr *MockGalaxyClientMockRecorder) GetWallet(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWallet", reflect.TypeOf((*MockGalaxyClient)(nil).GetWallet), varargs...)
+}
+
+// IncrementUsage mocks base method.
+func (m *MockGalaxyClient) IncrementUsage(arg0 context.Context, arg1 *pb.IncrementUsageRequest, arg2 ...grpc.CallOption) (*pb.IncrementUsageResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "IncrementUsage", varargs...)
+	ret0, _ := ret[0].(*pb.IncrementUsageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementUsage indicates an expected call of IncrementUsage.
+func (mr *MockGalaxyClientMockRecorder) IncrementUsage(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementUsage", reflect.TypeOf((*MockGalaxyClient)(nil).IncrementUsage), varargs...)
+}
+
+// ListBillingAttempts mocks base method.
+func (m *MockGalaxyClient) ListBillingAttempts(arg0 context.Context, arg1 *pb.ListBillingAttemptsRequest, arg2 ...grpc.CallOption) (*pb.ListBillingAttemptsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBillingAttempts", varargs...)
+	ret0, _ := ret[0].(*pb.ListBillingAttemptsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBillingAttempts indicates an expected call of ListBillingAttempts.
+func (mr *MockGalaxyClientMockRecorder) ListBillingAttempts(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBillingAttempts", reflect.TypeOf((*MockGalaxyClient)(nil).ListBillingAttempts), varargs...)
+}
+
+// ListCouponRedemptions mocks base method.
+func (m *MockGalaxyClient) ListCouponRedemptions(arg0 context.Context, arg1 *pb.ListCouponRedemptionsRequest, arg2 ...grpc.CallOption) (*pb.ListCouponRedemptionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCouponRedemptions", varargs...)
+	ret0, _ := ret[0].(*pb.ListCouponRedemptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCouponRedemptions indicates an expected call of ListCouponRedemptions.
+func (mr *MockGalaxyClientMockRecorder) ListCouponRedemptions(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCouponRedemptions", reflect.TypeOf((*MockGalaxyClient)(nil).ListCouponRedemptions), varargs...)
+}
+
+// ListCoupons mocks base method.
+func (m *MockGalaxyClient) ListCoupons(arg0 context.Context, arg1 *pb.ListCouponsRequest, arg2 ...grpc.CallOption) (*pb.ListCouponsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCoupons", varargs...)
+	ret0, _ := ret[0].(*pb.ListCouponsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCoupons indicates an expected call of ListCoupons.
+func (mr *MockGalaxyClientMockRecorder) ListCoupons(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCoupons", reflect.TypeOf((*MockGalaxyClient)(nil).ListCoupons), varargs...)
+}
+
+// ListEntries mocks base method.
+func (m *MockGalaxyClient) ListEntries(arg0 context.Context, arg1 *pb.ListEntriesRequest, arg2 ...grpc.CallOption) (*pb.ListEntriesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEntries", varargs...)
+	ret0, _ := ret[0].(*pb.ListEntriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntries indicates an expected call of ListEntries.
+func (mr *MockGalaxyClientMockRecorder) ListEntries(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockGalaxyClient)(nil).ListEntries), varargs...)
+}
+
+// ListEntriesByItem mocks base method.
+func (m *MockGalaxyClient) ListEntriesByItem(arg0 context.Context, arg1 *pb.ListEntriesByItemRequest, arg2 ...grpc.CallOption) (*pb.ListEntriesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEntriesByItem", varargs...)
+	ret0, _ := ret[0].(*pb.ListEntriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntriesByItem indicates an expected call of ListEntriesByItem.
+func (mr *MockGalaxyClientMockRecorder) ListEntriesByItem(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntriesByItem", reflect.TypeOf((*MockGalaxyClient)(nil).ListEntriesByItem), varargs...)
+}
+
+// ListEntriesByUser mocks base method.
+func (m *MockGalaxyClient) ListEntriesByUser(arg0 context.Context, arg1 *pb.ListEntriesByUserRequest, arg2 ...grpc.CallOption) (*pb.ListEntriesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEntriesByUser", varargs...)
+	ret0, _ := ret[0].(*pb.ListEntriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntriesByUser indicates an expected call of ListEntriesByUser.
+func (mr *MockGalaxyClientMockRecorder) ListEntriesByUser(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntriesByUser", reflect.TypeOf((*MockGalaxyClient)(nil).ListEntriesByUser), varargs...)
+}
+
+// ListGiftCardRedemptions mocks base method.
+func (m *MockGalaxyClient) ListGiftCardRedemptions(arg0 context.Context, arg1 *pb.ListGiftCardRedemptionsRequest, arg2 ...grpc.CallOption) (*pb.ListGiftCardRedemptionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGiftCardRedemptions", varargs...)
+	ret0, _ := ret[0].(*pb.ListGiftCardRedemptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGiftCardRedemptions indicates an expected call of ListGiftCardRedemptions.
+func (mr *MockGalaxyClientMockRecorder) ListGiftCardRedemptions(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGiftCardRedemptions", reflect.TypeOf((*MockGalaxyClient)(nil).ListGiftCardRedemptions), varargs...)
+}
+
+// ListItems mocks base method.
+func (m *MockGalaxyClient) ListItems(arg0 context.Context, arg1 *pb.ListItemsRequest, arg2 ...grpc.CallOption) (*pb.ListItemsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListItems", varargs...)
+	ret0, _ := ret[0].(*pb.ListItemsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListItems indicates an expected call of ListItems.
+func (mr *MockGalaxyClientMockRecorder) ListItems(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItems", reflect.TypeOf((*MockGalaxyClient)(nil).ListItems), varargs...)
+}
+
+// ListOrders mocks base method.
+func (m *MockGalaxyClient) ListOrders(arg0 context.Context, arg1 *pb.ListOrdersRequest, arg2 ...grpc.CallOption) (*pb.ListOrdersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOrders", varargs...)
+	ret0, _ := ret[0].(*pb.ListOrdersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrders indicates an expected call of ListOrders.
+func (mr *MockGalaxyClientMockRecorder) ListOrders(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrders", reflect.TypeOf((*MockGalaxyClient)(nil).ListOrders), varargs...)
+}
+
+// ListPlanChanges mocks base method.
+func (m *MockGalaxyClient) ListPlanChanges(arg0 context.Context, arg1 *pb.ListPlanChangesRequest, arg2 ...grpc.CallOption) (*pb.ListPlanChangesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPlanChanges", varargs...)
+	ret0, _ := ret[0].(*pb.ListPlanChangesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPlanChanges indicates an expected call of ListPlanChanges.
+func (mr *MockGalaxyClientMockRecorder) ListPlanChanges(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlanChanges", reflect.TypeOf((*MockGalaxyClient)(nil).ListPlanChanges), varargs...)
+}
+
+// ListPlans mocks base method.
+func (m *MockGalaxyClient) ListPlans(arg0 context.Context, arg1 *pb.ListPlansRequest, arg2 ...grpc.CallOption) (*pb.ListPlansResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPlans", varargs...)
+	ret0, _ := ret[0].(*pb.ListPlansResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Authorize indicates an expected call of Authorize.
-func (mr *MockGalaxyClientMockRecorder) Authorize(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// ListPlans indicates an expected call of ListPlans.
+func (mr *MockGalaxyClientMockRecorder) ListPlans(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockGalaxyClient)(nil).Authorize), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlans", reflect.TypeOf((*MockGalaxyClient)(nil).ListPlans), varargs...)
 }
 
-// CreateEntry mocks base method.
-func (m *MockGalaxyClient) CreateEntry(arg0 context.Context, arg1 *pb.CreateEntryRequest, arg2 ...grpc.CallOption) (*pb.CreateEntryResponse, error) {
+// ListPointsTransactions mocks base method.
+func (m *MockGalaxyClient) ListPointsTransactions(arg0 context.Context, arg1 *pb.ListPointsTransactionsRequest, arg2 ...grpc.CallOption) (*pb.ListPointsTransactionsResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "CreateEntry", varargs...)
-	ret0, _ := ret[0].(*pb.CreateEntryResponse)
+	ret := m.ctrl.Call(m, "ListPointsTransactions", varargs...)
+	ret0, _ := ret[0].(*pb.ListPointsTransactionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateEntry indicates an expected call of CreateEntry.
-func (mr *MockGalaxyClientMockRecorder) CreateEntry(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// ListPointsTransactions indicates an expected call of ListPointsTransactions.
+func (mr *MockGalaxyClientMockRecorder) ListPointsTransactions(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntry", reflect.TypeOf((*MockGalaxyClient)(nil).CreateEntry), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPointsTransactions", reflect.TypeOf((*MockGalaxyClient)(nil).ListPointsTransactions), varargs...)
 }
 
-// CreateItem mocks base method.
-func (m *MockGalaxyClient) CreateItem(arg0 context.Context, arg1 *pb.CreateItemRequest, arg2 ...grpc.CallOption) (*pb.CreateItemResponse, error) {
+// ListSagas mocks base method.
+func (m *MockGalaxyClient) ListSagas(arg0 context.Context, arg1 *pb.ListSagasRequest, arg2 ...grpc.CallOption) (*pb.ListSagasResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "CreateItem", varargs...)
-	ret0, _ := ret[0].(*pb.CreateItemResponse)
+	ret := m.ctrl.Call(m, "ListSagas", varargs...)
+	ret0, _ := ret[0].(*pb.ListSagasResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateItem indicates an expected call of CreateItem.
-func (mr *MockGalaxyClientMockRecorder) CreateItem(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// ListSagas indicates an expected call of ListSagas.
+func (mr *MockGalaxyClientMockRecorder) ListSagas(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateItem", reflect.TypeOf((*MockGalaxyClient)(nil).CreateItem), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSagas", reflect.TypeOf((*MockGalaxyClient)(nil).ListSagas), varargs...)
 }
 
-// CreateSession mocks base method.
-func (m *MockGalaxyClient) CreateSession(arg0 context.Context, arg1 *pb.CreateSessionRequest, arg2 ...grpc.CallOption) (*pb.CreateSessionResponse, error) {
+// ListSecurityEvents mocks base method.
+func (m *MockGalaxyClient) ListSecurityEvents(arg0 context.Context, arg1 *pb.ListSecurityEventsRequest, arg2 ...grpc.CallOption) (*pb.ListSecurityEventsResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "CreateSession", varargs...)
-	ret0, _ := ret[0].(*pb.CreateSessionResponse)
+	ret := m.ctrl.Call(m, "ListSecurityEvents", varargs...)
+	ret0, _ := ret[0].(*pb.ListSecurityEventsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateSession indicates an expected call of CreateSession.
-func (mr *MockGalaxyClientMockRecorder) CreateSession(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// ListSecurityEvents indicates an expected call of ListSecurityEvents.
+func (mr *MockGalaxyClientMockRecorder) ListSecurityEvents(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockGalaxyClient)(nil).CreateSession), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecurityEvents", reflect.TypeOf((*MockGalaxyClient)(nil).ListSecurityEvents), varargs...)
 }
 
-// CreateUser mocks base method.
-func (m *MockGalaxyClient) CreateUser(arg0 context.Context, arg1 *pb.CreateUserRequest, arg2 ...grpc.CallOption) (*pb.CreateUserResponse, error) {
+// ListSessions mocks base method.
+func (m *MockGalaxyClient) ListSessions(arg0 context.Context, arg1 *pb.ListSessionsRequest, arg2 ...grpc.CallOption) (*pb.ListSessionsResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "CreateUser", varargs...)
-	ret0, _ := ret[0].(*pb.CreateUserResponse)
+	ret := m.ctrl.Call(m, "ListSessions", varargs...)
+	ret0, _ := ret[0].(*pb.ListSessionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUser indicates an expected call of CreateUser.
-func (mr *MockGalaxyClientMockRecorder) CreateUser(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockGalaxyClientMockRecorder) ListSessions(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockGalaxyClient)(nil).CreateUser), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockGalaxyClient)(nil).ListSessions), varargs...)
 }
 
-// DeleteEntry mocks base method.
-func (m *MockGalaxyClient) DeleteEntry(arg0 context.Context, arg1 *pb.DeleteEntryRequest, arg2 ...grpc.CallOption) (*pb.Empty, error) {
+// ListTeamMembers mocks base method.
+func (m *MockGalaxyClient) ListTeamMembers(arg0 context.Context, arg1 *pb.ListTeamMembersRequest, arg2 ...grpc.CallOption) (*pb.ListTeamMembersResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DeleteEntry", varargs...)
-	ret0, _ := ret[0].(*pb.Empty)
+	ret := m.ctrl.Call(m, "ListTeamMembers", varargs...)
+	ret0, _ := ret[0].(*pb.ListTeamMembersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteEntry indicates an expected call of DeleteEntry.
-func (mr *MockGalaxyClientMockRecorder) DeleteEntry(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// ListTeamMembers indicates an expected call of ListTeamMembers.
+func (mr *MockGalaxyClientMockRecorder) ListTeamMembers(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEntry", reflect.TypeOf((*MockGalaxyClient)(nil).DeleteEntry), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTeamMembers", reflect.TypeOf((*MockGalaxyClient)(nil).ListTeamMembers), varargs...)
 }
 
-// DeleteItem mocks base method.
-func (m *MockGalaxyClient) DeleteItem(arg0 context.Context, arg1 *pb.DeleteItemRequest, arg2 ...grpc.CallOption) (*pb.Empty, error) {
+// ListTeams mocks base method.
+func (m *MockGalaxyClient) ListTeams(arg0 context.Context, arg1 *pb.ListTeamsRequest, arg2 ...grpc.CallOption) (*pb.ListTeamsResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DeleteItem", varargs...)
-	ret0, _ := ret[0].(*pb.Empty)
+	ret := m.ctrl.Call(m, "ListTeams", varargs...)
+	ret0, _ := ret[0].(*pb.ListTeamsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteItem indicates an expected call of DeleteItem.
-func (mr *MockGalaxyClientMockRecorder) DeleteItem(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// ListTeams indicates an expected call of ListTeams.
+func (mr *MockGalaxyClientMockRecorder) ListTeams(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItem", reflect.TypeOf((*MockGalaxyClient)(nil).DeleteItem), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTeams", reflect.TypeOf((*MockGalaxyClient)(nil).ListTeams), varargs...)
 }
 
-// GetEntry mocks base method.
-func (m *MockGalaxyClient) GetEntry(arg0 context.Context, arg1 *pb.GetEntryRequest, arg2 ...grpc.CallOption) (*pb.GetEntryResponse, error) {
+// ListUsers mocks base method.
+func (m *MockGalaxyClient) ListUsers(arg0 context.Context, arg1 *pb.ListUsersRequest, arg2 ...grpc.CallOption) (*pb.ListUsersResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetEntry", varargs...)
-	ret0, _ := ret[0].(*pb.GetEntryResponse)
+	ret := m.ctrl.Call(m, "ListUsers", varargs...)
+	ret0, _ := ret[0].(*pb.ListUsersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetEntry indicates an expected call of GetEntry.
-func (mr *MockGalaxyClientMockRecorder) GetEntry(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockGalaxyClientMockRecorder) ListUsers(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntry", reflect.TypeOf((*MockGalaxyClient)(nil).GetEntry), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockGalaxyClient)(nil).ListUsers), varargs...)
 }
 
-// GetItem mocks base method.
-func (m *MockGalaxyClient) GetItem(arg0 context.Context, arg1 *pb.GetItemRequest, arg2 ...grpc.CallOption) (*pb.GetItemResponse, error) {
+// ListWalletTransactions mocks base method.
+func (m *MockGalaxyClient) ListWalletTransactions(arg0 context.Context, arg1 *pb.ListWalletTransactionsRequest, arg2 ...grpc.CallOption) (*pb.ListWalletTransactionsResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetItem", varargs...)
-	ret0, _ := ret[0].(*pb.GetItemResponse)
+	ret := m.ctrl.Call(m, "ListWalletTransactions", varargs...)
+	ret0, _ := ret[0].(*pb.ListWalletTransactionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetItem indicates an expected call of GetItem.
-func (mr *MockGalaxyClientMockRecorder) GetItem(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// ListWalletTransactions indicates an expected call of ListWalletTransactions.
+func (mr *MockGalaxyClientMockRecorder) ListWalletTransactions(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItem", reflect.TypeOf((*MockGalaxyClient)(nil).GetItem), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWalletTransactions", reflect.TypeOf((*MockGalaxyClient)(nil).ListWalletTransactions), varargs...)
 }
 
-// GetUser mocks base method.
-func (m *MockGalaxyClient) GetUser(arg0 context.Context, arg1 *pb.GetUserRequest, arg2 ...grpc.CallOption) (*pb.GetUserResponse, error) {
+// Login mocks base method.
+func (m *MockGalaxyClient) Login(arg0 context.Context, arg1 *pb.LoginRequest, arg2 ...grpc.CallOption) (*pb.LoginResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetUser", varargs...)
-	ret0, _ := ret[0].(*pb.GetUserResponse)
+	ret := m.ctrl.Call(m, "Login", varargs...)
+	ret0, _ := ret[0].(*pb.LoginResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUser indicates an expected call of GetUser.
-func (mr *MockGalaxyClientMockRecorder) GetUser(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// Login indicates an expected call of Login.
+func (mr *MockGalaxyClientMockRecorder) Login(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockGalaxyClient)(nil).GetUser), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockGalaxyClient)(nil).Login), varargs...)
 }
 
-// GetUserByUsername mocks base method.
-func (m *MockGalaxyClient) GetUserByUsername(arg0 context.Context, arg1 *pb.GetUserByUsernameRequest, arg2 ...grpc.CallOption) (*pb.GetUserResponse, error) {
+// RedeemCoupon mocks base method.
+func (m *MockGalaxyClient) RedeemCoupon(arg0 context.Context, arg1 *pb.RedeemCouponRequest, arg2 ...grpc.CallOption) (*pb.RedeemCouponResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetUserByUsername", varargs...)
-	ret0, _ := ret[0].(*pb.GetUserResponse)
+	ret := m.ctrl.Call(m, "RedeemCoupon", varargs...)
+	ret0, _ := ret[0].(*pb.RedeemCouponResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserByUsername indicates an expected call of GetUserByUsername.
-func (mr *MockGalaxyClientMockRecorder) GetUserByUsername(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// RedeemCoupon indicates an expected call of RedeemCoupon.
+func (mr *MockGalaxyClientMockRecorder) RedeemCoupon(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByUsername", reflect.TypeOf((*MockGalaxyClient)(nil).GetUserByUsername), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeemCoupon", reflect.TypeOf((*MockGalaxyClient)(nil).RedeemCoupon), varargs...)
 }
 
-// ListEntries mocks base method.
-func (m *MockGalaxyClient) ListEntries(arg0 context.Context, arg1 *pb.ListEntriesRequest, arg2 ...grpc.CallOption) (*pb.ListEntriesResponse, error) {
+// RedeemGiftCard mocks base method.
+func (m *MockGalaxyClient) RedeemGiftCard(arg0 context.Context, arg1 *pb.RedeemGiftCardRequest, arg2 ...grpc.CallOption) (*pb.RedeemGiftCardResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListEntries", varargs...)
-	ret0, _ := ret[0].(*pb.ListEntriesResponse)
+	ret := m.ctrl.Call(m, "RedeemGiftCard", varargs...)
+	ret0, _ := ret[0].(*pb.RedeemGiftCardResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListEntries indicates an expected call of ListEntries.
-func (mr *MockGalaxyClientMockRecorder) ListEntries(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// RedeemGiftCard indicates an expected call of RedeemGiftCard.
+func (mr *MockGalaxyClientMockRecorder) RedeemGiftCard(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockGalaxyClient)(nil).ListEntries), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeemGiftCard", reflect.TypeOf((*MockGalaxyClient)(nil).RedeemGiftCard), varargs...)
 }
 
-// ListEntriesByItem mocks base method.
-func (m *MockGalaxyClient) ListEntriesByItem(arg0 context.Context, arg1 *pb.ListEntriesByItemRequest, arg2 ...grpc.CallOption) (*pb.ListEntriesResponse, error) {
+// RemoveTeamMember mocks base method.
+func (m *MockGalaxyClient) RemoveTeamMember(arg0 context.Context, arg1 *pb.RemoveTeamMemberRequest, arg2 ...grpc.CallOption) (*pb.Empty, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListEntriesByItem", varargs...)
-	ret0, _ := ret[0].(*pb.ListEntriesResponse)
+	ret := m.ctrl.Call(m, "RemoveTeamMember", varargs...)
+	ret0, _ := ret[0].(*pb.Empty)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListEntriesByItem indicates an expected call of ListEntriesByItem.
-func (mr *MockGalaxyClientMockRecorder) ListEntriesByItem(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// RemoveTeamMember indicates an expected call of RemoveTeamMember.
+func (mr *MockGalaxyClientMockRecorder) RemoveTeamMember(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntriesByItem", reflect.TypeOf((*MockGalaxyClient)(nil).ListEntriesByItem), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTeamMember", reflect.TypeOf((*MockGalaxyClient)(nil).RemoveTeamMember), varargs...)
 }
 
-// ListEntriesByUser mocks base method.
-func (m *MockGalaxyClient) ListEntriesByUser(arg0 context.Context, arg1 *pb.ListEntriesByUserRequest, arg2 ...grpc.CallOption) (*pb.ListEntriesResponse, error) {
+// RenewAccessToken mocks base method.
+func (m *MockGalaxyClient) RenewAccessToken(arg0 context.Context, arg1 *pb.RenewAccessTokenRequest, arg2 ...grpc.CallOption) (*pb.RenewAccessTokenResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListEntriesByUser", varargs...)
-	ret0, _ := ret[0].(*pb.ListEntriesResponse)
+	ret := m.ctrl.Call(m, "RenewAccessToken", varargs...)
+	ret0, _ := ret[0].(*pb.RenewAccessTokenResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListEntriesByUser indicates an expected call of ListEntriesByUser.
-func (mr *MockGalaxyClientMockRecorder) ListEntriesByUser(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// RenewAccessToken indicates an expected call of RenewAccessToken.
+func (mr *MockGalaxyClientMockRecorder) RenewAccessToken(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntriesByUser", reflect.TypeOf((*MockGalaxyClient)(nil).ListEntriesByUser), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewAccessToken", reflect.TypeOf((*MockGalaxyClient)(nil).RenewAccessToken), varargs...)
 }
 
-// ListItems mocks base method.
-func (m *MockGalaxyClient) ListItems(arg0 context.Context, arg1 *pb.ListItemsRequest, arg2 ...grpc.CallOption) (*pb.ListItemsResponse, error) {
+// RenewTeam mocks base method.
+func (m *MockGalaxyClient) RenewTeam(arg0 context.Context, arg1 *pb.RenewTeamRequest, arg2 ...grpc.CallOption) (*pb.TeamResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListItems", varargs...)
-	ret0, _ := ret[0].(*pb.ListItemsResponse)
+	ret := m.ctrl.Call(m, "RenewTeam", varargs...)
+	ret0, _ := ret[0].(*pb.TeamResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListItems indicates an expected call of ListItems.
-func (mr *MockGalaxyClientMockRecorder) ListItems(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// RenewTeam indicates an expected call of RenewTeam.
+func (mr *MockGalaxyClientMockRecorder) RenewTeam(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItems", reflect.TypeOf((*MockGalaxyClient)(nil).ListItems), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewTeam", reflect.TypeOf((*MockGalaxyClient)(nil).RenewTeam), varargs...)
 }
 
-// Login mocks base method.
-func (m *MockGalaxyClient) Login(arg0 context.Context, arg1 *pb.LoginRequest, arg2 ...grpc.CallOption) (*pb.LoginResponse, error) {
+// ReverseGiftCardRedemption mocks base method.
+func (m *MockGalaxyClient) ReverseGiftCardRedemption(arg0 context.Context, arg1 *pb.ReverseGiftCardRedemptionRequest, arg2 ...grpc.CallOption) (*pb.RedeemGiftCardResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "Login", varargs...)
-	ret0, _ := ret[0].(*pb.LoginResponse)
+	ret := m.ctrl.Call(m, "ReverseGiftCardRedemption", varargs...)
+	ret0, _ := ret[0].(*pb.RedeemGiftCardResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Login indicates an expected call of Login.
-func (mr *MockGalaxyClientMockRecorder) Login(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// ReverseGiftCardRedemption indicates an expected call of ReverseGiftCardRedemption.
+func (mr *MockGalaxyClientMockRecorder) ReverseGiftCardRedemption(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockGalaxyClient)(nil).Login), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReverseGiftCardRedemption", reflect.TypeOf((*MockGalaxyClient)(nil).ReverseGiftCardRedemption), varargs...)
 }
 
-// RenewAccessToken mocks base method.
-func (m *MockGalaxyClient) RenewAccessToken(arg0 context.Context, arg1 *pb.RenewAccessTokenRequest, arg2 ...grpc.CallOption) (*pb.RenewAccessTokenResponse, error) {
+// RevokeSession mocks base method.
+func (m *MockGalaxyClient) RevokeSession(arg0 context.Context, arg1 *pb.RevokeSessionRequest, arg2 ...grpc.CallOption) (*pb.Empty, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "RenewAccessToken", varargs...)
-	ret0, _ := ret[0].(*pb.RenewAccessTokenResponse)
+	ret := m.ctrl.Call(m, "RevokeSession", varargs...)
+	ret0, _ := ret[0].(*pb.Empty)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RenewAccessToken indicates an expected call of RenewAccessToken.
-func (mr *MockGalaxyClientMockRecorder) RenewAccessToken(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockGalaxyClientMockRecorder) RevokeSession(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewAccessToken", reflect.TypeOf((*MockGalaxyClient)(nil).RenewAccessToken), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockGalaxyClient)(nil).RevokeSession), varargs...)
+}
+
+// RevokeSessions mocks base method.
+func (m *MockGalaxyClient) RevokeSessions(arg0 context.Context, arg1 *pb.RevokeSessionsRequest, arg2 ...grpc.CallOption) (*pb.RevokeSessionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeSessions", varargs...)
+	ret0, _ := ret[0].(*pb.RevokeSessionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSessions indicates an expected call of RevokeSessions.
+func (mr *MockGalaxyClientMockRecorder) RevokeSessions(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSessions", reflect.TypeOf((*MockGalaxyClient)(nil).RevokeSessions), varargs...)
+}
+
+// SetCartLine mocks base method.
+func (m *MockGalaxyClient) SetCartLine(arg0 context.Context, arg1 *pb.SetCartLineRequest, arg2 ...grpc.CallOption) (*pb.CartResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetCartLine", varargs...)
+	ret0, _ := ret[0].(*pb.CartResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetCartLine indicates an expected call of SetCartLine.
+func (mr *MockGalaxyClientMockRecorder) SetCartLine(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCartLine", reflect.TypeOf((*MockGalaxyClient)(nil).SetCartLine), varargs...)
+}
+
+// UpdateCoupon mocks base method.
+func (m *MockGalaxyClient) UpdateCoupon(arg0 context.Context, arg1 *pb.UpdateCouponRequest, arg2 ...grpc.CallOption) (*pb.CouponResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateCoupon", varargs...)
+	ret0, _ := ret[0].(*pb.CouponResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCoupon indicates an expected call of UpdateCoupon.
+func (mr *MockGalaxyClientMockRecorder) UpdateCoupon(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCoupon", reflect.TypeOf((*MockGalaxyClient)(nil).UpdateCoupon), varargs...)
+}
+
+// UpdateEntryStatus mocks base method.
+func (m *MockGalaxyClient) UpdateEntryStatus(arg0 context.Context, arg1 *pb.UpdateEntryStatusRequest, arg2 ...grpc.CallOption) (*pb.UpdateEntryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateEntryStatus", varargs...)
+	ret0, _ := ret[0].(*pb.UpdateEntryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEntryStatus indicates an expected call of UpdateEntryStatus.
+func (mr *MockGalaxyClientMockRecorder) UpdateEntryStatus(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEntryStatus", reflect.TypeOf((*MockGalaxyClient)(nil).UpdateEntryStatus), varargs...)
 }
 
 // UpdateItem mocks base method.
@@ -396,6 +1616,86 @@ func (mr *MockGalaxyClientMockRecorder) UpdateItem(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItem", reflect.TypeOf((*MockGalaxyClient)(nil).UpdateItem), varargs...)
 }
 
+// UpdateOrderStatus mocks base method.
+func (m *MockGalaxyClient) UpdateOrderStatus(arg0 context.Context, arg1 *pb.UpdateOrderStatusRequest, arg2 ...grpc.CallOption) (*pb.OrderResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateOrderStatus", varargs...)
+	ret0, _ := ret[0].(*pb.OrderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOrderStatus indicates an expected call of UpdateOrderStatus.
+func (mr *MockGalaxyClientMockRecorder) UpdateOrderStatus(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderStatus", reflect.TypeOf((*MockGalaxyClient)(nil).UpdateOrderStatus), varargs...)
+}
+
+// UpdateSaga mocks base method.
+func (m *MockGalaxyClient) UpdateSaga(arg0 context.Context, arg1 *pb.UpdateSagaRequest, arg2 ...grpc.CallOption) (*pb.SagaResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateSaga", varargs...)
+	ret0, _ := ret[0].(*pb.SagaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSaga indicates an expected call of UpdateSaga.
+func (mr *MockGalaxyClientMockRecorder) UpdateSaga(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSaga", reflect.TypeOf((*MockGalaxyClient)(nil).UpdateSaga), varargs...)
+}
+
+// UpdateTeamMember mocks base method.
+func (m *MockGalaxyClient) UpdateTeamMember(arg0 context.Context, arg1 *pb.UpdateTeamMemberRequest, arg2 ...grpc.CallOption) (*pb.TeamMemberResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTeamMember", varargs...)
+	ret0, _ := ret[0].(*pb.TeamMemberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTeamMember indicates an expected call of UpdateTeamMember.
+func (mr *MockGalaxyClientMockRecorder) UpdateTeamMember(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeamMember", reflect.TypeOf((*MockGalaxyClient)(nil).UpdateTeamMember), varargs...)
+}
+
+// UpdateTrial mocks base method.
+func (m *MockGalaxyClient) UpdateTrial(arg0 context.Context, arg1 *pb.UpdateTrialRequest, arg2 ...grpc.CallOption) (*pb.UpdateTrialResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTrial", varargs...)
+	ret0, _ := ret[0].(*pb.UpdateTrialResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTrial indicates an expected call of UpdateTrial.
+func (mr *MockGalaxyClientMockRecorder) UpdateTrial(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTrial", reflect.TypeOf((*MockGalaxyClient)(nil).UpdateTrial), varargs...)
+}
+
 // UpdateUser mocks base method.
 func (m *MockGalaxyClient) UpdateUser(arg0 context.Context, arg1 *pb.UpdateUserRequest, arg2 ...grpc.CallOption) (*pb.UpdateUserResponse, error) {
 	m.ctrl.T.Helper()
@@ -415,3 +1715,23 @@ func (mr *MockGalaxyClientMockRecorder) UpdateUser(arg0, arg1 interface{}, arg2
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockGalaxyClient)(nil).UpdateUser), varargs...)
 }
+
+// UpdateUserStatus mocks base method.
+func (m *MockGalaxyClient) UpdateUserStatus(arg0 context.Context, arg1 *pb.UpdateUserStatusRequest, arg2 ...grpc.CallOption) (*pb.UpdateUserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateUserStatus", varargs...)
+	ret0, _ := ret[0].(*pb.UpdateUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserStatus indicates an expected call of UpdateUserStatus.
+func (mr *MockGalaxyClientMockRecorder) UpdateUserStatus(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserStatus", reflect.TypeOf((*MockGalaxyClient)(nil).UpdateUserStatus), varargs...)
+}
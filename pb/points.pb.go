@@ -0,0 +1,320 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.12
+// source: points.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PointsTransactionType int32
+
+const (
+	PointsTransactionType_POINTS_TRANSACTION_TYPE_UNSPECIFIED PointsTransactionType = 0
+	PointsTransactionType_POINTS_TRANSACTION_TYPE_EARN        PointsTransactionType = 1
+	PointsTransactionType_POINTS_TRANSACTION_TYPE_REDEEM      PointsTransactionType = 2
+	PointsTransactionType_POINTS_TRANSACTION_TYPE_EXPIRE      PointsTransactionType = 3
+	PointsTransactionType_POINTS_TRANSACTION_TYPE_REVERSAL    PointsTransactionType = 4
+)
+
+// Enum value maps for PointsTransactionType.
+var (
+	PointsTransactionType_name = map[int32]string{
+		0: "POINTS_TRANSACTION_TYPE_UNSPECIFIED",
+		1: "POINTS_TRANSACTION_TYPE_EARN",
+		2: "POINTS_TRANSACTION_TYPE_REDEEM",
+		3: "POINTS_TRANSACTION_TYPE_EXPIRE",
+		4: "POINTS_TRANSACTION_TYPE_REVERSAL",
+	}
+	PointsTransactionType_value = map[string]int32{
+		"POINTS_TRANSACTION_TYPE_UNSPECIFIED": 0,
+		"POINTS_TRANSACTION_TYPE_EARN":        1,
+		"POINTS_TRANSACTION_TYPE_REDEEM":      2,
+		"POINTS_TRANSACTION_TYPE_EXPIRE":      3,
+		"POINTS_TRANSACTION_TYPE_REVERSAL":    4,
+	}
+)
+
+func (x PointsTransactionType) Enum() *PointsTransactionType {
+	p := new(PointsTransactionType)
+	*p = x
+	return p
+}
+
+func (x PointsTransactionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PointsTransactionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_points_proto_enumTypes[0].Descriptor()
+}
+
+func (PointsTransactionType) Type() protoreflect.EnumType {
+	return &file_points_proto_enumTypes[0]
+}
+
+func (x PointsTransactionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PointsTransactionType.Descriptor instead.
+func (PointsTransactionType) EnumDescriptor() ([]byte, []int) {
+	return file_points_proto_rawDescGZIP(), []int{0}
+}
+
+// PointsTransaction is one line of the append-only loyalty points ledger.
+// Points are spent and expire oldest first.
+type PointsTransaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID     int32                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	UserId int32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type   PointsTransactionType `protobuf:"varint,3,opt,name=type,proto3,enum=pb.PointsTransactionType" json:"type,omitempty"`
+	// points is negative for points leaving the balance.
+	Points int64 `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+	// balance is the balance after the transaction.
+	Balance int64  `protobuf:"varint,5,opt,name=balance,proto3" json:"balance,omitempty"`
+	EntryId int32  `protobuf:"varint,6,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	Reason  string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	// expires_at is when the points earned by the transaction expire.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// reverses_id is the transaction undone by a reversal.
+	ReversesId int32                  `protobuf:"varint,9,opt,name=reverses_id,json=reversesId,proto3" json:"reverses_id,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *PointsTransaction) Reset() {
+	*x = PointsTransaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_points_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PointsTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PointsTransaction) ProtoMessage() {}
+
+func (x *PointsTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_points_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PointsTransaction.ProtoReflect.Descriptor instead.
+func (*PointsTransaction) Descriptor() ([]byte, []int) {
+	return file_points_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PointsTransaction) GetID() int32 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *PointsTransaction) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PointsTransaction) GetType() PointsTransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return PointsTransactionType_POINTS_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *PointsTransaction) GetPoints() int64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *PointsTransaction) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *PointsTransaction) GetEntryId() int32 {
+	if x != nil {
+		return x.EntryId
+	}
+	return 0
+}
+
+func (x *PointsTransaction) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PointsTransaction) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *PointsTransaction) GetReversesId() int32 {
+	if x != nil {
+		return x.ReversesId
+	}
+	return 0
+}
+
+func (x *PointsTransaction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_points_proto protoreflect.FileDescriptor
+
+var file_points_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02,
+	0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xe7, 0x02, 0x0a, 0x11, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x49, 0x44, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x73, 0x5f, 0x69, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x73,
+	0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x2a, 0xd0, 0x01,
+	0x0a, 0x15, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x23, 0x50, 0x4f, 0x49, 0x4e, 0x54,
+	0x53, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x20, 0x0a, 0x1c, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x53, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x41, 0x52, 0x4e,
+	0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x53, 0x5f, 0x54, 0x52, 0x41,
+	0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45,
+	0x44, 0x45, 0x45, 0x4d, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x53,
+	0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x10, 0x03, 0x12, 0x24, 0x0a, 0x20, 0x50, 0x4f,
+	0x49, 0x4e, 0x54, 0x53, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x56, 0x45, 0x52, 0x53, 0x41, 0x4c, 0x10, 0x04,
+	0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d,
+	0x61, 0x63, 0x68, 0x65, 0x61, 0x72, 0x6e, 0x2f, 0x67, 0x61, 0x6c, 0x61, 0x78, 0x79, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_points_proto_rawDescOnce sync.Once
+	file_points_proto_rawDescData = file_points_proto_rawDesc
+)
+
+func file_points_proto_rawDescGZIP() []byte {
+	file_points_proto_rawDescOnce.Do(func() {
+		file_points_proto_rawDescData = protoimpl.X.CompressGZIP(file_points_proto_rawDescData)
+	})
+	return file_points_proto_rawDescData
+}
+
+var file_points_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_points_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_points_proto_goTypes = []interface{}{
+	(PointsTransactionType)(0),    // 0: pb.PointsTransactionType
+	(*PointsTransaction)(nil),     // 1: pb.PointsTransaction
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_points_proto_depIdxs = []int32{
+	0, // 0: pb.PointsTransaction.type:type_name -> pb.PointsTransactionType
+	2, // 1: pb.PointsTransaction.expires_at:type_name -> google.protobuf.Timestamp
+	2, // 2: pb.PointsTransaction.created_at:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_points_proto_init() }
+func file_points_proto_init() {
+	if File_points_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_points_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PointsTransaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_points_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_points_proto_goTypes,
+		DependencyIndexes: file_points_proto_depIdxs,
+		EnumInfos:         file_points_proto_enumTypes,
+		MessageInfos:      file_points_proto_msgTypes,
+	}.Build()
+	File_points_proto = out.File
+	file_points_proto_rawDesc = nil
+	file_points_proto_goTypes = nil
+	file_points_proto_depIdxs = nil
+}
@@ -0,0 +1,914 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.12
+// source: rpc_gift_card.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CreateGiftCardRequest fails with ALREADY_EXISTS if the code is taken or
+// idempotency_key was used before. The balance starts at value.
+type CreateGiftCardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code           string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Value          *Money                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	PurchaserId    int32                  `protobuf:"varint,3,opt,name=purchaser_id,json=purchaserId,proto3" json:"purchaser_id,omitempty"`
+	EntryId        int32                  `protobuf:"varint,4,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+}
+
+func (x *CreateGiftCardRequest) Reset() {
+	*x = CreateGiftCardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_gift_card_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGiftCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGiftCardRequest) ProtoMessage() {}
+
+func (x *CreateGiftCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_gift_card_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGiftCardRequest.ProtoReflect.Descriptor instead.
+func (*CreateGiftCardRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_gift_card_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateGiftCardRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateGiftCardRequest) GetValue() *Money {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *CreateGiftCardRequest) GetPurchaserId() int32 {
+	if x != nil {
+		return x.PurchaserId
+	}
+	return 0
+}
+
+func (x *CreateGiftCardRequest) GetEntryId() int32 {
+	if x != nil {
+		return x.EntryId
+	}
+	return 0
+}
+
+func (x *CreateGiftCardRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreateGiftCardRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type GetGiftCardByCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *GetGiftCardByCodeRequest) Reset() {
+	*x = GetGiftCardByCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_gift_card_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGiftCardByCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGiftCardByCodeRequest) ProtoMessage() {}
+
+func (x *GetGiftCardByCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_gift_card_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGiftCardByCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetGiftCardByCodeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_gift_card_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetGiftCardByCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// GetGiftCardByEntryRequest finds the gift card bought by an entry. It fails
+// with NOT_FOUND if the entry bought none.
+type GetGiftCardByEntryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntryId int32 `protobuf:"varint,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+}
+
+func (x *GetGiftCardByEntryRequest) Reset() {
+	*x = GetGiftCardByEntryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_gift_card_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGiftCardByEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGiftCardByEntryRequest) ProtoMessage() {}
+
+func (x *GetGiftCardByEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_gift_card_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGiftCardByEntryRequest.ProtoReflect.Descriptor instead.
+func (*GetGiftCardByEntryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_gift_card_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetGiftCardByEntryRequest) GetEntryId() int32 {
+	if x != nil {
+		return x.EntryId
+	}
+	return 0
+}
+
+// DeleteGiftCardRequest with unused set fails with FAILED_PRECONDITION if
+// any of the card's balance has been spent; the check and the delete are
+// atomic.
+type DeleteGiftCardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Unused bool  `protobuf:"varint,2,opt,name=unused,proto3" json:"unused,omitempty"`
+}
+
+func (x *DeleteGiftCardRequest) Reset() {
+	*x = DeleteGiftCardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_gift_card_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteGiftCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGiftCardRequest) ProtoMessage() {}
+
+func (x *DeleteGiftCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_gift_card_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGiftCardRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGiftCardRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_gift_card_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteGiftCardRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteGiftCardRequest) GetUnused() bool {
+	if x != nil {
+		return x.Unused
+	}
+	return false
+}
+
+type GiftCardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GiftCard *GiftCard `protobuf:"bytes,1,opt,name=gift_card,json=giftCard,proto3" json:"gift_card,omitempty"`
+}
+
+func (x *GiftCardResponse) Reset() {
+	*x = GiftCardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_gift_card_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GiftCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiftCardResponse) ProtoMessage() {}
+
+func (x *GiftCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_gift_card_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiftCardResponse.ProtoReflect.Descriptor instead.
+func (*GiftCardResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_gift_card_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GiftCardResponse) GetGiftCard() *GiftCard {
+	if x != nil {
+		return x.GiftCard
+	}
+	return nil
+}
+
+// RedeemGiftCardRequest takes amount off the balance of a card. It fails
+// with FAILED_PRECONDITION if the balance is lower or the card has expired,
+// and with ALREADY_EXISTS if idempotency_key was used before; the check and
+// the update are atomic.
+type RedeemGiftCardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GiftCardId     int32  `protobuf:"varint,1,opt,name=gift_card_id,json=giftCardId,proto3" json:"gift_card_id,omitempty"`
+	UserId         int32  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount         *Money `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	EntryId        int32  `protobuf:"varint,4,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	ToWallet       bool   `protobuf:"varint,5,opt,name=to_wallet,json=toWallet,proto3" json:"to_wallet,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+}
+
+func (x *RedeemGiftCardRequest) Reset() {
+	*x = RedeemGiftCardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_gift_card_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedeemGiftCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemGiftCardRequest) ProtoMessage() {}
+
+func (x *RedeemGiftCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_gift_card_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemGiftCardRequest.ProtoReflect.Descriptor instead.
+func (*RedeemGiftCardRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_gift_card_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RedeemGiftCardRequest) GetGiftCardId() int32 {
+	if x != nil {
+		return x.GiftCardId
+	}
+	return 0
+}
+
+func (x *RedeemGiftCardRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RedeemGiftCardRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *RedeemGiftCardRequest) GetEntryId() int32 {
+	if x != nil {
+		return x.EntryId
+	}
+	return 0
+}
+
+func (x *RedeemGiftCardRequest) GetToWallet() bool {
+	if x != nil {
+		return x.ToWallet
+	}
+	return false
+}
+
+func (x *RedeemGiftCardRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type RedeemGiftCardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Redemption *GiftCardRedemption `protobuf:"bytes,1,opt,name=redemption,proto3" json:"redemption,omitempty"`
+	GiftCard   *GiftCard           `protobuf:"bytes,2,opt,name=gift_card,json=giftCard,proto3" json:"gift_card,omitempty"`
+}
+
+func (x *RedeemGiftCardResponse) Reset() {
+	*x = RedeemGiftCardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_gift_card_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedeemGiftCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemGiftCardResponse) ProtoMessage() {}
+
+func (x *RedeemGiftCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_gift_card_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemGiftCardResponse.ProtoReflect.Descriptor instead.
+func (*RedeemGiftCardResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_gift_card_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RedeemGiftCardResponse) GetRedemption() *GiftCardRedemption {
+	if x != nil {
+		return x.Redemption
+	}
+	return nil
+}
+
+func (x *RedeemGiftCardResponse) GetGiftCard() *GiftCard {
+	if x != nil {
+		return x.GiftCard
+	}
+	return nil
+}
+
+// ReverseGiftCardRedemptionRequest puts the amount of a redemption back on
+// its card. Reversing a redemption twice changes nothing.
+type ReverseGiftCardRedemptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ReverseGiftCardRedemptionRequest) Reset() {
+	*x = ReverseGiftCardRedemptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_gift_card_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReverseGiftCardRedemptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseGiftCardRedemptionRequest) ProtoMessage() {}
+
+func (x *ReverseGiftCardRedemptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_gift_card_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseGiftCardRedemptionRequest.ProtoReflect.Descriptor instead.
+func (*ReverseGiftCardRedemptionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_gift_card_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReverseGiftCardRedemptionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// ListGiftCardRedemptionsRequest lists the newest redemptions first,
+// optionally only those of entry_id or the one recorded with
+// idempotency_key.
+type ListGiftCardRedemptionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GiftCardId     int32  `protobuf:"varint,1,opt,name=gift_card_id,json=giftCardId,proto3" json:"gift_card_id,omitempty"`
+	EntryId        int32  `protobuf:"varint,2,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	Offset         int32  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit          int32  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+}
+
+func (x *ListGiftCardRedemptionsRequest) Reset() {
+	*x = ListGiftCardRedemptionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_gift_card_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGiftCardRedemptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGiftCardRedemptionsRequest) ProtoMessage() {}
+
+func (x *ListGiftCardRedemptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_gift_card_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGiftCardRedemptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListGiftCardRedemptionsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_gift_card_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListGiftCardRedemptionsRequest) GetGiftCardId() int32 {
+	if x != nil {
+		return x.GiftCardId
+	}
+	return 0
+}
+
+func (x *ListGiftCardRedemptionsRequest) GetEntryId() int32 {
+	if x != nil {
+		return x.EntryId
+	}
+	return 0
+}
+
+func (x *ListGiftCardRedemptionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListGiftCardRedemptionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListGiftCardRedemptionsRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type ListGiftCardRedemptionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Redemptions []*GiftCardRedemption `protobuf:"bytes,1,rep,name=redemptions,proto3" json:"redemptions,omitempty"`
+}
+
+func (x *ListGiftCardRedemptionsResponse) Reset() {
+	*x = ListGiftCardRedemptionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_gift_card_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGiftCardRedemptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGiftCardRedemptionsResponse) ProtoMessage() {}
+
+func (x *ListGiftCardRedemptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_gift_card_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGiftCardRedemptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListGiftCardRedemptionsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_gift_card_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListGiftCardRedemptionsResponse) GetRedemptions() []*GiftCardRedemption {
+	if x != nil {
+		return x.Redemptions
+	}
+	return nil
+}
+
+var File_rpc_gift_card_proto protoreflect.FileDescriptor
+
+var file_rpc_gift_card_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x69, 0x66, 0x74, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x67, 0x69, 0x66, 0x74,
+	0x5f, 0x63, 0x61, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x6d, 0x6f, 0x6e,
+	0x65, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xee, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x75, 0x72, 0x63, 0x68,
+	0x61, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70,
+	0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x2e, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x42, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x36, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x49,
+	0x64, 0x22, 0x3f, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x69, 0x66, 0x74, 0x43,
+	0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e,
+	0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x6e, 0x75, 0x73,
+	0x65, 0x64, 0x22, 0x3d, 0x0a, 0x10, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x09, 0x67, 0x69, 0x66, 0x74, 0x5f, 0x63,
+	0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x08, 0x67, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72,
+	0x64, 0x22, 0xd6, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x47, 0x69, 0x66, 0x74,
+	0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x67,
+	0x69, 0x66, 0x74, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x67, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x6f, 0x57, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d,
+	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x7b, 0x0a, 0x16, 0x52, 0x65,
+	0x64, 0x65, 0x65, 0x6d, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x69,
+	0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x09,
+	0x67, 0x69, 0x66, 0x74, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x08, 0x67,
+	0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x22, 0x32, 0x0a, 0x20, 0x52, 0x65, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0xb4, 0x01, 0x0a, 0x1e,
+	0x4c, 0x69, 0x73, 0x74, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x64, 0x65,
+	0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
+	0x0a, 0x0c, 0x67, 0x69, 0x66, 0x74, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x67, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65,
+	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b,
+	0x65, 0x79, 0x22, 0x5b, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42,
+	0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x61,
+	0x63, 0x68, 0x65, 0x61, 0x72, 0x6e, 0x2f, 0x67, 0x61, 0x6c, 0x61, 0x78, 0x79, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_gift_card_proto_rawDescOnce sync.Once
+	file_rpc_gift_card_proto_rawDescData = file_rpc_gift_card_proto_rawDesc
+)
+
+func file_rpc_gift_card_proto_rawDescGZIP() []byte {
+	file_rpc_gift_card_proto_rawDescOnce.Do(func() {
+		file_rpc_gift_card_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_gift_card_proto_rawDescData)
+	})
+	return file_rpc_gift_card_proto_rawDescData
+}
+
+var file_rpc_gift_card_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_rpc_gift_card_proto_goTypes = []interface{}{
+	(*CreateGiftCardRequest)(nil),            // 0: pb.CreateGiftCardRequest
+	(*GetGiftCardByCodeRequest)(nil),         // 1: pb.GetGiftCardByCodeRequest
+	(*GetGiftCardByEntryRequest)(nil),        // 2: pb.GetGiftCardByEntryRequest
+	(*DeleteGiftCardRequest)(nil),            // 3: pb.DeleteGiftCardRequest
+	(*GiftCardResponse)(nil),                 // 4: pb.GiftCardResponse
+	(*RedeemGiftCardRequest)(nil),            // 5: pb.RedeemGiftCardRequest
+	(*RedeemGiftCardResponse)(nil),           // 6: pb.RedeemGiftCardResponse
+	(*ReverseGiftCardRedemptionRequest)(nil), // 7: pb.ReverseGiftCardRedemptionRequest
+	(*ListGiftCardRedemptionsRequest)(nil),   // 8: pb.ListGiftCardRedemptionsRequest
+	(*ListGiftCardRedemptionsResponse)(nil),  // 9: pb.ListGiftCardRedemptionsResponse
+	(*Money)(nil),                            // 10: pb.Money
+	(*timestamppb.Timestamp)(nil),            // 11: google.protobuf.Timestamp
+	(*GiftCard)(nil),                         // 12: pb.GiftCard
+	(*GiftCardRedemption)(nil),               // 13: pb.GiftCardRedemption
+}
+var file_rpc_gift_card_proto_depIdxs = []int32{
+	10, // 0: pb.CreateGiftCardRequest.value:type_name -> pb.Money
+	11, // 1: pb.CreateGiftCardRequest.expires_at:type_name -> google.protobuf.Timestamp
+	12, // 2: pb.GiftCardResponse.gift_card:type_name -> pb.GiftCard
+	10, // 3: pb.RedeemGiftCardRequest.amount:type_name -> pb.Money
+	13, // 4: pb.RedeemGiftCardResponse.redemption:type_name -> pb.GiftCardRedemption
+	12, // 5: pb.RedeemGiftCardResponse.gift_card:type_name -> pb.GiftCard
+	13, // 6: pb.ListGiftCardRedemptionsResponse.redemptions:type_name -> pb.GiftCardRedemption
+	7,  // [7:7] is the sub-list for method output_type
+	7,  // [7:7] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_rpc_gift_card_proto_init() }
+func file_rpc_gift_card_proto_init() {
+	if File_rpc_gift_card_proto != nil {
+		return
+	}
+	file_gift_card_proto_init()
+	file_money_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_gift_card_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGiftCardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_gift_card_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGiftCardByCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_gift_card_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGiftCardByEntryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_gift_card_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteGiftCardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_gift_card_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GiftCardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_gift_card_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedeemGiftCardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_gift_card_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedeemGiftCardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_gift_card_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReverseGiftCardRedemptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_gift_card_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGiftCardRedemptionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_gift_card_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGiftCardRedemptionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_gift_card_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_gift_card_proto_goTypes,
+		DependencyIndexes: file_rpc_gift_card_proto_depIdxs,
+		MessageInfos:      file_rpc_gift_card_proto_msgTypes,
+	}.Build()
+	File_rpc_gift_card_proto = out.File
+	file_rpc_gift_card_proto_rawDesc = nil
+	file_rpc_gift_card_proto_goTypes = nil
+	file_rpc_gift_card_proto_depIdxs = nil
+}
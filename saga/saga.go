@@ -0,0 +1,327 @@
+// Package saga runs operations that span several calls to the backend and
+// rolls back the steps that already happened when a later one fails.
+package saga
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/machearn/galaxy_controller/pb"
+	"github.com/machearn/galaxy_controller/util"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	leaseName    = "saga-recovery"
+	sagaPageSize = 100
+)
+
+// Data is the state a saga carries from one step to the next. It is saved
+// with the saga after every step, so compensations can use it after a
+// restart.
+type Data map[string]string
+
+func (d Data) Int32(key string) int32 {
+	value, _ := strconv.ParseInt(d[key], 10, 32)
+	return int32(value)
+}
+
+func (d Data) SetInt32(key string, value int32) {
+	d[key] = strconv.Itoa(int(value))
+}
+
+func (d Data) Int64(key string) int64 {
+	value, _ := strconv.ParseInt(d[key], 10, 64)
+	return value
+}
+
+func (d Data) SetInt64(key string, value int64) {
+	d[key] = strconv.FormatInt(value, 10)
+}
+
+// Step is one action of a saga.
+type Step struct {
+	Name string
+	// Do performs the step and may record its results in data. Do must not
+	// repeat what it already did if it runs again, so steps that create
+	// records pass an idempotency key and take the record that already
+	// exists for the key as their result.
+	Do func(ctx context.Context, data Data) error
+	// Compensate undoes the step after a later step failed. It is optional
+	// for steps with nothing to undo. A compensation may run again after a
+	// restart, so it must succeed if there is nothing left to undo.
+	Compensate func(ctx context.Context, data Data) error
+}
+
+// Definition is the ordered list of steps of a saga. Definitions are
+// looked up by name when abandoned sagas are recovered.
+type Definition struct {
+	Name  string
+	Steps []Step
+}
+
+// Coordinator runs sagas and saves their progress in the backend. Sagas that
+// have not made progress for SAGA_STALE_AFTER, because the replica running
+// them stopped, are rolled back by Recover.
+type Coordinator struct {
+	grpc   pb.GalaxyClient
+	config util.Config
+	holder string
+	now    func() time.Time
+
+	mu          sync.RWMutex
+	definitions map[string]Definition
+}
+
+func NewCoordinator(config util.Config, grpc pb.GalaxyClient) *Coordinator {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "controller"
+	}
+
+	return &Coordinator{
+		grpc:        grpc,
+		config:      config,
+		holder:      fmt.Sprintf("%s-%s", hostname, util.GetRandomString(8)),
+		now:         time.Now,
+		definitions: make(map[string]Definition),
+	}
+}
+
+// Register makes def available to Recover.
+func (c *Coordinator) Register(def Definition) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.definitions[def.Name] = def
+}
+
+func (c *Coordinator) definition(name string) (Definition, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	def, ok := c.definitions[name]
+	return def, ok
+}
+
+// Run performs the steps of def in order. If a step fails, the steps before
+// it are compensated in reverse order and the error of the failed step is
+// returned. Compensations and the final save are not cut short when ctx is
+// cancelled, since the caller going away is often why a step failed. def is
+// normally the registered definition of the same name, but may capture
+// per-call state in its steps.
+func (c *Coordinator) Run(ctx context.Context, def Definition, data Data) error {
+	if data == nil {
+		data = make(Data)
+	}
+
+	result, err := c.grpc.CreateSaga(ctx, &pb.CreateSagaRequest{Name: def.Name, Data: data})
+	if err != nil {
+		return err
+	}
+	id := result.GetSaga().GetID()
+
+	for i, step := range def.Steps {
+		if err := step.Do(ctx, data); err != nil {
+			if compensateErr := c.compensate(withoutCancel{ctx}, def, id, i, data, err); compensateErr != nil {
+				log.Printf("failed to compensate saga %d (%s): %v", id, def.Name, compensateErr)
+			}
+			return err
+		}
+
+		if err := c.save(ctx, id, pb.SagaStatus_SAGA_STATUS_RUNNING, i+1, data, ""); err != nil {
+			// without saved progress the saga could not be recovered
+			if compensateErr := c.compensate(withoutCancel{ctx}, def, id, i+1, data, err); compensateErr != nil {
+				log.Printf("failed to compensate saga %d (%s): %v", id, def.Name, compensateErr)
+			}
+			return err
+		}
+	}
+
+	// Recover completes the saga if this fails, as every step is saved
+	if err := c.save(withoutCancel{ctx}, id, pb.SagaStatus_SAGA_STATUS_COMPLETED, len(def.Steps), data, ""); err != nil {
+		log.Printf("failed to complete saga %d (%s): %v", id, def.Name, err)
+	}
+	return nil
+}
+
+// withoutCancel keeps the values of a context, such as its tenant, but not
+// its deadline or cancellation, like context.WithoutCancel in Go 1.21.
+type withoutCancel struct {
+	ctx context.Context
+}
+
+func (withoutCancel) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (withoutCancel) Done() <-chan struct{} {
+	return nil
+}
+
+func (withoutCancel) Err() error {
+	return nil
+}
+
+func (c withoutCancel) Value(key any) any {
+	return c.ctx.Value(key)
+}
+
+// compensate undoes the first done steps of a saga, last step first. The
+// saga stays in compensating status if a compensation fails, so that Recover
+// tries again later.
+func (c *Coordinator) compensate(ctx context.Context, def Definition, id int32, done int, data Data, cause error) error {
+	reason := cause.Error()
+	if err := c.save(ctx, id, pb.SagaStatus_SAGA_STATUS_COMPENSATING, done, data, reason); err != nil {
+		log.Printf("failed to save saga %d: %v", id, err)
+	}
+
+	for i := done - 1; i >= 0; i-- {
+		step := def.Steps[i]
+		if step.Compensate != nil {
+			if err := step.Compensate(ctx, data); err != nil {
+				return fmt.Errorf("compensate %s: %w", step.Name, err)
+			}
+		}
+
+		if err := c.save(ctx, id, pb.SagaStatus_SAGA_STATUS_COMPENSATING, i, data, reason); err != nil {
+			log.Printf("failed to save saga %d: %v", id, err)
+		}
+	}
+
+	return c.save(ctx, id, pb.SagaStatus_SAGA_STATUS_COMPENSATED, 0, data, reason)
+}
+
+func (c *Coordinator) save(ctx context.Context, id int32, status pb.SagaStatus, step int, data Data, reason string) error {
+	_, err := c.grpc.UpdateSaga(ctx, &pb.UpdateSagaRequest{
+		Id:     id,
+		Status: status,
+		Step:   int32(step),
+		Data:   data,
+		Error:  reason,
+	})
+	return err
+}
+
+// RunRecovery recovers abandoned sagas every SAGA_RECOVERY_INTERVAL until ctx
+// is cancelled, starting right away so that sagas interrupted by a restart
+// are picked up.
+func (c *Coordinator) RunRecovery(ctx context.Context) {
+	ticker := time.NewTicker(c.config.SagaRecoveryInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.Recover(ctx); err != nil {
+			log.Printf("saga recovery failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Recover rolls back every saga that is still running or compensating but
+// has not been updated for SAGA_STALE_AFTER. Sagas are rolled back rather
+// than finished because nobody is waiting for their result any more. A
+// running saga whose steps have all been saved only missed being marked
+// completed, so it is marked completed instead.
+func (c *Coordinator) Recover(ctx context.Context) error {
+	leader, err := c.acquireLease(ctx)
+	if err != nil {
+		return err
+	}
+	if !leader {
+		return nil
+	}
+
+	sagas, err := c.listStale(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, saga := range sagas {
+		def, ok := c.definition(saga.GetName())
+		if !ok {
+			log.Printf("cannot recover saga %d: unknown saga %s", saga.GetID(), saga.GetName())
+			continue
+		}
+
+		done := int(saga.GetStep())
+		if done > len(def.Steps) {
+			done = len(def.Steps)
+		}
+
+		data := Data(saga.GetData())
+		if data == nil {
+			data = make(Data)
+		}
+
+		if saga.GetStatus() == pb.SagaStatus_SAGA_STATUS_RUNNING && done == len(def.Steps) {
+			if err := c.save(ctx, saga.GetID(), pb.SagaStatus_SAGA_STATUS_COMPLETED, done, data, ""); err != nil {
+				log.Printf("failed to complete saga %d (%s): %v", saga.GetID(), saga.GetName(), err)
+			}
+			continue
+		}
+
+		cause := errors.New(saga.GetError())
+		if saga.GetStatus() == pb.SagaStatus_SAGA_STATUS_RUNNING {
+			cause = errors.New("abandoned while running")
+		}
+
+		if err := c.compensate(ctx, def, saga.GetID(), done, data, cause); err != nil {
+			log.Printf("failed to recover saga %d (%s): %v", saga.GetID(), saga.GetName(), err)
+		}
+	}
+	return nil
+}
+
+func (c *Coordinator) acquireLease(ctx context.Context) (bool, error) {
+	grpcReq := pb.AcquireLeaseRequest{
+		Name:       leaseName,
+		Holder:     c.holder,
+		TtlSeconds: int32(2 * c.config.SagaRecoveryInterval / time.Second),
+	}
+
+	result, err := c.grpc.AcquireLease(ctx, &grpcReq)
+	if err != nil {
+		return false, err
+	}
+	return result.GetAcquired(), nil
+}
+
+// listStale collects the abandoned sagas up front, because recovering a saga
+// moves it out of the filter and would shift later pages.
+func (c *Coordinator) listStale(ctx context.Context) ([]*pb.Saga, error) {
+	var sagas []*pb.Saga
+
+	for offset := int32(0); ; offset += sagaPageSize {
+		grpcReq := pb.ListSagasRequest{
+			Statuses: []pb.SagaStatus{
+				pb.SagaStatus_SAGA_STATUS_RUNNING,
+				pb.SagaStatus_SAGA_STATUS_COMPENSATING,
+			},
+			UpdatedBefore: timestamppb.New(c.now().Add(-c.config.SagaStaleAfter)),
+			Offset:        offset,
+			Limit:         sagaPageSize,
+		}
+
+		result, err := c.grpc.ListSagas(ctx, &grpcReq)
+		if err != nil {
+			return nil, err
+		}
+
+		sagas = append(sagas, result.GetSagas()...)
+		if len(result.GetSagas()) < sagaPageSize {
+			return sagas, nil
+		}
+	}
+}
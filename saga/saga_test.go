@@ -0,0 +1,277 @@
+package saga
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/machearn/galaxy_controller/pb"
+	mockpb "github.com/machearn/galaxy_controller/pb/mock"
+	"github.com/machearn/galaxy_controller/util"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCoordinator(grpc pb.GalaxyClient) *Coordinator {
+	config := util.Config{
+		SagaRecoveryInterval: time.Minute,
+		SagaStaleAfter:       time.Minute * 5,
+	}
+	return NewCoordinator(config, grpc)
+}
+
+// recordingSteps returns steps that log what they do into calls. The step
+// at index fail fails.
+func recordingSteps(calls *[]string, fail int) []Step {
+	names := []string{"a", "b", "c"}
+	steps := make([]Step, len(names))
+	for i, name := range names {
+		i, name := i, name
+		steps[i] = Step{
+			Name: name,
+			Do: func(ctx context.Context, data Data) error {
+				*calls = append(*calls, "do "+name)
+				if i == fail {
+					return errors.New(name + " failed")
+				}
+				data[name] = "done"
+				return nil
+			},
+			Compensate: func(ctx context.Context, data Data) error {
+				*calls = append(*calls, "undo "+name)
+				return nil
+			},
+		}
+	}
+	return steps
+}
+
+func expectSave(grpc *mockpb.MockGalaxyClient, status pb.SagaStatus, step int32) *gomock.Call {
+	return grpc.EXPECT().UpdateSaga(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *pb.UpdateSagaRequest, _ ...any) (*pb.SagaResponse, error) {
+			if req.GetStatus() != status || req.GetStep() != step {
+				return nil, errors.New("unexpected saga update")
+			}
+			return &pb.SagaResponse{}, nil
+		})
+}
+
+func TestRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().CreateSaga(gomock.Any(), gomock.Eq(&pb.CreateSagaRequest{
+		Name: "test",
+		Data: map[string]string{"key": "value"},
+	})).Return(&pb.SagaResponse{Saga: &pb.Saga{ID: 1}}, nil)
+	gomock.InOrder(
+		expectSave(grpc, pb.SagaStatus_SAGA_STATUS_RUNNING, 1),
+		expectSave(grpc, pb.SagaStatus_SAGA_STATUS_RUNNING, 2),
+		expectSave(grpc, pb.SagaStatus_SAGA_STATUS_RUNNING, 3),
+		expectSave(grpc, pb.SagaStatus_SAGA_STATUS_COMPLETED, 3),
+	)
+
+	var calls []string
+	data := Data{"key": "value"}
+	err := newTestCoordinator(grpc).Run(context.Background(), Definition{Name: "test", Steps: recordingSteps(&calls, -1)}, data)
+	require.NoError(t, err)
+	require.Equal(t, []string{"do a", "do b", "do c"}, calls)
+	require.Equal(t, "done", data["c"])
+}
+
+func TestRunCompensates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().CreateSaga(gomock.Any(), gomock.Any()).Return(&pb.SagaResponse{Saga: &pb.Saga{ID: 1}}, nil)
+	gomock.InOrder(
+		expectSave(grpc, pb.SagaStatus_SAGA_STATUS_RUNNING, 1),
+		expectSave(grpc, pb.SagaStatus_SAGA_STATUS_RUNNING, 2),
+		expectSave(grpc, pb.SagaStatus_SAGA_STATUS_COMPENSATING, 2),
+		expectSave(grpc, pb.SagaStatus_SAGA_STATUS_COMPENSATING, 1),
+		expectSave(grpc, pb.SagaStatus_SAGA_STATUS_COMPENSATING, 0),
+		expectSave(grpc, pb.SagaStatus_SAGA_STATUS_COMPENSATED, 0),
+	)
+
+	var calls []string
+	err := newTestCoordinator(grpc).Run(context.Background(), Definition{Name: "test", Steps: recordingSteps(&calls, 2)}, nil)
+	require.EqualError(t, err, "c failed")
+	require.Equal(t, []string{"do a", "do b", "do c", "undo b", "undo a"}, calls)
+}
+
+func TestRunCompensatesAfterCancel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().CreateSaga(gomock.Any(), gomock.Any()).Return(&pb.SagaResponse{Saga: &pb.Saga{ID: 1}}, nil)
+	grpc.EXPECT().UpdateSaga(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, req *pb.UpdateSagaRequest, _ ...any) (*pb.SagaResponse, error) {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			return &pb.SagaResponse{}, nil
+		}).AnyTimes()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var calls []string
+	steps := recordingSteps(&calls, 1)
+	steps[1].Do = func(ctx context.Context, data Data) error {
+		// the client went away while the step was running
+		cancel()
+		return ctx.Err()
+	}
+	steps[0].Compensate = func(ctx context.Context, data Data) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		calls = append(calls, "undo a")
+		return nil
+	}
+
+	err := newTestCoordinator(grpc).Run(ctx, Definition{Name: "test", Steps: steps}, nil)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, []string{"do a", "undo a"}, calls)
+}
+
+func TestRunCompletedSaveFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().CreateSaga(gomock.Any(), gomock.Any()).Return(&pb.SagaResponse{Saga: &pb.Saga{ID: 1}}, nil)
+	gomock.InOrder(
+		expectSave(grpc, pb.SagaStatus_SAGA_STATUS_RUNNING, 1),
+		expectSave(grpc, pb.SagaStatus_SAGA_STATUS_RUNNING, 2),
+		expectSave(grpc, pb.SagaStatus_SAGA_STATUS_RUNNING, 3),
+		grpc.EXPECT().UpdateSaga(gomock.Any(), gomock.Any()).Return(nil, errors.New("backend unavailable")),
+	)
+
+	var calls []string
+	err := newTestCoordinator(grpc).Run(context.Background(), Definition{Name: "test", Steps: recordingSteps(&calls, -1)}, nil)
+	// every step happened, so the saga succeeded and is not rolled back
+	require.NoError(t, err)
+	require.Equal(t, []string{"do a", "do b", "do c"}, calls)
+}
+
+func TestRunCompensationFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().CreateSaga(gomock.Any(), gomock.Any()).Return(&pb.SagaResponse{Saga: &pb.Saga{ID: 1}}, nil)
+	gomock.InOrder(
+		expectSave(grpc, pb.SagaStatus_SAGA_STATUS_RUNNING, 1),
+		expectSave(grpc, pb.SagaStatus_SAGA_STATUS_COMPENSATING, 1),
+	)
+
+	var calls []string
+	steps := recordingSteps(&calls, 1)
+	steps[0].Compensate = func(ctx context.Context, data Data) error {
+		calls = append(calls, "undo a")
+		return errors.New("backend unavailable")
+	}
+
+	err := newTestCoordinator(grpc).Run(context.Background(), Definition{Name: "test", Steps: steps}, nil)
+	require.EqualError(t, err, "b failed")
+	// the saga is left compensating for Recover to finish
+	require.Equal(t, []string{"do a", "do b", "undo a"}, calls)
+}
+
+func TestRecover(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().AcquireLease(gomock.Any(), gomock.Any()).Return(&pb.AcquireLeaseResponse{Acquired: true}, nil)
+	grpc.EXPECT().ListSagas(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *pb.ListSagasRequest, _ ...any) (*pb.ListSagasResponse, error) {
+			require.Equal(t, now.Add(-time.Minute*5).Unix(), req.GetUpdatedBefore().AsTime().Unix())
+			return &pb.ListSagasResponse{Sagas: []*pb.Saga{
+				{ID: 1, Name: "test", Status: pb.SagaStatus_SAGA_STATUS_RUNNING, Step: 2, Data: map[string]string{"a": "done", "b": "done"}},
+				{ID: 2, Name: "unknown", Status: pb.SagaStatus_SAGA_STATUS_RUNNING, Step: 1},
+			}}, nil
+		})
+	gomock.InOrder(
+		expectSave(grpc, pb.SagaStatus_SAGA_STATUS_COMPENSATING, 2),
+		expectSave(grpc, pb.SagaStatus_SAGA_STATUS_COMPENSATING, 1),
+		expectSave(grpc, pb.SagaStatus_SAGA_STATUS_COMPENSATING, 0),
+		grpc.EXPECT().UpdateSaga(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, req *pb.UpdateSagaRequest, _ ...any) (*pb.SagaResponse, error) {
+				require.Equal(t, pb.SagaStatus_SAGA_STATUS_COMPENSATED, req.GetStatus())
+				require.Equal(t, "abandoned while running", req.GetError())
+				return &pb.SagaResponse{}, nil
+			}),
+	)
+
+	var calls []string
+	coordinator := newTestCoordinator(grpc)
+	coordinator.now = func() time.Time { return now }
+	coordinator.Register(Definition{Name: "test", Steps: recordingSteps(&calls, -1)})
+
+	require.NoError(t, coordinator.Recover(context.Background()))
+	// the saved steps are rolled back rather than the saga resumed, since
+	// the replica running it may only be slow
+	require.Equal(t, []string{"undo b", "undo a"}, calls)
+}
+
+func TestRecoverCompensating(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().AcquireLease(gomock.Any(), gomock.Any()).Return(&pb.AcquireLeaseResponse{Acquired: true}, nil)
+	grpc.EXPECT().ListSagas(gomock.Any(), gomock.Any()).Return(&pb.ListSagasResponse{Sagas: []*pb.Saga{
+		{ID: 1, Name: "test", Status: pb.SagaStatus_SAGA_STATUS_COMPENSATING, Step: 2, Error: "c failed"},
+	}}, nil)
+	gomock.InOrder(
+		expectSave(grpc, pb.SagaStatus_SAGA_STATUS_COMPENSATING, 2),
+		expectSave(grpc, pb.SagaStatus_SAGA_STATUS_COMPENSATING, 1),
+		expectSave(grpc, pb.SagaStatus_SAGA_STATUS_COMPENSATING, 0),
+		expectSave(grpc, pb.SagaStatus_SAGA_STATUS_COMPENSATED, 0),
+	)
+
+	var calls []string
+	coordinator := newTestCoordinator(grpc)
+	coordinator.Register(Definition{Name: "test", Steps: recordingSteps(&calls, -1)})
+
+	require.NoError(t, coordinator.Recover(context.Background()))
+	require.Equal(t, []string{"undo b", "undo a"}, calls)
+}
+
+func TestRecoverCompleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().AcquireLease(gomock.Any(), gomock.Any()).Return(&pb.AcquireLeaseResponse{Acquired: true}, nil)
+	grpc.EXPECT().ListSagas(gomock.Any(), gomock.Any()).Return(&pb.ListSagasResponse{Sagas: []*pb.Saga{
+		// the saving of the completed status failed after the last step
+		{ID: 1, Name: "test", Status: pb.SagaStatus_SAGA_STATUS_RUNNING, Step: 3},
+	}}, nil)
+	expectSave(grpc, pb.SagaStatus_SAGA_STATUS_COMPLETED, 3)
+
+	var calls []string
+	coordinator := newTestCoordinator(grpc)
+	coordinator.Register(Definition{Name: "test", Steps: recordingSteps(&calls, -1)})
+
+	require.NoError(t, coordinator.Recover(context.Background()))
+	require.Empty(t, calls)
+}
+
+func TestRecoverNotLeader(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	grpc := mockpb.NewMockGalaxyClient(ctrl)
+	grpc.EXPECT().AcquireLease(gomock.Any(), gomock.Any()).Return(&pb.AcquireLeaseResponse{Acquired: false}, nil)
+	grpc.EXPECT().ListSagas(gomock.Any(), gomock.Any()).Times(0)
+
+	require.NoError(t, newTestCoordinator(grpc).Recover(context.Background()))
+}
@@ -0,0 +1,146 @@
+package tax
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ErrUnknownRegion is returned for regions the rules do not cover.
+var ErrUnknownRegion = errors.New("unknown tax region")
+
+// Rule is how purchases made in one region are taxed.
+type Rule struct {
+	// Rate is in basis points: 725 is 7.25%.
+	Rate int32 `json:"rate"`
+	// Inclusive means prices in the region already contain the tax, as with
+	// VAT, instead of having it added on top.
+	Inclusive bool `json:"inclusive"`
+	// ExemptItems are not taxed in the region.
+	ExemptItems []int32 `json:"exempt_items"`
+}
+
+// Rules is the content of the tax rules file.
+type Rules struct {
+	// DefaultRegion is used for purchases that name no region.
+	DefaultRegion string `json:"default_region"`
+	// ExemptItems are not taxed in any region.
+	ExemptItems []int32         `json:"exempt_items"`
+	Regions     map[string]Rule `json:"regions"`
+}
+
+// Result is the tax due on one purchase.
+type Result struct {
+	Region    string
+	Rate      int32
+	Inclusive bool
+	// Net is the amount before tax.
+	Net int64
+	Tax int64
+	// Total is what the customer pays, Net plus Tax.
+	Total int64
+}
+
+// Engine computes taxes from a set of rules. An engine without regions
+// charges no tax at all.
+type Engine struct {
+	rules Rules
+}
+
+// NewEngine checks rules and returns an engine applying them. Region names
+// are case-insensitive.
+func NewEngine(rules Rules) (*Engine, error) {
+	regions := make(map[string]Rule, len(rules.Regions))
+	for name, rule := range rules.Regions {
+		if rule.Rate < 0 || rule.Rate > 10000 {
+			return nil, fmt.Errorf("tax rate of region %s must be between 0 and 10000 basis points", name)
+		}
+		regions[normalizeRegion(name)] = rule
+	}
+	rules.Regions = regions
+
+	rules.DefaultRegion = normalizeRegion(rules.DefaultRegion)
+	if len(rules.DefaultRegion) > 0 {
+		if _, ok := regions[rules.DefaultRegion]; !ok {
+			return nil, fmt.Errorf("default tax region %s has no rule", rules.DefaultRegion)
+		}
+	}
+
+	return &Engine{rules: rules}, nil
+}
+
+// LoadRules reads the rules from a JSON file. No path means no tax.
+func LoadRules(path string) (*Engine, error) {
+	if len(path) == 0 {
+		return NewEngine(Rules{})
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read tax rules: %w", err)
+	}
+
+	var rules Rules
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("cannot parse tax rules %s: %w", path, err)
+	}
+	return NewEngine(rules)
+}
+
+func normalizeRegion(region string) string {
+	return strings.ToUpper(strings.TrimSpace(region))
+}
+
+func containsID(ids []int32, id int32) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Region resolves the region a purchase is taxed in, falling back to the
+// default region when none is given.
+func (e *Engine) Region(region string) (string, error) {
+	region = normalizeRegion(region)
+	if len(region) == 0 {
+		return e.rules.DefaultRegion, nil
+	}
+	if len(e.rules.Regions) == 0 {
+		return region, nil
+	}
+	if _, ok := e.rules.Regions[region]; !ok {
+		return "", fmt.Errorf("%w %s", ErrUnknownRegion, region)
+	}
+	return region, nil
+}
+
+// Calculate taxes a purchase of item costing amount in region. For
+// inclusive regions the tax is taken out of amount, otherwise it is added to
+// it. Tax is rounded to the nearest unit.
+func (e *Engine) Calculate(region string, itemID int32, amount int64) (Result, error) {
+	region, err := e.Region(region)
+	if err != nil {
+		return Result{}, err
+	}
+
+	result := Result{Region: region, Net: amount, Total: amount}
+	rule, ok := e.rules.Regions[region]
+	if !ok || containsID(e.rules.ExemptItems, itemID) || containsID(rule.ExemptItems, itemID) {
+		return result, nil
+	}
+
+	result.Rate = rule.Rate
+	result.Inclusive = rule.Inclusive
+	if rule.Inclusive {
+		result.Net = (amount*10000 + int64(10000+rule.Rate)/2) / int64(10000+rule.Rate)
+		result.Tax = amount - result.Net
+	} else {
+		result.Tax = (amount*int64(rule.Rate) + 5000) / 10000
+		result.Total = amount + result.Tax
+	}
+	return result, nil
+}
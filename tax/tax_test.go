@@ -0,0 +1,119 @@
+package tax
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEngine(t *testing.T) *Engine {
+	engine, err := NewEngine(Rules{
+		DefaultRegion: "us-ca",
+		ExemptItems:   []int32{9},
+		Regions: map[string]Rule{
+			"US-CA": {Rate: 725, ExemptItems: []int32{5}},
+			"DE":    {Rate: 1900, Inclusive: true},
+			"US-OR": {},
+		},
+	})
+	require.NoError(t, err)
+	return engine
+}
+
+func TestCalculate(t *testing.T) {
+	engine := newTestEngine(t)
+
+	testCases := []struct {
+		name   string
+		region string
+		itemID int32
+		amount int64
+		result Result
+	}{
+		{
+			name:   "Exclusive",
+			region: "US-CA",
+			itemID: 1,
+			amount: 1000,
+			result: Result{Region: "US-CA", Rate: 725, Net: 1000, Tax: 73, Total: 1073},
+		},
+		{
+			name:   "Inclusive",
+			region: "de",
+			itemID: 1,
+			amount: 1190,
+			result: Result{Region: "DE", Rate: 1900, Inclusive: true, Net: 1000, Tax: 190, Total: 1190},
+		},
+		{
+			name:   "DefaultRegion",
+			itemID: 1,
+			amount: 200,
+			result: Result{Region: "US-CA", Rate: 725, Net: 200, Tax: 15, Total: 215},
+		},
+		{
+			name:   "ExemptInRegion",
+			region: "US-CA",
+			itemID: 5,
+			amount: 1000,
+			result: Result{Region: "US-CA", Net: 1000, Total: 1000},
+		},
+		{
+			name:   "ExemptEverywhere",
+			region: "DE",
+			itemID: 9,
+			amount: 1000,
+			result: Result{Region: "DE", Net: 1000, Total: 1000},
+		},
+		{
+			name:   "NoTax",
+			region: "US-OR",
+			itemID: 1,
+			amount: 1000,
+			result: Result{Region: "US-OR", Net: 1000, Total: 1000},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := engine.Calculate(tc.region, tc.itemID, tc.amount)
+			require.NoError(t, err)
+			require.Equal(t, tc.result, result)
+		})
+	}
+
+	_, err := engine.Calculate("FR", 1, 1000)
+	require.True(t, errors.Is(err, ErrUnknownRegion))
+}
+
+func TestCalculateWithoutRules(t *testing.T) {
+	engine, err := LoadRules("")
+	require.NoError(t, err)
+
+	result, err := engine.Calculate("anywhere", 1, 1000)
+	require.NoError(t, err)
+	require.Equal(t, Result{Region: "ANYWHERE", Net: 1000, Total: 1000}, result)
+}
+
+func TestLoadRules(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tax_rules.json")
+	rules := `{"default_region": "de", "regions": {"de": {"rate": 1900, "inclusive": true}}}`
+	require.NoError(t, os.WriteFile(path, []byte(rules), 0o600))
+
+	engine, err := LoadRules(path)
+	require.NoError(t, err)
+
+	result, err := engine.Calculate("", 1, 119)
+	require.NoError(t, err)
+	require.Equal(t, Result{Region: "DE", Rate: 1900, Inclusive: true, Net: 100, Tax: 19, Total: 119}, result)
+
+	_, err = NewEngine(Rules{DefaultRegion: "fr", Regions: map[string]Rule{"de": {Rate: 1900}}})
+	require.EqualError(t, err, "default tax region FR has no rule")
+
+	_, err = NewEngine(Rules{Regions: map[string]Rule{"de": {Rate: 19000}}})
+	require.Error(t, err)
+}
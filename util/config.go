@@ -1,14 +1,66 @@
 package util
 
 import (
+	"path/filepath"
+	"time"
+
 	"github.com/spf13/viper"
 )
 
 // Config is a struct that holds the configuration for the application.
 type Config struct {
+	// Environment is "development" on developers' machines, where the fake
+	// payments provider and the placeholder secrets of app.env may be used.
+	Environment       string `mapstructure:"ENVIRONMENT"`
 	GrpcServerAddress string `mapstructure:"GRPC_SERVER_ADDRESS"`
 	HTTPServerAddress string `mapstructure:"HTTP_SERVER_ADDRESS"`
 	TokenSymmetricKey string `mapstructure:"TOKEN_SYMMETRIC_KEY"`
+	PublicBaseURL     string `mapstructure:"PUBLIC_BASE_URL"`
+	SMTPAddress       string `mapstructure:"SMTP_ADDRESS"`
+	SMTPUsername      string `mapstructure:"SMTP_USERNAME"`
+	SMTPPassword      string `mapstructure:"SMTP_PASSWORD"`
+	EmailSender       string `mapstructure:"EMAIL_SENDER"`
+	SessionPolicy     string `mapstructure:"SESSION_LIMIT_POLICY"`
+
+	PaymentsProvider      string `mapstructure:"PAYMENTS_PROVIDER"`
+	PaymentsCurrency      string `mapstructure:"PAYMENTS_CURRENCY"`
+	PaymentsWebhookSecret string `mapstructure:"PAYMENTS_WEBHOOK_SECRET"`
+	StripeAPIURL          string `mapstructure:"STRIPE_API_URL"`
+	StripeSecretKey       string `mapstructure:"STRIPE_SECRET_KEY"`
+
+	SubscriptionGracePeriod time.Duration `mapstructure:"SUBSCRIPTION_GRACE_PERIOD"`
+
+	BillingInterval     time.Duration `mapstructure:"BILLING_INTERVAL"`
+	BillingRenewWindow  time.Duration `mapstructure:"BILLING_RENEW_WINDOW"`
+	BillingRetryBackoff time.Duration `mapstructure:"BILLING_RETRY_BACKOFF"`
+	BillingMaxAttempts  int           `mapstructure:"BILLING_MAX_ATTEMPTS"`
+	TrialReminderBefore time.Duration `mapstructure:"TRIAL_REMINDER_BEFORE"`
+
+	SagaRecoveryInterval time.Duration `mapstructure:"SAGA_RECOVERY_INTERVAL"`
+	SagaStaleAfter       time.Duration `mapstructure:"SAGA_STALE_AFTER"`
+
+	// GiftCardValidity is how long gift cards can be used after they were
+	// bought. Gift cards never expire if it is zero.
+	GiftCardValidity time.Duration `mapstructure:"GIFT_CARD_VALIDITY"`
+
+	// PointsPerUnit is how many loyalty points members earn per major unit
+	// of the payments currency they spend, PointValue what a point is worth
+	// in its minor unit when redeemed. The loyalty program is off if
+	// PointsPerUnit is zero, and points never expire if PointsValidity is.
+	PointsPerUnit        int64         `mapstructure:"POINTS_PER_UNIT"`
+	PointValue           int64         `mapstructure:"POINT_VALUE"`
+	PointsValidity       time.Duration `mapstructure:"POINTS_VALIDITY"`
+	PointsExpiryInterval time.Duration `mapstructure:"POINTS_EXPIRY_INTERVAL"`
+
+	// TaxRulesFile and ExchangeRatesFile are resolved relative to the config
+	// directory.
+	TaxRulesFile      string `mapstructure:"TAX_RULES_FILE"`
+	ExchangeRatesFile string `mapstructure:"EXCHANGE_RATES_FILE"`
+}
+
+// Development reports whether the controller runs in development.
+func (config Config) Development() bool {
+	return config.Environment == "development"
 }
 
 func LoadConfig(configPath string) (Config, error) {
@@ -27,5 +79,11 @@ func LoadConfig(configPath string) (Config, error) {
 		return config, err
 	}
 
+	for _, path := range []*string{&config.TaxRulesFile, &config.ExchangeRatesFile} {
+		if len(*path) > 0 && !filepath.IsAbs(*path) {
+			*path = filepath.Join(configPath, *path)
+		}
+	}
+
 	return config, nil
 }
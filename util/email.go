@@ -0,0 +1,9 @@
+package util
+
+import "strings"
+
+// NormalizeEmail returns the canonical form of an email address used when
+// comparing addresses, e.g. to allow one trial per email.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
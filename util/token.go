@@ -0,0 +1,64 @@
+package util
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+var ErrInvalidSignature = errors.New("signature is invalid")
+
+// SignToken encodes claims as base64url JSON and appends an HMAC-SHA256
+// signature computed with key, so the token can be handed to clients and
+// verified later without server-side state.
+func SignToken(key string, claims any) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + Sign(key, []byte(encoded)), nil
+}
+
+// VerifyToken checks the signature of a token produced by SignToken and
+// decodes its claims.
+func VerifyToken(key string, token string, claims any) error {
+	encoded, signature, found := strings.Cut(token, ".")
+	if !found {
+		return ErrInvalidSignature
+	}
+	if err := VerifySignature(key, []byte(encoded), signature); err != nil {
+		return err
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(payload, claims)
+}
+
+// Sign returns the base64url HMAC-SHA256 of data.
+func Sign(key string, data []byte) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write(data)
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func VerifySignature(key string, data []byte, signature string) error {
+	expected, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write(data)
+	if !hmac.Equal(mac.Sum(nil), expected) {
+		return ErrInvalidSignature
+	}
+	return nil
+}